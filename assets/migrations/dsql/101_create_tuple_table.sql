@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+-- DSQL schema: uses full indexes (no partial), ASYNC index creation, C collation by default
+CREATE TABLE IF NOT EXISTS tuple (
+    store TEXT NOT NULL,
+    object_type TEXT NOT NULL,
+    object_id TEXT NOT NULL,
+    relation TEXT NOT NULL,
+    _user TEXT NOT NULL,
+    user_type TEXT NOT NULL,
+    ulid TEXT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (store, object_type, object_id, relation, _user)
+);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP TABLE IF EXISTS tuple;
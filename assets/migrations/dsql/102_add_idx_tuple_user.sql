@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE INDEX ASYNC IF NOT EXISTS idx_tuple_user ON tuple (store, object_type, object_id, relation, _user, user_type);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP INDEX IF EXISTS idx_tuple_user;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE UNIQUE INDEX ASYNC IF NOT EXISTS idx_tuple_ulid ON tuple (ulid);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP INDEX IF EXISTS idx_tuple_ulid;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE TABLE IF NOT EXISTS authorization_model (
+    store TEXT NOT NULL,
+    authorization_model_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    type_definition BYTEA,
+    PRIMARY KEY (store, authorization_model_id, type)
+);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP TABLE IF EXISTS authorization_model;
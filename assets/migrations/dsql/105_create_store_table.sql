@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE TABLE IF NOT EXISTS store (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP TABLE IF EXISTS store;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE TABLE IF NOT EXISTS assertion (
+    store TEXT NOT NULL,
+    authorization_model_id TEXT NOT NULL,
+    assertions BYTEA,
+    PRIMARY KEY (store, authorization_model_id)
+);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP TABLE IF EXISTS assertion;
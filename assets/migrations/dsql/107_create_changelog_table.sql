@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE TABLE IF NOT EXISTS changelog (
+    store TEXT NOT NULL,
+    object_type TEXT NOT NULL,
+    object_id TEXT NOT NULL,
+    relation TEXT NOT NULL,
+    _user TEXT NOT NULL,
+    operation INTEGER NOT NULL,
+    ulid TEXT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (store, ulid, object_type)
+);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP TABLE IF EXISTS changelog;
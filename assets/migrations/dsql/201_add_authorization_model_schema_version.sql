@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+-- DSQL: ADD COLUMN without DEFAULT, existing rows are backfilled by the next migration
+ALTER TABLE authorization_model ADD COLUMN IF NOT EXISTS schema_version TEXT;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE authorization_model DROP COLUMN IF EXISTS schema_version;
@@ -0,0 +1,3 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+UPDATE authorization_model SET schema_version = '1.0' WHERE schema_version IS NULL;
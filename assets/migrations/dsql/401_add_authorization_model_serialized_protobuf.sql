@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE authorization_model ADD COLUMN IF NOT EXISTS serialized_protobuf BYTEA;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE authorization_model DROP COLUMN IF EXISTS serialized_protobuf;
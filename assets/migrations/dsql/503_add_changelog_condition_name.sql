@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE changelog ADD COLUMN IF NOT EXISTS condition_name TEXT;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE changelog DROP COLUMN IF EXISTS condition_name;
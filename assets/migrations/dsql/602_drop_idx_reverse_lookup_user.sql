@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+DROP INDEX IF EXISTS idx_reverse_lookup_user;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+CREATE INDEX ASYNC IF NOT EXISTS idx_reverse_lookup_user ON tuple (store, object_type, relation, _user);
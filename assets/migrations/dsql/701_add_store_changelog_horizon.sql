@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE store ADD COLUMN IF NOT EXISTS changelog_horizon TEXT;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE store DROP COLUMN IF EXISTS changelog_horizon;
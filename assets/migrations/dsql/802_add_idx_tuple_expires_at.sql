@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+-- DSQL: no partial indexes
+CREATE INDEX ASYNC IF NOT EXISTS idx_tuple_expires_at ON tuple (expires_at);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP INDEX IF EXISTS idx_tuple_expires_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE changelog ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE changelog DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE store ADD COLUMN changelog_horizon TEXT;
+
+-- +goose Down
+ALTER TABLE store DROP COLUMN changelog_horizon;
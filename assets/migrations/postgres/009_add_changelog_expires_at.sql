@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE changelog ADD COLUMN expires_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE changelog DROP COLUMN expires_at;
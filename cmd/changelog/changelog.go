@@ -0,0 +1,131 @@
+// Package changelog contains the commands to manage the changelog of the stores.
+package changelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/openfga/openfga/cmd/util"
+	"github.com/openfga/openfga/internal/changelogretention"
+	"github.com/openfga/openfga/pkg/server/config"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/sqlcommon"
+)
+
+const (
+	datastoreEngineFlag   = "datastore-engine"
+	datastoreURIFlag      = "datastore-uri"
+	datastoreUsernameFlag = "datastore-username"
+	datastorePasswordFlag = "datastore-password"
+	maxAgeFlag            = "max-age"
+	maxChangesFlag        = "max-changes"
+	batchSizeFlag         = "batch-size"
+	storeIDFlag           = "store-id"
+)
+
+func NewChangelogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changelog",
+		Short: "Manage the changelog of the stores",
+		Long:  "The changelog command is used to manage the changes of the stores recorded in the changelog.",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewPruneCommand())
+
+	return cmd
+}
+
+func NewPruneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the changelog of the stores beyond a retention policy",
+		Long: "Delete the changes older than --max-age, and the changes beyond the --max-changes most recent ones, " +
+			"from the changelog of the stores. Reading the changes with a continuation token older than the pruned " +
+			"changes fails afterwards on the servers run with --changelog-retention-enabled, which also prune the " +
+			"changelog periodically.",
+		RunE:         runPrune,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+
+	flags := cmd.Flags()
+	defaultConfig := config.DefaultConfig()
+
+	flags.String(datastoreEngineFlag, "", "the datastore engine")
+	flags.String(datastoreURIFlag, "", "the connection uri to the datastore")
+	flags.String(datastoreUsernameFlag, "", "(optional) overwrite the username in the connection string")
+	flags.String(datastorePasswordFlag, "", "(optional) overwrite the password in the connection string")
+	util.AddDSQLFlags(flags)
+	flags.Duration(maxAgeFlag, defaultConfig.ChangelogRetention.MaxAge, "prune the changes older than this duration (0 to not prune by age)")
+	flags.Int(maxChangesFlag, defaultConfig.ChangelogRetention.MaxChanges, "the maximum number of most recent changes kept per store (0 to not prune by count)")
+	flags.Int(batchSizeFlag, defaultConfig.ChangelogRetention.BatchSize, "the maximum number of changes deleted in a single statement")
+	flags.StringSlice(storeIDFlag, nil, "only prune the changelog of these stores (can be repeated)")
+
+	// NOTE: if you add a new flag here, update the function below, too
+
+	cmd.PreRun = bindPruneFlagsFunc(flags)
+
+	return cmd
+}
+
+func runPrune(cmd *cobra.Command, _ []string) error {
+	engine := viper.GetString(datastoreEngineFlag)
+	uri := viper.GetString(datastoreURIFlag)
+	username := viper.GetString(datastoreUsernameFlag)
+	password := viper.GetString(datastorePasswordFlag)
+	storeIDs := viper.GetStringSlice(storeIDFlag)
+	options := storage.PruneChangesOptions{
+		MaxAge:     viper.GetDuration(maxAgeFlag),
+		MaxChanges: viper.GetInt(maxChangesFlag),
+		BatchSize:  viper.GetInt(batchSizeFlag),
+	}
+
+	if options.MaxAge < 0 || options.MaxChanges < 0 || (options.MaxAge == 0 && options.MaxChanges == 0) {
+		return fmt.Errorf("'%s' or '%s' must be greater than zero", maxAgeFlag, maxChangesFlag)
+	}
+	if options.BatchSize <= 0 {
+		return fmt.Errorf("'%s' must be greater than zero", batchSizeFlag)
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if engine == "memory" {
+		// The memory datastore only lives in the process serving it.
+		return fmt.Errorf("storage engine '%s' is unsupported, as its changelog can not be accessed from another process", engine)
+	}
+
+	cfg := sqlcommon.NewConfig(sqlcommon.WithUsername(username), sqlcommon.WithPassword(password))
+	db, err := util.OpenDatastore(ctx, engine, uri, cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	deleted, err := changelogretention.PruneStores(ctx, db, storeIDs, options)
+
+	total := 0
+	stores := make([]string, 0, len(deleted))
+	for storeID, n := range deleted {
+		stores = append(stores, storeID)
+		total += n
+	}
+	slices.Sort(stores)
+	for _, storeID := range stores {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: %d changes pruned\n", storeID, deleted[storeID])
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%d changes pruned from %d stores\n", total, len(deleted))
+
+	if errors.Is(err, changelogretention.ErrPruningNotSupported) {
+		return fmt.Errorf("storage engine '%s' does not support pruning the changelog", engine)
+	}
+	return err
+}
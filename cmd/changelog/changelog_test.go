@@ -0,0 +1,114 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openfga/openfga/cmd"
+	"github.com/openfga/openfga/cmd/util"
+)
+
+func TestPruneCommandWhenInvalidEngine(t *testing.T) {
+	for _, tc := range []struct {
+		engine        string
+		errorExpected string
+	}{
+		{
+			engine:        "unknown",
+			errorExpected: "storage engine 'unknown' is unsupported",
+		},
+		{
+			engine:        "",
+			errorExpected: "missing datastore engine type",
+		},
+		{
+			engine:        "memory",
+			errorExpected: "storage engine 'memory' is unsupported",
+		},
+	} {
+		t.Run(tc.engine, func(t *testing.T) {
+			pruneCommand := NewPruneCommand()
+			pruneCommand.SetArgs([]string{"--datastore-engine", tc.engine, "--datastore-uri", "", "--max-changes", "10"})
+			err := pruneCommand.Execute()
+			require.ErrorContains(t, err, tc.errorExpected)
+		})
+	}
+}
+
+func TestPruneCommandWhenInvalidRetention(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args          []string
+		errorExpected string
+	}{
+		"no_limits": {
+			errorExpected: "'max-age' or 'max-changes' must be greater than zero",
+		},
+		"negative_max_changes": {
+			args:          []string{"--max-age", "1h", "--max-changes", "-1"},
+			errorExpected: "'max-age' or 'max-changes' must be greater than zero",
+		},
+		"zero_batch_size": {
+			args:          []string{"--max-age", "1h", "--batch-size", "0"},
+			errorExpected: "'batch-size' must be greater than zero",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			util.PrepareTempConfigDir(t)
+			pruneCommand := NewPruneCommand()
+			pruneCommand.SetArgs(append([]string{"--datastore-engine", "memory"}, tc.args...))
+			require.ErrorContains(t, pruneCommand.Execute(), tc.errorExpected)
+		})
+	}
+}
+
+func TestPruneCommandNoConfigDefaultValues(t *testing.T) {
+	util.PrepareTempConfigDir(t)
+	pruneCommand := NewPruneCommand()
+	pruneCommand.RunE = func(cmd *cobra.Command, _ []string) error {
+		require.Empty(t, viper.GetString(datastoreEngineFlag))
+		require.Empty(t, viper.GetString(datastoreURIFlag))
+		require.Empty(t, viper.GetString(datastoreUsernameFlag))
+		require.Empty(t, viper.GetString(datastorePasswordFlag))
+		require.Equal(t, 15*time.Minute, viper.GetDuration(util.DatastoreDSQLTokenLifetimeFlag))
+		require.Equal(t, time.Duration(0), viper.GetDuration(maxAgeFlag))
+		require.Equal(t, 0, viper.GetInt(maxChangesFlag))
+		require.Equal(t, 1000, viper.GetInt(batchSizeFlag))
+		require.Empty(t, viper.GetStringSlice(storeIDFlag))
+		return nil
+	}
+
+	changelogCmd := NewChangelogCommand()
+	changelogCmd.RemoveCommand(changelogCmd.Commands()...)
+	changelogCmd.AddCommand(pruneCommand)
+
+	cmd := cmd.NewRootCommand()
+	cmd.AddCommand(changelogCmd)
+	cmd.SetArgs([]string{"changelog", "prune"})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestPruneCommandEnvValuesAreParsed(t *testing.T) {
+	util.PrepareTempConfigDir(t)
+	t.Setenv("OPENFGA_CHANGELOG_RETENTION_MAX_AGE", "48h")
+	t.Setenv("OPENFGA_CHANGELOG_RETENTION_MAX_CHANGES", "500")
+
+	pruneCommand := NewPruneCommand()
+	pruneCommand.RunE = func(cmd *cobra.Command, _ []string) error {
+		require.Equal(t, 48*time.Hour, viper.GetDuration(maxAgeFlag))
+		require.Equal(t, 500, viper.GetInt(maxChangesFlag))
+		return nil
+	}
+
+	changelogCmd := NewChangelogCommand()
+	changelogCmd.RemoveCommand(changelogCmd.Commands()...)
+	changelogCmd.AddCommand(pruneCommand)
+
+	cmd := cmd.NewRootCommand()
+	cmd.AddCommand(changelogCmd)
+	cmd.SetArgs([]string{"changelog", "prune"})
+	require.NoError(t, cmd.Execute())
+}
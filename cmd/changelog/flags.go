@@ -0,0 +1,39 @@
+package changelog
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openfga/openfga/cmd/util"
+)
+
+// bindPruneFlagsFunc binds the cobra cmd flags to the equivalent config value being managed
+// by viper. This bridges the config between cobra flags and viper flags.
+func bindPruneFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		util.MustBindPFlag(datastoreEngineFlag, flags.Lookup(datastoreEngineFlag))
+		util.MustBindEnv(datastoreEngineFlag, "OPENFGA_DATASTORE_ENGINE")
+
+		util.MustBindPFlag(datastoreURIFlag, flags.Lookup(datastoreURIFlag))
+		util.MustBindEnv(datastoreURIFlag, "OPENFGA_DATASTORE_URI")
+
+		util.MustBindPFlag(datastoreUsernameFlag, flags.Lookup(datastoreUsernameFlag))
+		util.MustBindEnv(datastoreUsernameFlag, "OPENFGA_DATASTORE_USERNAME")
+
+		util.MustBindPFlag(datastorePasswordFlag, flags.Lookup(datastorePasswordFlag))
+		util.MustBindEnv(datastorePasswordFlag, "OPENFGA_DATASTORE_PASSWORD")
+
+		util.BindDSQLFlags(flags)
+
+		util.MustBindPFlag(maxAgeFlag, flags.Lookup(maxAgeFlag))
+		util.MustBindEnv(maxAgeFlag, "OPENFGA_CHANGELOG_RETENTION_MAX_AGE")
+
+		util.MustBindPFlag(maxChangesFlag, flags.Lookup(maxChangesFlag))
+		util.MustBindEnv(maxChangesFlag, "OPENFGA_CHANGELOG_RETENTION_MAX_CHANGES")
+
+		util.MustBindPFlag(batchSizeFlag, flags.Lookup(batchSizeFlag))
+		util.MustBindEnv(batchSizeFlag, "OPENFGA_CHANGELOG_RETENTION_BATCH_SIZE")
+
+		util.MustBindPFlag(storeIDFlag, flags.Lookup(storeIDFlag))
+	}
+}
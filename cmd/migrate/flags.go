@@ -39,5 +39,10 @@ func bindRunFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
 
 		util.MustBindPFlag(logTimestampFlag, flags.Lookup(logTimestampFlag))
 		util.MustBindEnv(logTimestampFlag, "OPENFGA_LOG_TIMESTAMP_FORMAT")
+
+		util.MustBindPFlag(waitForIndexesFlag, flags.Lookup(waitForIndexesFlag))
+		util.MustBindEnv(waitForIndexesFlag, "OPENFGA_WAIT_FOR_INDEXES")
+
+		util.BindDSQLFlags(flags)
 	}
 }
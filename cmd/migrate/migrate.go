@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/openfga/openfga/cmd/util"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/server/config"
 	"github.com/openfga/openfga/pkg/storage/migrate"
@@ -24,6 +25,7 @@ const (
 	logFormatFlag         = "log-format"
 	logLevelFlag          = "log-level"
 	logTimestampFlag      = "log-timestamp-format"
+	waitForIndexesFlag    = "wait-for-indexes"
 )
 
 func NewMigrateCommand() *cobra.Command {
@@ -48,6 +50,8 @@ func NewMigrateCommand() *cobra.Command {
 	flags.String(logFormatFlag, defaultConfig.Log.Format, "the log format to output logs in")
 	flags.String(logLevelFlag, defaultConfig.Log.Level, "the log level to use")
 	flags.String(logTimestampFlag, defaultConfig.Log.TimestampFormat, "the timestamp format to use for log messages")
+	flags.Duration(waitForIndexesFlag, 10*time.Minute, "how long to wait for the indexes built asynchronously by the 'dsql' engine to become usable after migrating (0 to not wait)")
+	util.AddDSQLFlags(flags)
 
 	// NOTE: if you add a new flag here, update the function below, too
 
@@ -56,7 +60,7 @@ func NewMigrateCommand() *cobra.Command {
 	return cmd
 }
 
-func runMigration(_ *cobra.Command, _ []string) error {
+func runMigration(cmd *cobra.Command, _ []string) error {
 	engine := viper.GetString(datastoreEngineFlag)
 	uri := viper.GetString(datastoreURIFlag)
 	targetVersion := viper.GetUint(versionFlag)
@@ -67,18 +71,28 @@ func runMigration(_ *cobra.Command, _ []string) error {
 	logFormat := viper.GetString(logFormatFlag)
 	logLevel := viper.GetString(logLevelFlag)
 	logTimestamp := viper.GetString(logTimestampFlag)
+	waitForIndexes := viper.GetDuration(waitForIndexesFlag)
+
+	dsqlTokenConfig := util.DSQLTokenConfig()
 
 	log := logger.MustNewLogger(logFormat, logLevel, logTimestamp)
 
 	cfg := migrate.MigrationConfig{
-		Engine:        engine,
-		URI:           uri,
-		TargetVersion: targetVersion,
-		Timeout:       timeout,
-		Verbose:       verbose,
-		Username:      username,
-		Password:      password,
-		Logger:        log,
+		Engine:         engine,
+		URI:            uri,
+		TargetVersion:  targetVersion,
+		Timeout:        timeout,
+		Verbose:        verbose,
+		Username:       username,
+		Password:       password,
+		Logger:         log,
+		WaitForIndexes: waitForIndexes,
+
+		DSQLAWSProfile:      dsqlTokenConfig.Profile,
+		DSQLRoleARN:         dsqlTokenConfig.RoleARN,
+		DSQLRoleSessionName: dsqlTokenConfig.RoleSessionName,
+		DSQLRoleExternalID:  dsqlTokenConfig.RoleExternalID,
+		DSQLTokenLifetime:   dsqlTokenConfig.Lifetime,
 	}
-	return migrate.RunMigrations(cfg)
+	return migrate.RunMigrationsWithContext(cmd.Context(), cfg)
 }
@@ -5,8 +5,10 @@ import (
 	"os"
 
 	"github.com/openfga/openfga/cmd"
+	"github.com/openfga/openfga/cmd/changelog"
 	"github.com/openfga/openfga/cmd/migrate"
 	"github.com/openfga/openfga/cmd/run"
+	"github.com/openfga/openfga/cmd/store"
 	"github.com/openfga/openfga/cmd/validatemodels"
 )
 
@@ -22,6 +24,12 @@ func main() {
 	validateModelsCmd := validatemodels.NewValidateCommand()
 	rootCmd.AddCommand(validateModelsCmd)
 
+	changelogCmd := changelog.NewChangelogCommand()
+	rootCmd.AddCommand(changelogCmd)
+
+	storeCmd := store.NewStoreCommand()
+	rootCmd.AddCommand(storeCmd)
+
 	versionCmd := cmd.NewVersionCommand()
 	rootCmd.AddCommand(versionCmd)
 
@@ -134,6 +134,36 @@ func bindRunFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
 		util.MustBindPFlag("datastore.metrics.enabled", flags.Lookup("datastore-metrics-enabled"))
 		util.MustBindEnv("datastore.metrics.enabled", "OPENFGA_DATASTORE_METRICS_ENABLED")
 
+		util.MustBindPFlag("datastore.dsql.occRetry.initialInterval", flags.Lookup("datastore-dsql-occ-retry-initial-interval"))
+		util.MustBindEnv("datastore.dsql.occRetry.initialInterval", "OPENFGA_DATASTORE_DSQL_OCC_RETRY_INITIAL_INTERVAL")
+
+		util.MustBindPFlag("datastore.dsql.occRetry.maxInterval", flags.Lookup("datastore-dsql-occ-retry-max-interval"))
+		util.MustBindEnv("datastore.dsql.occRetry.maxInterval", "OPENFGA_DATASTORE_DSQL_OCC_RETRY_MAX_INTERVAL")
+
+		util.MustBindPFlag("datastore.dsql.occRetry.maxElapsedTime", flags.Lookup("datastore-dsql-occ-retry-max-elapsed-time"))
+		util.MustBindEnv("datastore.dsql.occRetry.maxElapsedTime", "OPENFGA_DATASTORE_DSQL_OCC_RETRY_MAX_ELAPSED_TIME")
+
+		util.MustBindPFlag("datastore.dsql.occRetry.maxAttempts", flags.Lookup("datastore-dsql-occ-retry-max-attempts"))
+		util.MustBindEnv("datastore.dsql.occRetry.maxAttempts", "OPENFGA_DATASTORE_DSQL_OCC_RETRY_MAX_ATTEMPTS")
+
+		util.MustBindPFlag("datastore.dsql.occRetry.jitter", flags.Lookup("datastore-dsql-occ-retry-jitter"))
+		util.MustBindEnv("datastore.dsql.occRetry.jitter", "OPENFGA_DATASTORE_DSQL_OCC_RETRY_JITTER")
+
+		util.MustBindPFlag("datastore.dsql.maxRowsPerTransaction", flags.Lookup("datastore-dsql-max-rows-per-transaction"))
+		util.MustBindEnv("datastore.dsql.maxRowsPerTransaction", "OPENFGA_DATASTORE_DSQL_MAX_ROWS_PER_TRANSACTION")
+
+		util.MustBindPFlag("datastore.remote.tls.enabled", flags.Lookup("datastore-remote-tls-enabled"))
+		util.MustBindEnv("datastore.remote.tls.enabled", "OPENFGA_DATASTORE_REMOTE_TLS_ENABLED")
+
+		util.MustBindPFlag("datastore.remote.tls.ca", flags.Lookup("datastore-remote-tls-ca"))
+		util.MustBindEnv("datastore.remote.tls.ca", "OPENFGA_DATASTORE_REMOTE_TLS_CA")
+
+		util.MustBindPFlag("datastore.remote.tls.cert", flags.Lookup("datastore-remote-tls-cert"))
+		util.MustBindEnv("datastore.remote.tls.cert", "OPENFGA_DATASTORE_REMOTE_TLS_CERT")
+
+		util.MustBindPFlag("datastore.remote.tls.key", flags.Lookup("datastore-remote-tls-key"))
+		util.MustBindEnv("datastore.remote.tls.key", "OPENFGA_DATASTORE_REMOTE_TLS_KEY")
+
 		util.MustBindPFlag("playground.enabled", flags.Lookup("playground-enabled"))
 		util.MustBindEnv("playground.enabled", "OPENFGA_PLAYGROUND_ENABLED")
 
@@ -212,6 +242,9 @@ func bindRunFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
 		util.MustBindPFlag("changelogHorizonOffset", flags.Lookup("changelog-horizon-offset"))
 		util.MustBindEnv("changelogHorizonOffset", "OPENFGA_CHANGELOG_HORIZON_OFFSET", "OPENFGA_CHANGELOGHORIZONOFFSET")
 
+		util.MustBindPFlag("watchPollInterval", flags.Lookup("watch-poll-interval"))
+		util.MustBindEnv("watchPollInterval", "OPENFGA_WATCH_POLL_INTERVAL", "OPENFGA_WATCHPOLLINTERVAL")
+
 		util.MustBindPFlag("resolveNodeLimit", flags.Lookup("resolve-node-limit"))
 		util.MustBindEnv("resolveNodeLimit", "OPENFGA_RESOLVE_NODE_LIMIT", "OPENFGA_RESOLVENODELIMIT")
 
@@ -243,6 +276,33 @@ func bindRunFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
 		util.MustBindPFlag("cacheController.ttl", flags.Lookup("cache-controller-ttl"))
 		util.MustBindEnv("cacheController.ttl", "OPENFGA_CACHE_CONTROLLER_TTL")
 
+		util.MustBindPFlag("cacheController.notificationsEnabled", flags.Lookup("cache-controller-notifications-enabled"))
+		util.MustBindEnv("cacheController.notificationsEnabled", "OPENFGA_CACHE_CONTROLLER_NOTIFICATIONS_ENABLED")
+
+		util.MustBindPFlag("changelogRetention.enabled", flags.Lookup("changelog-retention-enabled"))
+		util.MustBindEnv("changelogRetention.enabled", "OPENFGA_CHANGELOG_RETENTION_ENABLED")
+
+		util.MustBindPFlag("changelogRetention.maxAge", flags.Lookup("changelog-retention-max-age"))
+		util.MustBindEnv("changelogRetention.maxAge", "OPENFGA_CHANGELOG_RETENTION_MAX_AGE")
+
+		util.MustBindPFlag("changelogRetention.maxChanges", flags.Lookup("changelog-retention-max-changes"))
+		util.MustBindEnv("changelogRetention.maxChanges", "OPENFGA_CHANGELOG_RETENTION_MAX_CHANGES")
+
+		util.MustBindPFlag("changelogRetention.interval", flags.Lookup("changelog-retention-interval"))
+		util.MustBindEnv("changelogRetention.interval", "OPENFGA_CHANGELOG_RETENTION_INTERVAL")
+
+		util.MustBindPFlag("changelogRetention.batchSize", flags.Lookup("changelog-retention-batch-size"))
+		util.MustBindEnv("changelogRetention.batchSize", "OPENFGA_CHANGELOG_RETENTION_BATCH_SIZE")
+
+		util.MustBindPFlag("tupleExpiryReaper.enabled", flags.Lookup("tuple-expiry-reaper-enabled"))
+		util.MustBindEnv("tupleExpiryReaper.enabled", "OPENFGA_TUPLE_EXPIRY_REAPER_ENABLED")
+
+		util.MustBindPFlag("tupleExpiryReaper.interval", flags.Lookup("tuple-expiry-reaper-interval"))
+		util.MustBindEnv("tupleExpiryReaper.interval", "OPENFGA_TUPLE_EXPIRY_REAPER_INTERVAL")
+
+		util.MustBindPFlag("tupleExpiryReaper.batchSize", flags.Lookup("tuple-expiry-reaper-batch-size"))
+		util.MustBindEnv("tupleExpiryReaper.batchSize", "OPENFGA_TUPLE_EXPIRY_REAPER_BATCH_SIZE")
+
 		util.MustBindPFlag("checkIteratorCache.enabled", flags.Lookup("check-iterator-cache-enabled"))
 		util.MustBindEnv("checkIteratorCache.enabled", "OPENFGA_CHECK_ITERATOR_CACHE_ENABLED")
 
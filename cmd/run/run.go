@@ -22,8 +22,10 @@ import (
 	"github.com/go-logr/logr"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -52,8 +54,11 @@ import (
 	"github.com/openfga/openfga/internal/authn/oidc"
 	"github.com/openfga/openfga/internal/authn/presharedkey"
 	"github.com/openfga/openfga/internal/build"
+	"github.com/openfga/openfga/internal/changelogretention"
 	authnmw "github.com/openfga/openfga/internal/middleware/authn"
+	"github.com/openfga/openfga/internal/periodic"
 	"github.com/openfga/openfga/internal/planner"
+	"github.com/openfga/openfga/internal/tupleexpiry"
 	"github.com/openfga/openfga/pkg/encoder"
 	"github.com/openfga/openfga/pkg/gateway"
 	"github.com/openfga/openfga/pkg/logger"
@@ -68,10 +73,14 @@ import (
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
 	serverErrors "github.com/openfga/openfga/pkg/server/errors"
 	"github.com/openfga/openfga/pkg/server/health"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/memory"
 	"github.com/openfga/openfga/pkg/storage/mysql"
 	"github.com/openfga/openfga/pkg/storage/postgres"
+	"github.com/openfga/openfga/pkg/storage/remote"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
 	"github.com/openfga/openfga/pkg/storage/sqlite"
 	"github.com/openfga/openfga/pkg/telemetry"
@@ -150,7 +159,7 @@ func NewRunCommand() *cobra.Command {
 
 	flags.String("datastore-uri", defaultConfig.Datastore.URI, "the connection uri to use to connect to the datastore (for any engine other than 'memory')")
 
-	flags.String("datastore-secondary-uri", defaultConfig.Datastore.SecondaryURI, "the connection uri to use to connect to the secondary datastore (for postgres only)")
+	flags.String("datastore-secondary-uri", defaultConfig.Datastore.SecondaryURI, "the connection uri to use to connect to the secondary datastore (for postgres and dsql only). Reads that do not require higher consistency are served by the secondary, e.g. a read replica, or the local-region endpoint of a multi-region DSQL cluster")
 
 	flags.String("datastore-username", "", "the connection username to use to connect to the datastore (overwrites any username provided in the connection uri)")
 
@@ -178,6 +187,26 @@ func NewRunCommand() *cobra.Command {
 
 	flags.Bool("datastore-metrics-enabled", defaultConfig.Datastore.Metrics.Enabled, "enable/disable sql metrics")
 
+	flags.Duration("datastore-dsql-occ-retry-initial-interval", defaultConfig.Datastore.DSQL.OCCRetry.InitialInterval, "the wait time before the first retry of a DSQL write that failed with an OCC conflict")
+
+	flags.Duration("datastore-dsql-occ-retry-max-interval", defaultConfig.Datastore.DSQL.OCCRetry.MaxInterval, "the maximum wait time between two retries of a DSQL write that failed with an OCC conflict")
+
+	flags.Duration("datastore-dsql-occ-retry-max-elapsed-time", defaultConfig.Datastore.DSQL.OCCRetry.MaxElapsedTime, "the total time after which a DSQL write that keeps failing with OCC conflicts is no longer retried")
+
+	flags.Int("datastore-dsql-occ-retry-max-attempts", defaultConfig.Datastore.DSQL.OCCRetry.MaxAttempts, "the maximum number of attempts, including the first one, for a DSQL write that fails with OCC conflicts (0 means only bounded by the max elapsed time)")
+
+	flags.Float64("datastore-dsql-occ-retry-jitter", defaultConfig.Datastore.DSQL.OCCRetry.Jitter, "the randomization factor, between 0 and 1, applied to the wait time between retries of a DSQL write")
+
+	flags.Int("datastore-dsql-max-rows-per-transaction", defaultConfig.Datastore.DSQL.MaxRowsPerTransaction, "the maximum number of rows, including secondary index entries, that a single DSQL transaction may modify. It bounds the number of tuples per write on the 'dsql' engine")
+
+	flags.Bool("datastore-remote-tls-enabled", defaultConfig.Datastore.Remote.TLS.Enabled, "enable/disable TLS on the connection to the datastore of the 'remote' engine")
+
+	flags.String("datastore-remote-tls-ca", defaultConfig.Datastore.Remote.TLS.CAPath, "the (absolute) file path of the CA certificates used to verify the datastore of the 'remote' engine. Defaults to the system certificate pool")
+
+	flags.String("datastore-remote-tls-cert", defaultConfig.Datastore.Remote.TLS.CertPath, "the (absolute) file path of the client certificate presented to the datastore of the 'remote' engine, when it requires mutual TLS")
+
+	flags.String("datastore-remote-tls-key", defaultConfig.Datastore.Remote.TLS.KeyPath, "the (absolute) file path of the client key presented to the datastore of the 'remote' engine, when it requires mutual TLS")
+
 	flags.Bool("playground-enabled", defaultConfig.Playground.Enabled, "enable/disable the OpenFGA Playground")
 
 	flags.Int("playground-port", defaultConfig.Playground.Port, "the port to serve the local OpenFGA Playground on")
@@ -230,6 +259,8 @@ func NewRunCommand() *cobra.Command {
 
 	flags.Int("changelog-horizon-offset", defaultConfig.ChangelogHorizonOffset, "the offset (in minutes) from the current time. Changes that occur after this offset will not be included in the response of ReadChanges")
 
+	flags.Duration("watch-poll-interval", defaultConfig.WatchPollInterval, "the interval at which a Watch stream reads the changelog once it has caught up with the latest changes")
+
 	flags.Uint32("resolve-node-limit", defaultConfig.ResolveNodeLimit, "maximum resolution depth to attempt before throwing an error (defines how deeply nested an authorization model can be before a query errors out).")
 
 	flags.Uint32("resolve-node-breadth-limit", defaultConfig.ResolveNodeBreadthLimit, "defines how many nodes on a given level can be evaluated concurrently in a Check resolution tree")
@@ -270,6 +301,24 @@ func NewRunCommand() *cobra.Command {
 
 	flags.Duration("cache-controller-ttl", defaultConfig.CacheController.TTL, "if cache controller is enabled, this is the minimum time interval for Check requests to trigger cache invalidation. List Objects requests may trigger invalidation even sooner if list objects iterator cache is enabled.")
 
+	flags.Bool("cache-controller-notifications-enabled", defaultConfig.CacheController.NotificationsEnabled, "if cache controller is enabled, broadcast an invalidation after every tuple write so that caches are invalidated at once rather than on the next changelog poll. Supported by the memory engine, within a single instance, and the postgres engine, across instances using LISTEN/NOTIFY.")
+
+	flags.Bool("changelog-retention-enabled", defaultConfig.ChangelogRetention.Enabled, "enable the periodic pruning of the changes of every store from the changelog, beyond changelog-retention-max-age and changelog-retention-max-changes. Reading the changes with a continuation token older than the pruned changes fails with an OutOfRange error.")
+
+	flags.Duration("changelog-retention-max-age", defaultConfig.ChangelogRetention.MaxAge, "if changelog retention is enabled, the changes older than this duration are pruned. 0 means the changes are not pruned by age.")
+
+	flags.Int("changelog-retention-max-changes", defaultConfig.ChangelogRetention.MaxChanges, "if changelog retention is enabled, the maximum number of most recent changes kept per store. 0 means the changes are not pruned by count.")
+
+	flags.Duration("changelog-retention-interval", defaultConfig.ChangelogRetention.Interval, "if changelog retention is enabled, the interval at which the changelog is pruned.")
+
+	flags.Int("changelog-retention-batch-size", defaultConfig.ChangelogRetention.BatchSize, "if changelog retention is enabled, the maximum number of changes deleted in a single statement.")
+
+	flags.Bool("tuple-expiry-reaper-enabled", defaultConfig.TupleExpiryReaper.Enabled, "enable the periodic deletion of the expired tuples of every store, along with a delete change in the changelog for each. Reads ignore expired tuples whether it is enabled or not.")
+
+	flags.Duration("tuple-expiry-reaper-interval", defaultConfig.TupleExpiryReaper.Interval, "if the tuple expiry reaper is enabled, the interval at which the expired tuples are deleted.")
+
+	flags.Int("tuple-expiry-reaper-batch-size", defaultConfig.TupleExpiryReaper.BatchSize, "if the tuple expiry reaper is enabled, the maximum number of expired tuples deleted in a single transaction.")
+
 	// Unfortunately UintSlice/IntSlice does not work well when used as environment variable, we need to stick with string slice and convert back to integer
 	flags.StringSlice("request-duration-datastore-query-count-buckets", defaultConfig.RequestDurationDatastoreQueryCountBuckets, "datastore query count buckets used in labelling request_duration_ms.")
 
@@ -432,6 +481,14 @@ func (s *ServerContext) datastoreConfig(config *serverconfig.Config) (storage.Op
 		sqlcommon.WithMinIdleConns(config.Datastore.MinIdleConns),
 		sqlcommon.WithConnMaxIdleTime(config.Datastore.ConnMaxIdleTime),
 		sqlcommon.WithConnMaxLifetime(config.Datastore.ConnMaxLifetime),
+		sqlcommon.WithOCCRetry(sqlcommon.OCCRetryConfig{
+			InitialInterval: config.Datastore.DSQL.OCCRetry.InitialInterval,
+			MaxInterval:     config.Datastore.DSQL.OCCRetry.MaxInterval,
+			MaxElapsedTime:  config.Datastore.DSQL.OCCRetry.MaxElapsedTime,
+			MaxAttempts:     config.Datastore.DSQL.OCCRetry.MaxAttempts,
+			Jitter:          config.Datastore.DSQL.OCCRetry.Jitter,
+		}),
+		sqlcommon.WithDSQLMaxRowsPerTransaction(config.Datastore.DSQL.MaxRowsPerTransaction),
 	}
 
 	if config.Datastore.Metrics.Enabled {
@@ -473,6 +530,23 @@ func (s *ServerContext) datastoreConfig(config *serverconfig.Config) (storage.Op
 		if err != nil {
 			return nil, nil, fmt.Errorf("initialize dsql datastore: %w", err)
 		}
+	case "remote":
+		opts := []remote.Option{
+			remote.WithLogger(s.Logger),
+			remote.WithMaxTuplesPerWrite(config.MaxTuplesPerWrite),
+			remote.WithMaxTypesPerAuthorizationModel(config.MaxTypesPerAuthorizationModel),
+		}
+		if tlsConfig := config.Datastore.Remote.TLS; tlsConfig.Enabled {
+			opts = append(opts, remote.WithTLS(remote.TLSConfig{
+				CAPath:   tlsConfig.CAPath,
+				CertPath: tlsConfig.CertPath,
+				KeyPath:  tlsConfig.KeyPath,
+			}))
+		}
+		datastore, err = remote.New(config.Datastore.URI, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("initialize remote datastore: %w", err)
+		}
 	default:
 		return nil, nil, fmt.Errorf("storage engine '%s' is unsupported", config.Datastore.Engine)
 	}
@@ -482,6 +556,62 @@ func (s *ServerContext) datastoreConfig(config *serverconfig.Config) (storage.Op
 	return datastore, tokenSerializer, nil
 }
 
+// invalidationNotifierConfig returns the notifier broadcasting the cache invalidations of datastore,
+// or nil when notifications are disabled.
+func (s *ServerContext) invalidationNotifierConfig(config *serverconfig.Config, datastore storage.OpenFGADatastore) (storage.InvalidationNotifier, error) {
+	if !config.CacheController.NotificationsEnabled {
+		return nil, nil
+	}
+
+	switch ds := datastore.(type) {
+	case *memory.MemoryBackend:
+		return storage.NewInProcessInvalidationNotifier(), nil
+	case *postgres.Datastore:
+		notifier, err := ds.NewInvalidationNotifier(postgres.DefaultInvalidationChannel)
+		if err != nil {
+			return nil, fmt.Errorf("initialize cache invalidation notifier: %w", err)
+		}
+		return notifier, nil
+	default:
+		return nil, fmt.Errorf("cache invalidation notifications are unsupported by the '%s' storage engine", config.Datastore.Engine)
+	}
+}
+
+// periodicTasksConfig returns the tasks run periodically against datastore in the background: the
+// janitor pruning the changelog and the reaper deleting the expired tuples, when they are enabled.
+func (s *ServerContext) periodicTasksConfig(config *serverconfig.Config, datastore storage.OpenFGADatastore) ([]*periodic.Runner, error) {
+	var tasks []*periodic.Runner
+
+	if config.ChangelogRetention.Enabled {
+		janitor, err := changelogretention.NewJanitor(datastore, storage.PruneChangesOptions{
+			MaxAge:     config.ChangelogRetention.MaxAge,
+			MaxChanges: config.ChangelogRetention.MaxChanges,
+			BatchSize:  config.ChangelogRetention.BatchSize,
+		}, config.ChangelogRetention.Interval, s.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("changelog retention is unsupported by the '%s' storage engine: %w", config.Datastore.Engine, err)
+		}
+		tasks = append(tasks, janitor)
+	}
+
+	if config.TupleExpiryReaper.Enabled {
+		reaper, err := tupleexpiry.NewReaper(datastore, config.TupleExpiryReaper.BatchSize, config.TupleExpiryReaper.Interval, s.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("tuple expiry is unsupported by the '%s' storage engine: %w", config.Datastore.Engine, err)
+		}
+		tasks = append(tasks, reaper)
+	}
+
+	return tasks, nil
+}
+
+// stopPeriodicTasks stops the tasks returned by periodicTasksConfig.
+func stopPeriodicTasks(tasks []*periodic.Runner) {
+	for _, task := range tasks {
+		task.Stop()
+	}
+}
+
 func (s *ServerContext) authenticatorConfig(config *serverconfig.Config) (authn.Authenticator, error) {
 	var authenticator authn.Authenticator
 	var err error
@@ -536,7 +666,17 @@ func (s *ServerContext) buildServerOpts(ctx context.Context, config *serverconfi
 		timeoutMiddleware := middleware.NewTimeoutInterceptor(config.RequestTimeout, s.Logger)
 
 		serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(timeoutMiddleware.NewUnaryTimeoutInterceptor()))
-		serverOpts = append(serverOpts, grpc.ChainStreamInterceptor(timeoutMiddleware.NewStreamTimeoutInterceptor()))
+		// Watch streams are meant to stay open, they end with the client or the server instead. Store
+		// clones last as long as the copy, which depends on the size of the store, and tuple imports
+		// as long as the client keeps sending tuples.
+		serverOpts = append(serverOpts, grpc.ChainStreamInterceptor(selector.StreamServerInterceptor(
+			timeoutMiddleware.NewStreamTimeoutInterceptor(),
+			selector.MatchFunc(func(_ context.Context, callMeta interceptors.CallMeta) bool {
+				return callMeta.FullMethod() != watchv1.WatchService_Watch_FullMethodName &&
+					callMeta.FullMethod() != adminv1.AdminService_CloneStore_FullMethodName &&
+					callMeta.FullMethod() != adminv1.AdminService_ImportTuples_FullMethodName
+			}),
+		)))
 	}
 
 	serverOpts = append(serverOpts,
@@ -651,11 +791,36 @@ func (s *ServerContext) runHTTPServer(ctx context.Context, config *serverconfig.
 		}),
 		runtime.WithHealthzEndpoint(healthv1pb.NewHealthClient(grpcConn)),
 		runtime.WithOutgoingHeaderMatcher(func(s string) (string, bool) { return s, true }),
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, server.TupleExpiresAtHeader) || strings.EqualFold(key, server.AsOfHeader) ||
+				strings.EqualFold(key, server.ExplainHeader) {
+				return key, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
 	}
 	mux := runtime.NewServeMux(muxOpts...)
 	if err := openfgav1.RegisterOpenFGAServiceHandler(ctx, mux, grpcConn); err != nil {
 		return nil, err
 	}
+	if err := mux.HandlePath(http.MethodGet, gateway.WatchPath, gateway.NewWatchHandler(mux, watchv1.NewWatchServiceClient(grpcConn))); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodPost, gateway.StreamedListUsersPath, gateway.NewStreamedListUsersHandler(mux, queryv1.NewQueryServiceClient(grpcConn))); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodPost, gateway.PaginatedListObjectsPath, gateway.NewPaginatedListObjectsHandler(mux, queryv1.NewQueryServiceClient(grpcConn))); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodPost, gateway.ListRelationsPath, gateway.NewListRelationsHandler(mux, queryv1.NewQueryServiceClient(grpcConn))); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodPost, gateway.MultiTypeListObjectsPath, gateway.NewMultiTypeListObjectsHandler(mux, queryv1.NewQueryServiceClient(grpcConn))); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodPost, gateway.StreamedMultiTypeListObjectsPath, gateway.NewStreamedMultiTypeListObjectsHandler(mux, queryv1.NewQueryServiceClient(grpcConn))); err != nil {
+		return nil, err
+	}
 	handler := http.Handler(mux)
 
 	if config.Trace.Enabled {
@@ -807,6 +972,21 @@ func (s *ServerContext) Run(ctx context.Context, config *serverconfig.Config) er
 		return err
 	}
 
+	invalidationNotifier, err := s.invalidationNotifierConfig(config, datastore)
+	if err != nil {
+		return err
+	}
+
+	periodicTasks, err := s.periodicTasksConfig(config, datastore)
+	if err != nil {
+		return err
+	}
+	for _, task := range periodicTasks {
+		task.Start()
+	}
+	// Stopped on shutdown, before the datastore is closed, or when starting the server fails.
+	defer stopPeriodicTasks(periodicTasks)
+
 	authenticator, err := s.authenticatorConfig(config)
 
 	if err != nil {
@@ -872,6 +1052,8 @@ func (s *ServerContext) Run(ctx context.Context, config *serverconfig.Config) er
 		server.WithResolveNodeLimit(config.ResolveNodeLimit),
 		server.WithResolveNodeBreadthLimit(config.ResolveNodeBreadthLimit),
 		server.WithChangelogHorizonOffset(config.ChangelogHorizonOffset),
+		server.WithChangelogRetention(config.ChangelogRetention.Enabled),
+		server.WithWatchPollInterval(config.WatchPollInterval),
 		server.WithListObjectsDeadline(config.ListObjectsDeadline),
 		server.WithListObjectsMaxResults(config.ListObjectsMaxResults),
 		server.WithListUsersDeadline(config.ListUsersDeadline),
@@ -881,6 +1063,7 @@ func (s *ServerContext) Run(ctx context.Context, config *serverconfig.Config) er
 		server.WithMaxConcurrentReadsForListUsers(config.MaxConcurrentReadsForListUsers),
 		server.WithCacheControllerEnabled(config.CacheController.Enabled),
 		server.WithCacheControllerTTL(config.CacheController.TTL),
+		server.WithInvalidationNotifier(invalidationNotifier),
 		server.WithCheckCacheLimit(config.CheckCache.Limit),
 		server.WithCheckIteratorCacheEnabled(config.CheckIteratorCache.Enabled),
 		server.WithCheckIteratorCacheMaxResults(config.CheckIteratorCache.MaxResults),
@@ -937,6 +1120,9 @@ func (s *ServerContext) Run(ctx context.Context, config *serverconfig.Config) er
 	// nosemgrep: grpc-server-insecure-connection
 	grpcServer := grpc.NewServer(serverOpts...)
 	openfgav1.RegisterOpenFGAServiceServer(grpcServer, svr)
+	watchv1.RegisterWatchServiceServer(grpcServer, svr)
+	adminv1.RegisterAdminServiceServer(grpcServer, svr)
+	queryv1.RegisterQueryServiceServer(grpcServer, svr)
 	healthServer := &health.Checker{TargetService: svr, TargetServiceName: openfgav1.OpenFGAService_ServiceDesc.ServiceName}
 	healthv1pb.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
@@ -1011,6 +1197,12 @@ func (s *ServerContext) Run(ctx context.Context, config *serverconfig.Config) er
 
 	grpcServer.GracefulStop()
 
+	if invalidationNotifier != nil {
+		invalidationNotifier.Close()
+	}
+
+	stopPeriodicTasks(periodicTasks)
+
 	svr.Close()
 
 	authenticator.Close()
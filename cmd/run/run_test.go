@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -49,6 +50,8 @@ import (
 	"github.com/openfga/openfga/pkg/server"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
 	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
 	"github.com/openfga/openfga/pkg/storage/sqlite"
 	storagefixtures "github.com/openfga/openfga/pkg/testfixtures/storage"
@@ -1088,6 +1091,34 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, val.Exists())
 	require.False(t, val.Bool())
 
+	val = res.Get("properties.datastore.properties.dsql.properties.occRetry.properties.initialInterval.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.String(), cfg.Datastore.DSQL.OCCRetry.InitialInterval.String())
+
+	val = res.Get("properties.datastore.properties.dsql.properties.occRetry.properties.maxInterval.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.String(), cfg.Datastore.DSQL.OCCRetry.MaxInterval.String())
+
+	val = res.Get("properties.datastore.properties.dsql.properties.occRetry.properties.maxElapsedTime.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.String(), cfg.Datastore.DSQL.OCCRetry.MaxElapsedTime.String())
+
+	val = res.Get("properties.datastore.properties.dsql.properties.occRetry.properties.maxAttempts.default")
+	require.True(t, val.Exists())
+	require.EqualValues(t, val.Int(), cfg.Datastore.DSQL.OCCRetry.MaxAttempts)
+
+	val = res.Get("properties.datastore.properties.dsql.properties.occRetry.properties.jitter.default")
+	require.True(t, val.Exists())
+	require.InDelta(t, val.Float(), cfg.Datastore.DSQL.OCCRetry.Jitter, 0)
+
+	val = res.Get("properties.datastore.properties.dsql.properties.maxRowsPerTransaction.default")
+	require.True(t, val.Exists())
+	require.EqualValues(t, val.Int(), cfg.Datastore.DSQL.MaxRowsPerTransaction)
+
+	val = res.Get("properties.datastore.properties.remote.properties.tls.properties.enabled.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.Bool(), cfg.Datastore.Remote.TLS.Enabled)
+
 	val = res.Get("properties.grpc.properties.addr.default")
 	require.True(t, val.Exists())
 	require.Equal(t, val.String(), cfg.GRPC.Addr)
@@ -1160,6 +1191,10 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, val.Exists())
 	require.EqualValues(t, val.Int(), cfg.ChangelogHorizonOffset)
 
+	val = res.Get("properties.watchPollInterval.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.String(), cfg.WatchPollInterval.String())
+
 	val = res.Get("properties.resolveNodeBreadthLimit.default")
 	require.True(t, val.Exists())
 	require.EqualValues(t, val.Int(), cfg.ResolveNodeBreadthLimit)
@@ -1268,6 +1303,46 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, val.Exists())
 	require.Equal(t, val.String(), cfg.CacheController.TTL.String())
 
+	val = res.Get("properties.cacheController.properties.notificationsEnabled.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.Bool(), cfg.CacheController.NotificationsEnabled)
+
+	val = res.Get("properties.changelogRetention.properties.enabled.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.Bool(), cfg.ChangelogRetention.Enabled)
+
+	val = res.Get("properties.changelogRetention.properties.maxAge.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.String(), cfg.ChangelogRetention.MaxAge.String())
+
+	val = res.Get("properties.changelogRetention.properties.maxChanges.default")
+	require.True(t, val.Exists())
+	require.EqualValues(t, val.Int(), cfg.ChangelogRetention.MaxChanges)
+
+	val = res.Get("properties.changelogRetention.properties.interval.default")
+	require.True(t, val.Exists())
+	interval, err := time.ParseDuration(val.String())
+	require.NoError(t, err)
+	require.Equal(t, interval, cfg.ChangelogRetention.Interval)
+
+	val = res.Get("properties.changelogRetention.properties.batchSize.default")
+	require.True(t, val.Exists())
+	require.EqualValues(t, val.Int(), cfg.ChangelogRetention.BatchSize)
+
+	val = res.Get("properties.tupleExpiryReaper.properties.enabled.default")
+	require.True(t, val.Exists())
+	require.Equal(t, val.Bool(), cfg.TupleExpiryReaper.Enabled)
+
+	val = res.Get("properties.tupleExpiryReaper.properties.interval.default")
+	require.True(t, val.Exists())
+	interval, err = time.ParseDuration(val.String())
+	require.NoError(t, err)
+	require.Equal(t, interval, cfg.TupleExpiryReaper.Interval)
+
+	val = res.Get("properties.tupleExpiryReaper.properties.batchSize.default")
+	require.True(t, val.Exists())
+	require.EqualValues(t, val.Int(), cfg.TupleExpiryReaper.BatchSize)
+
 	val = res.Get("properties.sharedIterator.properties.enabled.default")
 	require.True(t, val.Exists())
 	require.Equal(t, val.Bool(), cfg.SharedIterator.Enabled)
@@ -1665,6 +1740,24 @@ func TestServerContext_datastoreConfig(t *testing.T) {
 			wantSerializer: nil,
 			wantErr:        errors.New("parse postgres connection uri"),
 		},
+		{
+			name: "remote_client_cert_without_key",
+			config: &serverconfig.Config{
+				Datastore: serverconfig.DatastoreConfig{
+					Engine: "remote",
+					URI:    "dns:///localhost:8080",
+					Remote: serverconfig.DatastoreRemoteConfig{
+						TLS: serverconfig.DatastoreRemoteTLSConfig{
+							Enabled:  true,
+							CertPath: "/path/to/client.crt",
+						},
+					},
+				},
+			},
+			wantDSType:     nil,
+			wantSerializer: nil,
+			wantErr:        errors.New("load remote datastore client certificate"),
+		},
 		{
 			name: "unsupported_engine",
 			config: &serverconfig.Config{
@@ -1696,3 +1789,33 @@ func TestServerContext_datastoreConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServerContext_invalidationNotifierConfig(t *testing.T) {
+	s := &ServerContext{
+		Logger: logger.NewNoopLogger(),
+	}
+	config := serverconfig.DefaultConfig()
+
+	t.Run("disabled", func(t *testing.T) {
+		notifier, err := s.invalidationNotifierConfig(config, memory.New())
+		require.NoError(t, err)
+		require.Nil(t, notifier)
+	})
+
+	config.CacheController.Enabled = true
+	config.CacheController.NotificationsEnabled = true
+
+	t.Run("memory", func(t *testing.T) {
+		notifier, err := s.invalidationNotifierConfig(config, memory.New())
+		require.NoError(t, err)
+		require.IsType(t, &storage.InProcessInvalidationNotifier{}, notifier)
+		notifier.Close()
+	})
+
+	t.Run("unsupported_engine", func(t *testing.T) {
+		config.Datastore.Engine = "mysql"
+		notifier, err := s.invalidationNotifierConfig(config, mocks.NewMockOpenFGADatastore(gomock.NewController(t)))
+		require.ErrorContains(t, err, "cache invalidation notifications are unsupported by the 'mysql' storage engine")
+		require.Nil(t, notifier)
+	})
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openfga/openfga/cmd/util"
+)
+
+func addDatastoreFlags(flags *pflag.FlagSet) {
+	flags.String(datastoreEngineFlag, "", "the datastore engine")
+	flags.String(datastoreURIFlag, "", "the connection uri to the datastore")
+	flags.String(datastoreUsernameFlag, "", "(optional) overwrite the username in the connection string")
+	flags.String(datastorePasswordFlag, "", "(optional) overwrite the password in the connection string")
+	util.AddDSQLFlags(flags)
+}
+
+// bindDatastoreFlags binds the datastore flags added by addDatastoreFlags to the equivalent config
+// value being managed by viper.
+func bindDatastoreFlags(flags *pflag.FlagSet) {
+	util.MustBindPFlag(datastoreEngineFlag, flags.Lookup(datastoreEngineFlag))
+	util.MustBindEnv(datastoreEngineFlag, "OPENFGA_DATASTORE_ENGINE")
+
+	util.MustBindPFlag(datastoreURIFlag, flags.Lookup(datastoreURIFlag))
+	util.MustBindEnv(datastoreURIFlag, "OPENFGA_DATASTORE_URI")
+
+	util.MustBindPFlag(datastoreUsernameFlag, flags.Lookup(datastoreUsernameFlag))
+	util.MustBindEnv(datastoreUsernameFlag, "OPENFGA_DATASTORE_USERNAME")
+
+	util.MustBindPFlag(datastorePasswordFlag, flags.Lookup(datastorePasswordFlag))
+	util.MustBindEnv(datastorePasswordFlag, "OPENFGA_DATASTORE_PASSWORD")
+
+	util.BindDSQLFlags(flags)
+}
+
+// bindExportFlagsFunc binds the cobra cmd flags to the equivalent config value being managed
+// by viper. This bridges the config between cobra flags and viper flags.
+func bindExportFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		bindDatastoreFlags(flags)
+		util.MustBindPFlag(storeIDFlag, flags.Lookup(storeIDFlag))
+		util.MustBindPFlag(fileFlag, flags.Lookup(fileFlag))
+		util.MustBindPFlag(tuplesPerChunkFlag, flags.Lookup(tuplesPerChunkFlag))
+	}
+}
+
+// bindImportFlagsFunc binds the cobra cmd flags to the equivalent config value being managed
+// by viper. This bridges the config between cobra flags and viper flags.
+func bindImportFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		bindDatastoreFlags(flags)
+		util.MustBindPFlag(storeIDFlag, flags.Lookup(storeIDFlag))
+		util.MustBindPFlag(storeNameFlag, flags.Lookup(storeNameFlag))
+		util.MustBindPFlag(fileFlag, flags.Lookup(fileFlag))
+		util.MustBindPFlag(preserveModelIDsFlag, flags.Lookup(preserveModelIDsFlag))
+		util.MustBindPFlag(checkpointFlag, flags.Lookup(checkpointFlag))
+	}
+}
+
+// bindCloneFlagsFunc binds the cobra cmd flags to the equivalent config value being managed
+// by viper. This bridges the config between cobra flags and viper flags.
+func bindCloneFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		bindDatastoreFlags(flags)
+		util.MustBindPFlag(storeIDFlag, flags.Lookup(storeIDFlag))
+		util.MustBindPFlag(storeNameFlag, flags.Lookup(storeNameFlag))
+		util.MustBindPFlag(batchSizeFlag, flags.Lookup(batchSizeFlag))
+	}
+}
@@ -0,0 +1,361 @@
+// Package store contains the commands to export, import and clone stores.
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/cmd/util"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/archive"
+	"github.com/openfga/openfga/pkg/storage/sqlcommon"
+)
+
+const (
+	datastoreEngineFlag   = "datastore-engine"
+	datastoreURIFlag      = "datastore-uri"
+	datastoreUsernameFlag = "datastore-username"
+	datastorePasswordFlag = "datastore-password"
+	storeIDFlag           = "store-id"
+	storeNameFlag         = "store-name"
+	fileFlag              = "file"
+	tuplesPerChunkFlag    = "tuples-per-chunk"
+	preserveModelIDsFlag  = "preserve-model-ids"
+	checkpointFlag        = "checkpoint"
+	batchSizeFlag         = "batch-size"
+
+	// stdio is the value of --file reading the archive from stdin, or writing it to stdout.
+	stdio = "-"
+)
+
+func NewStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Export, import and clone stores",
+		Long: "The store command is used to move stores between environments and datastore engines, " +
+			"through a portable archive holding the store metadata, all the versions of its authorization model " +
+			"along with their assertions, and its tuples. It also copies stores within a datastore, for instance " +
+			"to try a new authorization model against a copy of the production tuples.",
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewExportCommand())
+	cmd.AddCommand(NewImportCommand())
+	cmd.AddCommand(NewCloneCommand())
+
+	return cmd
+}
+
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "export",
+		Short:        "Export a store to an archive",
+		Long:         "Write the archive of a store, a gzip-compressed tar file, to --file or to stdout.",
+		RunE:         runExport,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+
+	flags := cmd.Flags()
+	addDatastoreFlags(flags)
+	flags.String(storeIDFlag, "", "(required) the ID of the store to export")
+	flags.String(fileFlag, stdio, "the file to write the archive to ('-' for stdout)")
+	flags.Int(tuplesPerChunkFlag, archive.DefaultTuplesPerChunk, "the number of tuples per entry of the archive, which bounds the memory used by the export and the import")
+
+	// NOTE: if you add a new flag here, update the function below, too
+
+	cmd.PreRun = bindExportFlagsFunc(flags)
+
+	return cmd
+}
+
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a store from an archive",
+		Long: "Read the archive of a store written by 'openfga store export' from --file or from stdin, and create the store it holds. " +
+			"With --checkpoint, the progress of the import is recorded in that file, and running the import again with " +
+			"the same checkpoint resumes an interrupted import.",
+		RunE:         runImport,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+
+	flags := cmd.Flags()
+	addDatastoreFlags(flags)
+	flags.String(storeIDFlag, "", "the ID of the imported store (defaults to the ID of the exported store)")
+	flags.String(storeNameFlag, "", "the name of the imported store (defaults to the name of the exported store)")
+	flags.String(fileFlag, stdio, "the file to read the archive from ('-' for stdin)")
+	flags.Bool(preserveModelIDsFlag, true, "import the authorization models with their exported IDs, rather than new IDs")
+	flags.String(checkpointFlag, "", "the file recording the progress of the import, to resume it if it is interrupted")
+
+	// NOTE: if you add a new flag here, update the function below, too
+
+	cmd.PreRun = bindImportFlagsFunc(flags)
+
+	return cmd
+}
+
+func NewCloneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clone",
+		Short: "Copy a store into a new store of the same datastore",
+		Long: "Create a store holding a copy of the authorization models, the assertions and the tuples of --store-id, " +
+			"but not of its changelog. The copy is made by the datastore, in batches, and the number of tuples copied " +
+			"so far is reported after every batch. The source store should not be written to during the copy, as " +
+			"those writes may or may not be copied.",
+		RunE:         runClone,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+	}
+
+	flags := cmd.Flags()
+	addDatastoreFlags(flags)
+	flags.String(storeIDFlag, "", "(required) the ID of the store to clone")
+	flags.String(storeNameFlag, "", "the name of the created store (defaults to the name of the cloned store)")
+	flags.Int(batchSizeFlag, storage.DefaultCloneStoreBatchSize, "the maximum number of rows copied at once")
+
+	// NOTE: if you add a new flag here, update the function below, too
+
+	cmd.PreRun = bindCloneFlagsFunc(flags)
+
+	return cmd
+}
+
+func runExport(cmd *cobra.Command, _ []string) error {
+	storeID := viper.GetString(storeIDFlag)
+	file := viper.GetString(fileFlag)
+	options := archive.ExportOptions{
+		TuplesPerChunk: viper.GetInt(tuplesPerChunkFlag),
+	}
+
+	if storeID == "" {
+		return fmt.Errorf("'%s' is required", storeIDFlag)
+	}
+	if options.TuplesPerChunk <= 0 {
+		return fmt.Errorf("'%s' must be greater than zero", tuplesPerChunkFlag)
+	}
+
+	ctx := cmdContext(cmd)
+	db, err := openDatastore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if file == stdio {
+		_, err := archive.Export(ctx, db, storeID, cmd.OutOrStdout(), options)
+		if err != nil {
+			return fmt.Errorf("failed to export store %s: %w", storeID, err)
+		}
+		return nil
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	stats, err := archive.Export(ctx, db, storeID, f, options)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to export store %s: %w", storeID, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "exported %d models and %d tuples of store %s\n", stats.Models, stats.Tuples, storeID)
+	return nil
+}
+
+func runImport(cmd *cobra.Command, _ []string) error {
+	file := viper.GetString(fileFlag)
+	checkpointFile := viper.GetString(checkpointFlag)
+	options := archive.ImportOptions{
+		StoreID:          viper.GetString(storeIDFlag),
+		StoreName:        viper.GetString(storeNameFlag),
+		PreserveModelIDs: viper.GetBool(preserveModelIDsFlag),
+	}
+
+	var checkpoint archive.Checkpoint
+	if checkpointFile != "" {
+		resume, err := readCheckpoint(checkpointFile)
+		if err != nil {
+			return err
+		}
+		options.Resume = resume
+		options.OnCheckpoint = func(c archive.Checkpoint) error {
+			checkpoint = c
+			return writeCheckpoint(checkpointFile, c)
+		}
+	} else {
+		options.OnCheckpoint = func(c archive.Checkpoint) error {
+			checkpoint = c
+			return nil
+		}
+	}
+
+	ctx := cmdContext(cmd)
+	db, err := openDatastore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	r := cmd.InOrStdin()
+	if file != stdio {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	stats, err := archive.Import(ctx, db, r, options)
+	if err != nil {
+		if checkpointFile != "" && checkpoint.StoreID != "" {
+			return fmt.Errorf("failed to import store %s, run the import again to resume it: %w", checkpoint.StoreID, err)
+		}
+		return fmt.Errorf("failed to import the store: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "imported %d models and %d tuples into store %s\n", stats.Models, stats.Tuples, checkpoint.StoreID)
+	if !options.PreserveModelIDs {
+		for _, from := range slices.Sorted(maps.Keys(checkpoint.ModelIDs)) {
+			fmt.Fprintf(cmd.OutOrStdout(), "authorization model %s imported as %s\n", from, checkpoint.ModelIDs[from])
+		}
+	}
+	return nil
+}
+
+func runClone(cmd *cobra.Command, _ []string) error {
+	storeID := viper.GetString(storeIDFlag)
+	storeName := viper.GetString(storeNameFlag)
+	batchSize := viper.GetInt(batchSizeFlag)
+
+	if storeID == "" {
+		return fmt.Errorf("'%s' is required", storeIDFlag)
+	}
+	if batchSize <= 0 {
+		return fmt.Errorf("'%s' must be greater than zero", batchSizeFlag)
+	}
+
+	ctx := cmdContext(cmd)
+	db, err := openDatastore(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	cloner, ok := db.(storage.StoreCloner)
+	if !ok {
+		return fmt.Errorf("the '%s' datastore engine does not support cloning stores", viper.GetString(datastoreEngineFlag))
+	}
+
+	if storeName == "" {
+		source, err := db.GetStore(ctx, storeID)
+		if err != nil {
+			return fmt.Errorf("failed to clone store %s: %w", storeID, err)
+		}
+		storeName = source.GetName()
+	}
+
+	copied := 0
+	targetID := ulid.Make().String()
+	store, err := cloner.CloneStore(ctx, storeID, &openfgav1.Store{
+		Id:   targetID,
+		Name: storeName,
+	}, storage.CloneStoreOptions{
+		BatchSize: batchSize,
+		OnProgress: func(copiedTuples int) {
+			copied = copiedTuples
+			fmt.Fprintf(cmd.ErrOrStderr(), "copied %d tuples\n", copiedTuples)
+		},
+	})
+	if err != nil {
+		// Do not leave a partial copy behind.
+		if !errors.Is(err, storage.ErrNotFound) && !errors.Is(err, storage.ErrCollision) {
+			if deleteErr := db.DeleteStore(context.WithoutCancel(ctx), targetID); deleteErr != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "failed to delete the partial copy %s: %v\n", targetID, deleteErr)
+			}
+		}
+		return fmt.Errorf("failed to clone store %s: %w", storeID, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "cloned store %s into store %s with %d tuples\n", storeID, store.GetId(), copied)
+	return nil
+}
+
+// readCheckpoint returns the checkpoint saved in file, or nil if there is none.
+func readCheckpoint(file string) (*archive.Checkpoint, error) {
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint archive.Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", file, err)
+	}
+	return &checkpoint, nil
+}
+
+// writeCheckpoint replaces the checkpoint saved in file, atomically so that an interrupted write
+// does not lose the progress of the import.
+func writeCheckpoint(file string, checkpoint archive.Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+func cmdContext(cmd *cobra.Command) context.Context {
+	if ctx := cmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+func openDatastore(ctx context.Context) (storage.OpenFGADatastore, error) {
+	engine := viper.GetString(datastoreEngineFlag)
+	if engine == "memory" {
+		// The memory datastore only lives in the process serving it.
+		return nil, fmt.Errorf("storage engine '%s' is unsupported, as its stores can not be accessed from another process", engine)
+	}
+
+	cfg := sqlcommon.NewConfig(
+		sqlcommon.WithUsername(viper.GetString(datastoreUsernameFlag)),
+		sqlcommon.WithPassword(viper.GetString(datastorePasswordFlag)),
+	)
+	return util.OpenDatastore(ctx, engine, viper.GetString(datastoreURIFlag), cfg)
+}
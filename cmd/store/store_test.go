@@ -0,0 +1,186 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/cmd"
+	"github.com/openfga/openfga/cmd/util"
+	"github.com/openfga/openfga/pkg/storage/archive"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+func executeStoreCommand(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	util.PrepareTempConfigDir(t)
+
+	var out bytes.Buffer
+	storeCmd := NewStoreCommand()
+	storeCmd.SetOut(&out)
+
+	cmd := cmd.NewRootCommand()
+	cmd.AddCommand(storeCmd)
+	cmd.SetArgs(append([]string{"store"}, args...))
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+// datastoreArgs bootstraps a sqlite datastore, and returns the flags of the store commands to connect
+// to it.
+func datastoreArgs(t *testing.T, args ...string) []string {
+	t.Helper()
+	_, _, uri := util.MustBootstrapDatastore(t, "sqlite")
+	return append([]string{"--datastore-engine", "sqlite", "--datastore-uri", uri}, args...)
+}
+
+// writeArchive writes the archive of a store with one tuple to a file, and returns the file and the
+// store ID.
+func writeArchive(t *testing.T) (string, string) {
+	t.Helper()
+	ctx := context.Background()
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store, err := ds.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "exported"})
+	require.NoError(t, err)
+	require.NoError(t, ds.Write(ctx, store.GetId(), nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+	}))
+
+	file := filepath.Join(t.TempDir(), "store.tar.gz")
+	f, err := os.Create(file)
+	require.NoError(t, err)
+	_, err = archive.Export(ctx, ds, store.GetId(), f, archive.ExportOptions{})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	return file, store.GetId()
+}
+
+func TestExportCommand(t *testing.T) {
+	t.Run("missing_store_id", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "export", "--datastore-engine", "memory")
+		require.ErrorContains(t, err, "'store-id' is required")
+	})
+
+	t.Run("invalid_engine", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "export", "--datastore-engine", "unknown", "--store-id", ulid.Make().String())
+		require.ErrorContains(t, err, "storage engine 'unknown' is unsupported")
+	})
+
+	t.Run("memory_engine", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "export", "--datastore-engine", "memory", "--store-id", ulid.Make().String())
+		require.ErrorContains(t, err, "storage engine 'memory' is unsupported")
+	})
+
+	t.Run("store_not_found", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		_, err := executeStoreCommand(t, append([]string{"export"}, datastoreArgs(t, "--store-id", storeID,
+			"--file", filepath.Join(t.TempDir(), "store.tar.gz"))...)...)
+		require.ErrorContains(t, err, "failed to export store "+storeID)
+	})
+}
+
+func TestImportCommand(t *testing.T) {
+	file, storeID := writeArchive(t)
+
+	t.Run("import", func(t *testing.T) {
+		out, err := executeStoreCommand(t, append([]string{"import"}, datastoreArgs(t, "--file", file)...)...)
+		require.NoError(t, err)
+		require.Equal(t, "imported 0 models and 1 tuples into store "+storeID+"\n", out)
+	})
+
+	t.Run("remap_store_id", func(t *testing.T) {
+		newStoreID := ulid.Make().String()
+		out, err := executeStoreCommand(t, append([]string{"import"}, datastoreArgs(t, "--file", file, "--store-id", newStoreID)...)...)
+		require.NoError(t, err)
+		require.Equal(t, "imported 0 models and 1 tuples into store "+newStoreID+"\n", out)
+	})
+
+	t.Run("checkpoint", func(t *testing.T) {
+		checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+		_, err := executeStoreCommand(t, append([]string{"import"}, datastoreArgs(t, "--file", file, "--checkpoint", checkpointFile)...)...)
+		require.NoError(t, err)
+
+		checkpoint, err := readCheckpoint(checkpointFile)
+		require.NoError(t, err)
+		require.Equal(t, &archive.Checkpoint{StoreID: storeID, Entry: "tuples/00000001.ndjson"}, checkpoint)
+	})
+
+	t.Run("memory_engine", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "import", "--datastore-engine", "memory", "--file", file)
+		require.ErrorContains(t, err, "storage engine 'memory' is unsupported")
+	})
+
+	t.Run("invalid_archive", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "invalid.tar.gz")
+		require.NoError(t, os.WriteFile(invalid, []byte("invalid"), 0o600))
+
+		_, err := executeStoreCommand(t, append([]string{"import"}, datastoreArgs(t, "--file", invalid)...)...)
+		require.ErrorIs(t, err, archive.ErrInvalidArchive)
+	})
+}
+
+func TestCloneCommand(t *testing.T) {
+	t.Run("missing_store_id", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "clone", "--datastore-engine", "memory")
+		require.ErrorContains(t, err, "'store-id' is required")
+	})
+
+	t.Run("invalid_batch_size", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "clone", "--datastore-engine", "memory", "--store-id", ulid.Make().String(), "--batch-size", "0")
+		require.ErrorContains(t, err, "'batch-size' must be greater than zero")
+	})
+
+	t.Run("memory_engine", func(t *testing.T) {
+		_, err := executeStoreCommand(t, "clone", "--datastore-engine", "memory", "--store-id", ulid.Make().String())
+		require.ErrorContains(t, err, "storage engine 'memory' is unsupported")
+	})
+
+	t.Run("store_not_found", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		_, err := executeStoreCommand(t, append([]string{"clone"}, datastoreArgs(t, "--store-id", storeID, "--store-name", "staging")...)...)
+		require.ErrorContains(t, err, "failed to clone store "+storeID)
+	})
+}
+
+func TestReadCheckpointNotFound(t *testing.T) {
+	checkpoint, err := readCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+}
+
+func TestImportCommandNoConfigDefaultValues(t *testing.T) {
+	util.PrepareTempConfigDir(t)
+	importCommand := NewImportCommand()
+	importCommand.RunE = func(cmd *cobra.Command, _ []string) error {
+		require.Empty(t, viper.GetString(datastoreEngineFlag))
+		require.Empty(t, viper.GetString(storeIDFlag))
+		require.Empty(t, viper.GetString(storeNameFlag))
+		require.Equal(t, stdio, viper.GetString(fileFlag))
+		require.True(t, viper.GetBool(preserveModelIDsFlag))
+		require.Empty(t, viper.GetString(checkpointFlag))
+		return nil
+	}
+
+	storeCmd := NewStoreCommand()
+	storeCmd.RemoveCommand(storeCmd.Commands()...)
+	storeCmd.AddCommand(importCommand)
+
+	cmd := cmd.NewRootCommand()
+	cmd.AddCommand(storeCmd)
+	cmd.SetArgs([]string{"store", "import"})
+	require.NoError(t, cmd.Execute())
+}
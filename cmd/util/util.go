@@ -3,14 +3,18 @@
 package util
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/openfga/openfga/internal/dsql"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/memory"
 	"github.com/openfga/openfga/pkg/storage/mysql"
@@ -20,6 +24,16 @@ import (
 	storagefixtures "github.com/openfga/openfga/pkg/testfixtures/storage"
 )
 
+// The flags configuring the IAM authentication tokens of the 'dsql' engine, shared by the commands
+// connecting to the datastore.
+const (
+	DatastoreDSQLAWSProfileFlag     = "datastore-dsql-aws-profile"
+	DatastoreDSQLRoleARNFlag        = "datastore-dsql-role-arn"
+	DatastoreDSQLRoleSessionFlag    = "datastore-dsql-role-session-name"
+	DatastoreDSQLRoleExternalIDFlag = "datastore-dsql-role-external-id"
+	DatastoreDSQLTokenLifetimeFlag  = "datastore-dsql-token-lifetime"
+)
+
 // MustBindPFlag attempts to bind a specific key to a pflag (as used by cobra) and panics
 // if the binding fails with a non-nil error.
 func MustBindPFlag(key string, flag *pflag.Flag) {
@@ -62,7 +76,7 @@ func MustBootstrapDatastore(t testing.TB, engine string) (storagefixtures.Datast
 	switch engine {
 	case "memory":
 		ds = memory.New()
-	case "postgres":
+	case "postgres", "dsql":
 		ds, err = postgres.New(uri, cfg)
 	case "mysql":
 		ds, err = mysql.New(uri, cfg)
@@ -77,6 +91,98 @@ func MustBootstrapDatastore(t testing.TB, engine string) (storagefixtures.Datast
 	return container, ds, uri
 }
 
+// AddDSQLFlags adds to flags the flags configuring the IAM authentication tokens of the 'dsql' engine.
+func AddDSQLFlags(flags *pflag.FlagSet) {
+	flags.String(DatastoreDSQLAWSProfileFlag, "", "the AWS shared config profile whose credentials generate the IAM authentication tokens of the 'dsql' engine (default credential chain if omitted)")
+	flags.String(DatastoreDSQLRoleARNFlag, "", "an IAM role to assume to generate the IAM authentication tokens of the 'dsql' engine")
+	flags.String(DatastoreDSQLRoleSessionFlag, "", "the session name used when assuming the role set by --"+DatastoreDSQLRoleARNFlag)
+	flags.String(DatastoreDSQLRoleExternalIDFlag, "", "the external id used when assuming the role set by --"+DatastoreDSQLRoleARNFlag)
+	flags.Duration(DatastoreDSQLTokenLifetimeFlag, dsql.DefaultTokenLifetime, "how long the IAM authentication tokens of the 'dsql' engine are valid; new tokens are generated before they expire")
+}
+
+// BindDSQLFlags binds the flags added by AddDSQLFlags to the equivalent config value being managed
+// by viper, and to their environment variables.
+func BindDSQLFlags(flags *pflag.FlagSet) {
+	MustBindPFlag(DatastoreDSQLAWSProfileFlag, flags.Lookup(DatastoreDSQLAWSProfileFlag))
+	MustBindEnv(DatastoreDSQLAWSProfileFlag, "OPENFGA_DATASTORE_DSQL_AWS_PROFILE")
+
+	MustBindPFlag(DatastoreDSQLRoleARNFlag, flags.Lookup(DatastoreDSQLRoleARNFlag))
+	MustBindEnv(DatastoreDSQLRoleARNFlag, "OPENFGA_DATASTORE_DSQL_ROLE_ARN")
+
+	MustBindPFlag(DatastoreDSQLRoleSessionFlag, flags.Lookup(DatastoreDSQLRoleSessionFlag))
+	MustBindEnv(DatastoreDSQLRoleSessionFlag, "OPENFGA_DATASTORE_DSQL_ROLE_SESSION_NAME")
+
+	MustBindPFlag(DatastoreDSQLRoleExternalIDFlag, flags.Lookup(DatastoreDSQLRoleExternalIDFlag))
+	MustBindEnv(DatastoreDSQLRoleExternalIDFlag, "OPENFGA_DATASTORE_DSQL_ROLE_EXTERNAL_ID")
+
+	MustBindPFlag(DatastoreDSQLTokenLifetimeFlag, flags.Lookup(DatastoreDSQLTokenLifetimeFlag))
+	MustBindEnv(DatastoreDSQLTokenLifetimeFlag, "OPENFGA_DATASTORE_DSQL_TOKEN_LIFETIME")
+}
+
+// DSQLTokenConfig returns the configuration of the IAM authentication tokens of the 'dsql' engine
+// set by the flags added by AddDSQLFlags.
+func DSQLTokenConfig() dsql.TokenConfig {
+	return dsql.TokenConfig{
+		Profile:         viper.GetString(DatastoreDSQLAWSProfileFlag),
+		RoleARN:         viper.GetString(DatastoreDSQLRoleARNFlag),
+		RoleSessionName: viper.GetString(DatastoreDSQLRoleSessionFlag),
+		RoleExternalID:  viper.GetString(DatastoreDSQLRoleExternalIDFlag),
+		Lifetime:        viper.GetDuration(DatastoreDSQLTokenLifetimeFlag),
+	}
+}
+
+// OpenDatastore opens a connection to the datastore of the given engine, for the commands working
+// directly against the datastore of a server.
+func OpenDatastore(ctx context.Context, engine, uri string, cfg *sqlcommon.Config) (storage.OpenFGADatastore, error) {
+	var (
+		db  storage.OpenFGADatastore
+		err error
+	)
+	switch engine {
+	case "memory":
+		db = memory.New()
+	case "mysql":
+		db, err = mysql.New(uri, cfg)
+	case "postgres":
+		db, err = postgres.New(uri, cfg)
+	case "dsql":
+		db, err = newDSQLDatastore(ctx, uri, cfg)
+	case "sqlite":
+		db, err = sqlite.New(uri, cfg)
+	case "":
+		return nil, fmt.Errorf("missing datastore engine type")
+	default:
+		return nil, fmt.Errorf("storage engine '%s' is unsupported", engine)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a connection to the datastore: %w", err)
+	}
+	return db, nil
+}
+
+// newDSQLDatastore connects to DSQL with IAM authentication tokens generated with the AWS identity
+// configured by the flags added by AddDSQLFlags, and refreshed before they expire so that long
+// running commands keep working.
+func newDSQLDatastore(ctx context.Context, uri string, cfg *sqlcommon.Config) (storage.OpenFGADatastore, error) {
+	poolCfg, err := dsql.PoolConfig(ctx, uri, cfg.Username, DSQLTokenConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := postgres.NewDSQLWithDB(pool, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return ds, nil
+}
+
 func PrepareTempConfigDir(t *testing.T) string {
 	_, err := os.Stat("/etc/openfga/config.yaml")
 	require.ErrorIs(t, err, os.ErrNotExist, "Config file at /etc/openfga/config.yaml would disturb test result.")
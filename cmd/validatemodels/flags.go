@@ -13,5 +13,11 @@ func bindRunFlagsFunc(flags *pflag.FlagSet) func(*cobra.Command, []string) {
 	return func(cmd *cobra.Command, args []string) {
 		util.MustBindPFlag(datastoreEngineFlag, flags.Lookup(datastoreEngineFlag))
 		util.MustBindPFlag(datastoreURIFlag, flags.Lookup(datastoreURIFlag))
+		util.MustBindPFlag(datastoreUsernameFlag, flags.Lookup(datastoreUsernameFlag))
+		util.BindDSQLFlags(flags)
+		util.MustBindPFlag(concurrencyFlag, flags.Lookup(concurrencyFlag))
+		util.MustBindPFlag(outputFlag, flags.Lookup(outputFlag))
+		util.MustBindPFlag(storeIDFlag, flags.Lookup(storeIDFlag))
+		util.MustBindPFlag(latestOnlyFlag, flags.Lookup(latestOnlyFlag))
 	}
 }
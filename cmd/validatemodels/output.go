@@ -0,0 +1,143 @@
+package validatemodels
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+const (
+	outputJSON  = "json"
+	outputJSONL = "jsonl"
+	outputJUnit = "junit"
+	outputTable = "table"
+)
+
+var outputFormats = []string{outputJSON, outputJSONL, outputJUnit, outputTable}
+
+// writeResults writes the validation results to w in the given output format.
+func writeResults(w io.Writer, format string, results []validationResult) error {
+	switch format {
+	case outputJSON:
+		return writeJSON(w, results)
+	case outputJSONL:
+		return writeJSONL(w, results)
+	case outputJUnit:
+		return writeJUnit(w, results)
+	case outputTable:
+		return writeTable(w, results)
+	default:
+		return fmt.Errorf("unknown output format '%s'", format)
+	}
+}
+
+func writeJSON(w io.Writer, results []validationResult) error {
+	marshalled, err := json.MarshalIndent(results, " ", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(marshalled))
+	return err
+}
+
+// writeJSONL writes one JSON object per result and line.
+func writeJSONL(w io.Writer, results []validationResult) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, result := range results {
+		if err := encoder.Encode(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit writes the results as a JUnit XML report, with one test suite per store and one
+// test case per model.
+func writeJUnit(w io.Writer, results []validationResult) error {
+	report := junitTestSuites{Name: "validate-models"}
+	for _, result := range results {
+		if len(report.Suites) == 0 || report.Suites[len(report.Suites)-1].Name != result.StoreID {
+			report.Suites = append(report.Suites, junitTestSuite{Name: result.StoreID})
+		}
+		suite := &report.Suites[len(report.Suites)-1]
+
+		testCase := junitTestCase{Name: result.ModelID, ClassName: result.StoreID}
+		if result.IsLatestModel {
+			testCase.Name += " (latest)"
+		}
+		if result.Error != "" {
+			testCase.Failure = &junitFailure{Message: "invalid authorization model", Text: result.Error}
+			suite.Failures++
+			report.Failures++
+		}
+		suite.Cases = append(suite.Cases, testCase)
+		suite.Tests++
+		report.Tests++
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// writeTable writes the results as a table, followed by a summary line.
+func writeTable(w io.Writer, results []validationResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STORE ID\tMODEL ID\tLATEST\tERROR")
+
+	invalid, invalidLatest := 0, 0
+	for _, result := range results {
+		errMsg := "-"
+		if result.Error != "" {
+			errMsg = result.Error
+			invalid++
+			if result.IsLatestModel {
+				invalidLatest++
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%t\t%s\n", result.StoreID, result.ModelID, result.IsLatestModel, errMsg)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d models validated, %d invalid, %d of which are the latest model of their store\n",
+		len(results), invalid, invalidLatest)
+	return err
+}
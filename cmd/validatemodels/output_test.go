@@ -0,0 +1,63 @@
+package validatemodels
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteResults(t *testing.T) {
+	results := []validationResult{
+		{StoreID: "store1", ModelID: "model2", IsLatestModel: true},
+		{StoreID: "store1", ModelID: "model1", Error: "invalid & old"},
+		{StoreID: "store2", ModelID: "model3", IsLatestModel: true, Error: "invalid"},
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeResults(&out, outputJSONL, results))
+		require.Equal(t, `{"store_id":"store1","model_id":"model2","is_latest_model":true,"error":""}
+{"store_id":"store1","model_id":"model1","is_latest_model":false,"error":"invalid & old"}
+{"store_id":"store2","model_id":"model3","is_latest_model":true,"error":"invalid"}
+`, out.String())
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeResults(&out, outputJUnit, results))
+		require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="validate-models" tests="3" failures="2">
+  <testsuite name="store1" tests="2" failures="1">
+    <testcase name="model2 (latest)" classname="store1"></testcase>
+    <testcase name="model1" classname="store1">
+      <failure message="invalid authorization model">invalid &amp; old</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="store2" tests="1" failures="1">
+    <testcase name="model3 (latest)" classname="store2">
+      <failure message="invalid authorization model">invalid</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, out.String())
+	})
+
+	t.Run("table", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeResults(&out, outputTable, results))
+		require.Equal(t, `STORE ID  MODEL ID  LATEST  ERROR
+store1    model2    true    -
+store1    model1    false   invalid & old
+store2    model3    true    invalid
+
+3 models validated, 2 invalid, 1 of which are the latest model of their store
+`, out.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeResults(&out, outputJSON, results[:1]))
+		require.JSONEq(t, `[{"store_id":"store1","model_id":"model2","is_latest_model":true,"error":""}]`, out.String())
+	})
+}
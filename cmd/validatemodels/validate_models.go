@@ -3,37 +3,60 @@ package validatemodels
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
 
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/cmd/util"
 	"github.com/openfga/openfga/pkg/storage"
-	"github.com/openfga/openfga/pkg/storage/mysql"
-	"github.com/openfga/openfga/pkg/storage/postgres"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
-	"github.com/openfga/openfga/pkg/storage/sqlite"
 	"github.com/openfga/openfga/pkg/typesystem"
 )
 
 const (
-	datastoreEngineFlag = "datastore-engine"
-	datastoreURIFlag    = "datastore-uri"
+	datastoreEngineFlag   = "datastore-engine"
+	datastoreURIFlag      = "datastore-uri"
+	datastoreUsernameFlag = "datastore-username"
+	concurrencyFlag       = "concurrency"
+	outputFlag            = "output"
+	storeIDFlag           = "store-id"
+	latestOnlyFlag        = "latest-only"
+
+	defaultConcurrency = 8
 )
 
+// errLatestModelInvalid is returned by the command when the latest model of a store fails
+// validation, so that it exits with a non-zero code.
+var errLatestModelInvalid = errors.New("the latest authorization model of at least one store is invalid")
+
 func NewValidateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validate-models",
 		Short: "Validate authorization models. NOTE: this command is in beta and may be removed in future releases.",
-		Long:  "List all authorization models across all stores and run validations against them.\nNOTE: this command is in beta and may be removed in future releases.",
-		RunE:  runValidate,
-		Args:  cobra.NoArgs,
+		Long: "List all authorization models across all stores and run validations against them. " +
+			"Exits with a non-zero code if the latest model of a store is invalid.\n" +
+			"NOTE: this command is in beta and may be removed in future releases.",
+		RunE:         runValidate,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
 	}
 
 	flags := cmd.Flags()
 	flags.String(datastoreEngineFlag, "", "the datastore engine")
 	flags.String(datastoreURIFlag, "", "the connection uri to the datastore")
+	flags.String(datastoreUsernameFlag, "", "(optional) overwrite the username in the connection string")
+	util.AddDSQLFlags(flags)
+	flags.Int(concurrencyFlag, defaultConcurrency, "the number of stores validated concurrently")
+	flags.String(outputFlag, outputJSON, fmt.Sprintf("the output format, one of %v", outputFormats))
+	flags.StringSlice(storeIDFlag, nil, "only validate the models of these stores (can be repeated)")
+	flags.Bool(latestOnlyFlag, false, "only validate the latest model of each store")
 
 	// NOTE: if you add a new flag here, update the function below, too
 
@@ -49,57 +72,131 @@ type validationResult struct {
 	Error         string `json:"error"`
 }
 
-func runValidate(_ *cobra.Command, _ []string) error {
+// ValidationOptions selects the models validated by [ValidateAuthorizationModels].
+type ValidationOptions struct {
+	// StoreIDs restricts the validation to these stores. All stores are validated when empty.
+	StoreIDs []string
+
+	// LatestOnly restricts the validation to the latest model of each store.
+	LatestOnly bool
+
+	// Concurrency is the number of stores validated concurrently. Defaults to 1.
+	Concurrency int
+}
+
+func runValidate(cmd *cobra.Command, _ []string) error {
 	engine := viper.GetString(datastoreEngineFlag)
 	uri := viper.GetString(datastoreURIFlag)
+	username := viper.GetString(datastoreUsernameFlag)
+	output := viper.GetString(outputFlag)
+	opts := ValidationOptions{
+		StoreIDs:    viper.GetStringSlice(storeIDFlag),
+		LatestOnly:  viper.GetBool(latestOnlyFlag),
+		Concurrency: viper.GetInt(concurrencyFlag),
+	}
 
-	ctx := context.Background()
-
-	var (
-		db  storage.OpenFGADatastore
-		err error
-	)
-	cfg := sqlcommon.NewConfig()
-	switch engine {
-	case "mysql":
-		db, err = mysql.New(uri, cfg)
-	case "postgres":
-		db, err = postgres.New(uri, cfg)
-	case "sqlite":
-		db, err = sqlite.New(uri, cfg)
-	case "":
-		return fmt.Errorf("missing datastore engine type")
-	case "memory":
-		fallthrough
-	default:
-		return fmt.Errorf("storage engine '%s' is unsupported", engine)
+	if !slices.Contains(outputFormats, output) {
+		return fmt.Errorf("invalid output format '%s', must be one of %v", output, outputFormats)
+	}
+	if opts.Concurrency < 1 {
+		return fmt.Errorf("'%s' must be greater than zero", concurrencyFlag)
 	}
 
-	if err != nil {
-		return fmt.Errorf("failed to open a connection to the datastore: %w", err)
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
-	validationResults, err := ValidateAllAuthorizationModels(ctx, db)
+	cfg := sqlcommon.NewConfig(sqlcommon.WithUsername(username))
+	db, err := util.OpenDatastore(ctx, engine, uri, cfg)
 	if err != nil {
 		return err
 	}
+	defer db.Close()
 
-	marshalled, err := json.MarshalIndent(validationResults, " ", "    ")
+	validationResults, err := ValidateAuthorizationModels(ctx, db, opts)
 	if err != nil {
+		return err
+	}
+
+	if err := writeResults(cmd.OutOrStdout(), output, validationResults); err != nil {
 		return fmt.Errorf("error gathering validation results: %w", err)
 	}
-	fmt.Println(string(marshalled))
 
+	return checkLatestModels(validationResults)
+}
+
+// checkLatestModels returns errLatestModelInvalid if the latest model of a store is invalid.
+func checkLatestModels(results []validationResult) error {
+	for _, result := range results {
+		if result.IsLatestModel && result.Error != "" {
+			return errLatestModelInvalid
+		}
+	}
 	return nil
 }
 
 // ValidateAllAuthorizationModels lists all stores and then, for each store, lists all models.
 // Then it runs validation on each model.
 func ValidateAllAuthorizationModels(ctx context.Context, db storage.OpenFGADatastore) ([]validationResult, error) {
+	return ValidateAuthorizationModels(ctx, db, ValidationOptions{})
+}
+
+// ValidateAuthorizationModels runs validation on the models of the stores selected by opts,
+// validating up to opts.Concurrency stores at a time. The results are ordered by store, in the
+// order the stores are listed, then by model, latest first.
+func ValidateAuthorizationModels(ctx context.Context, db storage.OpenFGADatastore, opts ValidationOptions) ([]validationResult, error) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(opts.Concurrency, 1))
+
+	var mu sync.Mutex
+	var storeResults [][]validationResult
+	validate := func(storeID string) {
+		mu.Lock()
+		i := len(storeResults)
+		storeResults = append(storeResults, nil)
+		mu.Unlock()
+
+		g.Go(func() error {
+			results, err := validateStore(ctx, db, storeID, opts.LatestOnly)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			storeResults[i] = results
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	err := forEachStore(ctx, db, opts.StoreIDs, validate)
+	if waitErr := g.Wait(); waitErr != nil {
+		return nil, waitErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	validationResults := make([]validationResult, 0)
+	for _, results := range storeResults {
+		validationResults = append(validationResults, results...)
+	}
+	return validationResults, nil
+}
 
-	continuationTokenStores := ""
+// forEachStore calls fn with the id of each store, either the given storeIDs or all stores.
+func forEachStore(ctx context.Context, db storage.OpenFGADatastore, storeIDs []string, fn func(storeID string)) error {
+	if len(storeIDs) > 0 {
+		for _, storeID := range storeIDs {
+			if _, err := db.GetStore(ctx, storeID); err != nil {
+				return fmt.Errorf("error reading store %s: %w", storeID, err)
+			}
+			fn(storeID)
+		}
+		return nil
+	}
 
+	continuationTokenStores := ""
 	for {
 		// fetch a page of stores
 		opts := storage.ListStoresOptions{
@@ -107,59 +204,72 @@ func ValidateAllAuthorizationModels(ctx context.Context, db storage.OpenFGADatas
 		}
 		stores, tokenStores, err := db.ListStores(ctx, opts)
 		if err != nil {
-			return nil, fmt.Errorf("error reading stores: %w", err)
+			return fmt.Errorf("error reading stores: %w", err)
 		}
 
-		// validate each store
 		for _, store := range stores {
-			latestModel, err := db.FindLatestAuthorizationModel(ctx, store.GetId())
-			if err != nil {
-				fmt.Printf("no models in store %s \n", store.GetId())
-			}
-
-			continuationTokenModels := ""
-
-			for {
-				// fetch a page of models for that store
-				opts := storage.ReadAuthorizationModelsOptions{
-					Pagination: storage.NewPaginationOptions(100, continuationTokenModels),
-				}
-				models, tokenModels, err := db.ReadAuthorizationModels(ctx, store.GetId(), opts)
-				if err != nil {
-					return nil, fmt.Errorf("error reading authorization models: %w", err)
-				}
-
-				// validate each model
-				for _, model := range models {
-					_, err := typesystem.NewAndValidate(context.Background(), model)
-
-					validationResult := validationResult{
-						StoreID:       store.GetId(),
-						ModelID:       model.GetId(),
-						IsLatestModel: model.GetId() == latestModel.GetId(),
-					}
-
-					if err != nil {
-						validationResult.Error = err.Error()
-					}
-					validationResults = append(validationResults, validationResult)
-				}
-
-				continuationTokenModels = tokenModels
-
-				if continuationTokenModels == "" {
-					break
-				}
-			}
+			fn(store.GetId())
 		}
 
 		// next page of stores
 		continuationTokenStores = tokenStores
 
 		if continuationTokenStores == "" {
-			break
+			return nil
 		}
 	}
+}
 
-	return validationResults, nil
+// validateStore validates the models of a store, or only its latest model if latestOnly is set.
+func validateStore(ctx context.Context, db storage.OpenFGADatastore, storeID string, latestOnly bool) ([]validationResult, error) {
+	latestModel, err := db.FindLatestAuthorizationModel(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			// no models in the store
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading latest authorization model of store %s: %w", storeID, err)
+	}
+
+	if latestOnly {
+		return []validationResult{validateModel(ctx, storeID, latestModel, latestModel.GetId())}, nil
+	}
+
+	var validationResults []validationResult
+	continuationTokenModels := ""
+
+	for {
+		// fetch a page of models for that store
+		opts := storage.ReadAuthorizationModelsOptions{
+			Pagination: storage.NewPaginationOptions(100, continuationTokenModels),
+		}
+		models, tokenModels, err := db.ReadAuthorizationModels(ctx, storeID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error reading authorization models: %w", err)
+		}
+
+		// validate each model
+		for _, model := range models {
+			validationResults = append(validationResults, validateModel(ctx, storeID, model, latestModel.GetId()))
+		}
+
+		continuationTokenModels = tokenModels
+
+		if continuationTokenModels == "" {
+			return validationResults, nil
+		}
+	}
+}
+
+func validateModel(ctx context.Context, storeID string, model *openfgav1.AuthorizationModel, latestModelID string) validationResult {
+	validationResult := validationResult{
+		StoreID:       storeID,
+		ModelID:       model.GetId(),
+		IsLatestModel: model.GetId() == latestModelID,
+	}
+
+	if _, err := typesystem.NewAndValidate(ctx, model); err != nil {
+		validationResult.Error = err.Error()
+	}
+	return validationResult
 }
@@ -1,9 +1,11 @@
 package validatemodels
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/spf13/cobra"
@@ -15,11 +17,13 @@ import (
 
 	"github.com/openfga/openfga/cmd"
 	"github.com/openfga/openfga/cmd/util"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
 	"github.com/openfga/openfga/pkg/typesystem"
 )
 
 func TestValidationResult(t *testing.T) {
-	engines := []string{"postgres", "mysql", "sqlite"}
+	engines := []string{"postgres", "mysql", "sqlite", "dsql"}
 
 	totalStores := 200
 	totalModelsForOneStore := 200
@@ -81,13 +85,17 @@ func TestValidateModelsCommandWhenInvalidEngine(t *testing.T) {
 		errorExpected string
 	}{
 		{
-			engine:        "memory",
-			errorExpected: "storage engine 'memory' is unsupported",
+			engine:        "unknown",
+			errorExpected: "storage engine 'unknown' is unsupported",
 		},
 		{
 			engine:        "",
 			errorExpected: "missing datastore engine type",
 		},
+		{
+			engine:        "dsql",
+			errorExpected: "DSQL URI must use the dsql:// scheme",
+		},
 	} {
 		t.Run(tc.engine, func(t *testing.T) {
 			validateModelsCommand := NewValidateCommand()
@@ -98,12 +106,55 @@ func TestValidateModelsCommandWhenInvalidEngine(t *testing.T) {
 	}
 }
 
+func TestValidateModelsCommandMemoryEngine(t *testing.T) {
+	util.PrepareTempConfigDir(t)
+
+	// The memory datastore starts empty, so there is nothing to validate.
+	for output, expected := range map[string]string{
+		"json":  "[]\n",
+		"jsonl": "",
+		"table": "STORE ID  MODEL ID  LATEST  ERROR\n\n0 models validated, 0 invalid, 0 of which are the latest model of their store\n",
+	} {
+		t.Run(output, func(t *testing.T) {
+			var out bytes.Buffer
+			validateCmd := NewValidateCommand()
+			validateCmd.SetOut(&out)
+
+			cmd := cmd.NewRootCommand()
+			cmd.AddCommand(validateCmd)
+			cmd.SetArgs([]string{"validate-models", "--datastore-engine", "memory", "--output", output})
+			require.NoError(t, cmd.Execute())
+			require.Equal(t, expected, out.String())
+		})
+	}
+
+	t.Run("invalid_output", func(t *testing.T) {
+		validateCmd := NewValidateCommand()
+		validateCmd.SetArgs([]string{"--datastore-engine", "memory", "--output", "yaml"})
+		require.ErrorContains(t, validateCmd.Execute(), "invalid output format 'yaml'")
+	})
+
+	t.Run("invalid_concurrency", func(t *testing.T) {
+		validateCmd := NewValidateCommand()
+		validateCmd.SetArgs([]string{"--datastore-engine", "memory", "--output", "json", "--concurrency", "0"})
+		require.ErrorContains(t, validateCmd.Execute(), "'concurrency' must be greater than zero")
+	})
+}
+
 func TestValidateModelsCommandNoConfigDefaultValues(t *testing.T) {
 	util.PrepareTempConfigDir(t)
 	validateCommand := NewValidateCommand()
 	validateCommand.RunE = func(cmd *cobra.Command, _ []string) error {
 		require.Empty(t, viper.GetString(datastoreEngineFlag))
 		require.Empty(t, viper.GetString(datastoreURIFlag))
+		require.Empty(t, viper.GetString(datastoreUsernameFlag))
+		require.Empty(t, viper.GetString(util.DatastoreDSQLAWSProfileFlag))
+		require.Empty(t, viper.GetString(util.DatastoreDSQLRoleARNFlag))
+		require.Equal(t, 15*time.Minute, viper.GetDuration(util.DatastoreDSQLTokenLifetimeFlag))
+		require.Equal(t, defaultConcurrency, viper.GetInt(concurrencyFlag))
+		require.Equal(t, "json", viper.GetString(outputFlag))
+		require.Empty(t, viper.GetStringSlice(storeIDFlag))
+		require.False(t, viper.GetBool(latestOnlyFlag))
 		return nil
 	}
 
@@ -153,3 +204,89 @@ func TestValidateModelsCommandConfigIsMerged(t *testing.T) {
 	cmd.SetArgs([]string{"validate-models"})
 	require.NoError(t, cmd.Execute())
 }
+
+func TestValidateAuthorizationModels(t *testing.T) {
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	validModel := parser.MustTransformDSLToProto(`
+		model
+			schema 1.1
+		type user
+		type document
+			relations
+				define viewer: [user]
+		`).GetTypeDefinitions()
+	invalidModel := parser.MustTransformDSLToProto(`
+		model
+			schema 1.1
+		type document
+			relations
+				define viewer: [user]
+		`).GetTypeDefinitions()
+
+	writeStore := func(typeDefinitions ...[]*openfgav1.TypeDefinition) (string, []string) {
+		storeID := ulid.Make().String()
+		_, err := ds.CreateStore(ctx, &openfgav1.Store{Id: storeID, Name: storeID})
+		require.NoError(t, err)
+
+		var modelIDs []string
+		for _, typeDefinition := range typeDefinitions {
+			modelID := ulid.Make().String()
+			err := ds.WriteAuthorizationModel(ctx, storeID, &openfgav1.AuthorizationModel{
+				Id:              modelID,
+				SchemaVersion:   typesystem.SchemaVersion1_1,
+				TypeDefinitions: typeDefinition,
+			})
+			require.NoError(t, err)
+			modelIDs = append(modelIDs, modelID)
+		}
+		return storeID, modelIDs
+	}
+
+	// the latest model is valid, the previous one is not
+	validStore, validStoreModels := writeStore(invalidModel, validModel)
+	// the latest model is invalid
+	invalidStore, invalidStoreModels := writeStore(invalidModel)
+	emptyStore, _ := writeStore()
+
+	t.Run("all_stores", func(t *testing.T) {
+		for _, concurrency := range []int{1, 4} {
+			results, err := ValidateAuthorizationModels(ctx, ds, ValidationOptions{Concurrency: concurrency})
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+			require.Equal(t, validStore, results[0].StoreID)
+			require.Equal(t, validStoreModels[1], results[0].ModelID)
+			require.True(t, results[0].IsLatestModel)
+			require.Empty(t, results[0].Error)
+			require.Equal(t, validStoreModels[0], results[1].ModelID)
+			require.False(t, results[1].IsLatestModel)
+			require.NotEmpty(t, results[1].Error)
+			require.Equal(t, invalidStore, results[2].StoreID)
+			require.Equal(t, invalidStoreModels[0], results[2].ModelID)
+			require.True(t, results[2].IsLatestModel)
+
+			require.ErrorIs(t, checkLatestModels(results), errLatestModelInvalid)
+		}
+	})
+
+	t.Run("store_filter", func(t *testing.T) {
+		results, err := ValidateAuthorizationModels(ctx, ds, ValidationOptions{StoreIDs: []string{validStore, emptyStore}})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, result := range results {
+			require.Equal(t, validStore, result.StoreID)
+		}
+		require.NoError(t, checkLatestModels(results))
+
+		_, err = ValidateAuthorizationModels(ctx, ds, ValidationOptions{StoreIDs: []string{ulid.Make().String()}})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("latest_only", func(t *testing.T) {
+		results, err := ValidateAuthorizationModels(ctx, ds, ValidationOptions{LatestOnly: true, StoreIDs: []string{validStore}})
+		require.NoError(t, err)
+		require.Equal(t, []validationResult{{StoreID: validStore, ModelID: validStoreModels[1], IsLatestModel: true}}, results)
+	})
+}
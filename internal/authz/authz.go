@@ -149,15 +149,16 @@ func (a *Authorizer) getRelation(apiMethod apimethod.APIMethod) (string, error)
 	switch apiMethod {
 	case apimethod.ReadAuthorizationModel, apimethod.ReadAuthorizationModels:
 		return CanCallReadAuthorizationModels, nil
-	case apimethod.Read:
+	case apimethod.Read, apimethod.CloneStore, apimethod.DiffAuthorizationModels:
 		return CanCallRead, nil
-	case apimethod.Write:
+	case apimethod.Write, apimethod.ImportTuples:
 		return CanCallWrite, nil
-	case apimethod.ListObjects, apimethod.StreamedListObjects:
+	case apimethod.ListObjects, apimethod.StreamedListObjects, apimethod.PaginatedListObjects,
+		apimethod.MultiTypeListObjects, apimethod.StreamedMultiTypeListObjects:
 		return CanCallListObjects, nil
-	case apimethod.Check, apimethod.BatchCheck:
+	case apimethod.Check, apimethod.BatchCheck, apimethod.ListRelations:
 		return CanCallCheck, nil
-	case apimethod.ListUsers:
+	case apimethod.ListUsers, apimethod.StreamedListUsers:
 		return CanCallListUsers, nil
 	case apimethod.WriteAssertions:
 		return CanCallWriteAssertions, nil
@@ -175,7 +176,7 @@ func (a *Authorizer) getRelation(apiMethod apimethod.APIMethod) (string, error)
 		return CanCallDeleteStore, nil
 	case apimethod.Expand:
 		return CanCallExpand, nil
-	case apimethod.ReadChanges:
+	case apimethod.ReadChanges, apimethod.Watch:
 		return CanCallReadChanges, nil
 	default:
 		return "", fmt.Errorf("unknown API method: %s", apiMethod)
@@ -39,9 +39,14 @@ func TestGetRelation(t *testing.T) {
 		{method: apimethod.Write, expectedResult: CanCallWrite},
 		{method: apimethod.ListObjects, expectedResult: CanCallListObjects},
 		{method: apimethod.StreamedListObjects, expectedResult: CanCallListObjects},
+		{method: apimethod.PaginatedListObjects, expectedResult: CanCallListObjects},
+		{method: apimethod.MultiTypeListObjects, expectedResult: CanCallListObjects},
+		{method: apimethod.StreamedMultiTypeListObjects, expectedResult: CanCallListObjects},
 		{method: apimethod.Check, expectedResult: CanCallCheck},
 		{method: apimethod.BatchCheck, expectedResult: CanCallCheck},
+		{method: apimethod.ListRelations, expectedResult: CanCallCheck},
 		{method: apimethod.ListUsers, expectedResult: CanCallListUsers},
+		{method: apimethod.StreamedListUsers, expectedResult: CanCallListUsers},
 		{method: apimethod.WriteAssertions, expectedResult: CanCallWriteAssertions},
 		{method: apimethod.ReadAssertions, expectedResult: CanCallReadAssertions},
 		{method: apimethod.WriteAuthorizationModel, expectedResult: CanCallWriteAuthorizationModels},
@@ -50,6 +55,10 @@ func TestGetRelation(t *testing.T) {
 		{method: apimethod.DeleteStore, expectedResult: CanCallDeleteStore},
 		{method: apimethod.Expand, expectedResult: CanCallExpand},
 		{method: apimethod.ReadChanges, expectedResult: CanCallReadChanges},
+		{method: apimethod.Watch, expectedResult: CanCallReadChanges},
+		{method: apimethod.CloneStore, expectedResult: CanCallRead},
+		{method: apimethod.ImportTuples, expectedResult: CanCallWrite},
+		{method: apimethod.DiffAuthorizationModels, expectedResult: CanCallRead},
 		{method: "Unknown", errorMsg: "unknown API method: Unknown"},
 	}
 
@@ -13,9 +13,15 @@ var (
 	// Date is the date when the app was built.
 	Date = "unknown"
 
-	// MinimumSupportedDatastoreSchemaRevision refers to the minimum schema version that is required to run
-	// this specific build of OpenFGA. Refer to the `assets/migrations` artifacts for more information.
-	MinimumSupportedDatastoreSchemaRevision int64 = 4
+	// MinimumSupportedPostgresSchemaRevision, MinimumSupportedMySQLSchemaRevision,
+	// MinimumSupportedSQLiteSchemaRevision and MinimumSupportedDSQLSchemaRevision refer to the minimum
+	// schema version of each SQL engine that is required to run this specific build of OpenFGA, which
+	// is currently the one adding the changelog.expires_at column. The engines number their migrations
+	// differently. Refer to the `assets/migrations` artifacts for more information.
+	MinimumSupportedPostgresSchemaRevision int64 = 9
+	MinimumSupportedMySQLSchemaRevision    int64 = 10
+	MinimumSupportedSQLiteSchemaRevision   int64 = 8
+	MinimumSupportedDSQLSchemaRevision     int64 = 9
 
 	ProjectName = "openfga"
 )
@@ -2,7 +2,9 @@ package cachecontroller
 
 import (
 	"context"
+	"maps"
 	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -67,6 +69,10 @@ type CacheController interface {
 	// and if not it will spawn a goroutine to invalidate cached records conditionally
 	// based on timestamp. It may invalidate all cache records, some, or none.
 	InvalidateIfNeeded(context.Context, string)
+
+	// Close releases the resources held by the controller, such as its subscription
+	// to an invalidation notifier.
+	Close()
 }
 
 type NoopCacheController struct{}
@@ -78,6 +84,9 @@ func (c *NoopCacheController) DetermineInvalidationTime(_ context.Context, _ str
 func (c *NoopCacheController) InvalidateIfNeeded(_ context.Context, _ string) {
 }
 
+func (c *NoopCacheController) Close() {
+}
+
 func NewNoopCacheController() CacheController {
 	return &NoopCacheController{}
 }
@@ -93,6 +102,15 @@ func WithLogger(logger logger.Logger) InMemoryCacheControllerOpt {
 	}
 }
 
+// WithInvalidationNotifier subscribes the InMemoryCacheController to the invalidations broadcast by
+// notifier. They are applied as soon as they are received, while polling the changelog remains
+// as a fallback for missed notifications.
+func WithInvalidationNotifier(notifier storage.InvalidationNotifier) InMemoryCacheControllerOpt {
+	return func(inm *InMemoryCacheController) {
+		inm.notifier = notifier
+	}
+}
+
 // InMemoryCacheController will invalidate cache iterator (InMemoryCache) and sub problem cache (CachedCheckResolver) entries
 // that are more recent than the last write for the specified store.
 // Note that the invalidation is done asynchronously, triggered by Check requests,
@@ -110,6 +128,8 @@ type InMemoryCacheController struct {
 	iteratorCacheTTL        time.Duration
 	inflightInvalidations   sync.Map
 	logger                  logger.Logger
+	notifier                storage.InvalidationNotifier
+	unsubscribe             func()
 
 	// for testing purposes
 	wg sync.WaitGroup
@@ -137,9 +157,20 @@ func NewCacheController(
 		opt(c)
 	}
 
+	if c.notifier != nil {
+		c.unsubscribe = c.notifier.Subscribe(c.invalidateOnNotification)
+	}
+
 	return c
 }
 
+// Close unsubscribes the controller from its invalidation notifier, if any.
+func (c *InMemoryCacheController) Close() {
+	if c.unsubscribe != nil {
+		c.unsubscribe()
+	}
+}
+
 // DetermineInvalidationTime returns the timestamp of the last write for the
 // specified store if it was in cache, else it returns the Zero time and
 // triggers InvalidateIfNeeded(). The last write time can be used to determine
@@ -166,6 +197,16 @@ func (c *InMemoryCacheController) DetermineInvalidationTime(
 	// (shouldn't happen since we used the unique changelog cache key prefix
 	// when getting from cache).
 	entry, _ := cacheResp.(*storage.ChangelogCacheEntry)
+
+	// Invalidations received from the notifier are more recent than the
+	// changelog until the next poll catches up with them.
+	notified := time.Time{}
+	if c.notifier != nil {
+		if notifiedEntry, ok := c.cache.Get(storage.GetInvalidQueryCacheKey(storeID)).(*storage.InvalidEntityCacheEntry); ok {
+			notified = notifiedEntry.LastModified
+		}
+	}
+
 	if entry == nil {
 		c.InvalidateIfNeeded(ctx, storeID) // async
 
@@ -173,7 +214,7 @@ func (c *InMemoryCacheController) DetermineInvalidationTime(
 		// in progress (async). This may result in stale cache hits until
 		// invalidation completes and updates the ChangelogCacheEntry, but this
 		// is an acceptable trade-off for performance.
-		return time.Time{}
+		return notified
 	}
 
 	// Ensure invalidation is triggered at most every c.minInvalidationInterval
@@ -187,10 +228,40 @@ func (c *InMemoryCacheController) DetermineInvalidationTime(
 	}
 
 	// Return time of last known change to store. This is refreshed at most every
-	// minInvalidationInterval, so recent writes may not be reflected immediately.
+	// minInvalidationInterval, so recent writes may not be reflected immediately
+	// unless they were notified.
+	if notified.After(entry.LastModified) {
+		return notified
+	}
 	return entry.LastModified
 }
 
+// invalidateOnNotification invalidates the cached Check responses depending on the object types
+// and relations written, and the cached iterators over their object types. The receipt time is
+// used rather than the time of the write, so that clock skew between instances can not keep
+// stale entries valid.
+func (c *InMemoryCacheController) invalidateOnNotification(inv storage.Invalidation) {
+	now := time.Now()
+	c.cache.Set(storage.GetInvalidQueryCacheKey(inv.StoreID), &storage.InvalidEntityCacheEntry{LastModified: now}, c.queryCacheTTL)
+
+	if len(inv.ObjectRelations) == 0 {
+		c.invalidateCheckCache(inv.StoreID, now)
+		c.invalidateIteratorCache(inv.StoreID)
+	}
+	for _, objectRelation := range inv.ObjectRelations {
+		c.invalidateCheckCacheByObjectRelation(inv.StoreID, objectRelation, now)
+	}
+	for _, objectType := range inv.ObjectTypes() {
+		c.invalidateIteratorCacheByObjectType(inv.StoreID, objectType, now)
+	}
+	c.recordCheckInvalidations(inv.StoreID, now)
+
+	cacheInvalidationCounter.Inc()
+	c.logger.Debug("InMemoryCacheController invalidation notified",
+		zap.String("store_id", inv.StoreID),
+		zap.Strings("object_relations", inv.ObjectRelations))
+}
+
 // findChangesDescending is a wrapper on ReadChanges. If there are 0 changes to be returned, ReadChanges will actually return an error.
 func (c *InMemoryCacheController) findChangesDescending(ctx context.Context, storeID string) ([]*openfgav1.TupleChange, string, error) {
 	opts := storage.ReadChangesOptions{
@@ -307,6 +378,8 @@ func (c *InMemoryCacheController) findChangesAndInvalidateIfNecessary(parentCtx
 		return
 	}
 
+	c.invalidateCheckCacheByChanges(storeID, changes, lastChangeTimeCached)
+
 	lastIteratorInvalidation := time.Now().Add(-c.iteratorCacheTTL)
 
 	// need to consider there might just be 1 change
@@ -355,6 +428,54 @@ func (c *InMemoryCacheController) findChangesAndInvalidateIfNecessary(parentCtx
 	findChangesAndInvalidateHistogram.WithLabelValues(invalidationType).Observe(float64(time.Since(start).Milliseconds()))
 }
 
+// invalidateCheckCacheByChanges invalidates the cached Check responses depending on the object
+// types and relations of the changes made after since. If since is unknown or changes, ordered
+// from most recent to oldest, may not reach back to it, the cached Check responses of the whole
+// store are invalidated instead.
+func (c *InMemoryCacheController) invalidateCheckCacheByChanges(storeID string, changes []*openfgav1.TupleChange, since time.Time) {
+	oldest := changes[len(changes)-1].GetTimestamp().AsTime()
+	if since.IsZero() || (len(changes) >= storage.DefaultPageSize && oldest.After(since)) {
+		c.invalidateCheckCache(storeID, changes[0].GetTimestamp().AsTime())
+		c.recordCheckInvalidations(storeID, changes[0].GetTimestamp().AsTime())
+		return
+	}
+
+	lastModified := make(map[string]time.Time)
+	for _, change := range changes {
+		ts := change.GetTimestamp().AsTime()
+		if !ts.After(since) {
+			break
+		}
+		t := change.GetTupleKey()
+		objectRelation := tuple.ToObjectRelationString(tuple.GetType(t.GetObject()), t.GetRelation())
+		if _, ok := lastModified[objectRelation]; !ok {
+			lastModified[objectRelation] = ts
+		}
+	}
+	for _, objectRelation := range slices.Sorted(maps.Keys(lastModified)) {
+		c.invalidateCheckCacheByObjectRelation(storeID, objectRelation, lastModified[objectRelation])
+	}
+	c.recordCheckInvalidations(storeID, changes[0].GetTimestamp().AsTime())
+}
+
+// invalidateCheckCache writes a new key to the cache invalidating the cached Check responses of the store
+// cached before ts (see graph.CachedCheckResolver).
+func (c *InMemoryCacheController) invalidateCheckCache(storeID string, ts time.Time) {
+	c.cache.Set(storage.GetInvalidCheckCacheKey(storeID), &storage.InvalidEntityCacheEntry{LastModified: ts}, c.queryCacheTTL)
+}
+
+// recordCheckInvalidations writes a new key to the cache recording that the invalidations of the cached
+// Check responses by object type and relation account for the writes until ts, see graph.CachedCheckResolver.
+func (c *InMemoryCacheController) recordCheckInvalidations(storeID string, ts time.Time) {
+	c.cache.Set(storage.GetInvalidCheckRecordedCacheKey(storeID), &storage.InvalidEntityCacheEntry{LastModified: ts}, c.queryCacheTTL)
+}
+
+// invalidateCheckCacheByObjectRelation writes a new key to the cache invalidating the cached Check responses
+// depending on objectRelation cached before ts (see graph.CachedCheckResolver).
+func (c *InMemoryCacheController) invalidateCheckCacheByObjectRelation(storeID, objectRelation string, ts time.Time) {
+	c.cache.Set(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, objectRelation), &storage.InvalidEntityCacheEntry{LastModified: ts}, c.queryCacheTTL)
+}
+
 // invalidateIteratorCache writes a new key to the cache with a very long TTL.
 // An alternative implementation could delete invalid keys, but this approach is faster (see storagewrappers.findInCache).
 func (c *InMemoryCacheController) invalidateIteratorCache(storeID string) {
@@ -367,6 +488,12 @@ func (c *InMemoryCacheController) invalidateIteratorCacheByObjectRelation(storeI
 	c.cache.Set(storage.GetInvalidIteratorByObjectRelationCacheKey(storeID, object, relation), &storage.InvalidEntityCacheEntry{LastModified: ts}, c.iteratorCacheTTL)
 }
 
+// invalidateIteratorCacheByObjectType writes a new key to the cache.
+// An alternative implementation could delete invalid keys, but this approach is faster (see storagewrappers.findInCache).
+func (c *InMemoryCacheController) invalidateIteratorCacheByObjectType(storeID, objectType string, ts time.Time) {
+	c.cache.Set(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, objectType), &storage.InvalidEntityCacheEntry{LastModified: ts}, c.iteratorCacheTTL)
+}
+
 // invalidateIteratorCacheByUserAndObjectType writes a new key to the cache.
 // An alternative implementation could delete invalid keys, but this approach is faster (see storagewrappers.findInCache).
 func (c *InMemoryCacheController) invalidateIteratorCacheByUserAndObjectType(storeID, user, objectType string, ts time.Time) {
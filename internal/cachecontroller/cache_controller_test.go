@@ -66,6 +66,8 @@ func TestInMemoryCacheController_DetermineInvalidationTime(t *testing.T) {
 			}, "", nil),
 			// Expect invalidation to have been triggered
 			cache.EXPECT().Set(storage.GetChangelogCacheKey(storeID), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, "#viewer"), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey(storeID), gomock.Any(), gomock.Any()),
 		)
 		invalidationTime := cacheController.DetermineInvalidationTime(ctx, storeID)
 		// Should return the last known changelog modified time from cache
@@ -99,6 +101,8 @@ func TestInMemoryCacheController_DetermineInvalidationTime(t *testing.T) {
 					}},
 			}, "", nil),
 			cache.EXPECT().Set(storage.GetChangelogCacheKey(storeID), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckCacheKey(storeID), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey(storeID), gomock.Any(), gomock.Any()),
 		)
 		invalidationTime := cacheController.DetermineInvalidationTime(ctx, storeID)
 		require.Zero(t, invalidationTime)
@@ -146,6 +150,8 @@ func TestInMemoryCacheController_DetermineInvalidationTime(t *testing.T) {
 					entry.LastChecked = time.Now()
 				},
 			),
+			cache.EXPECT().Set(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, "#viewer"), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey(storeID), gomock.Any(), gomock.Any()),
 
 			// Second call to DetermineInvalidationTime (within TTL)
 			cache.EXPECT().Get(storage.GetChangelogCacheKey(storeID)).Return(
@@ -245,6 +251,8 @@ func TestInMemoryCacheController_DetermineInvalidationTime(t *testing.T) {
 				}, "", nil),
 
 			cache.EXPECT().Set(storage.GetChangelogCacheKey(storeID), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, "#editor"), gomock.Any(), gomock.Any()),
+			cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey(storeID), gomock.Any(), gomock.Any()),
 		)
 
 		// First call
@@ -352,6 +360,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 							}},
 					}, "", nil),
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("3"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckByObjectRelationCacheKey("3", "test#viewer"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("3"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorCacheKey("3"), gomock.Any(), gomock.Any()),
 				)
 			},
@@ -403,6 +413,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 						},
 					}, "", nil),
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("5"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckCacheKey("5"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("5"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorByObjectRelationCacheKey("5", "test:5", "viewer"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorByUserObjectTypeCacheKeys("5", []string{"test"}, "test")[0], gomock.Any(), gomock.Any()),
 				)
@@ -453,6 +465,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 						},
 					}, "", nil),
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("6"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckCacheKey("6"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("6"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorByObjectRelationCacheKey("6", "test:5", "viewer"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorByUserObjectTypeCacheKeys("6", []string{"test"}, "test")[0], gomock.Any(), gomock.Any()),
 				)
@@ -467,6 +481,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 					datastore.EXPECT().ReadChanges(gomock.Any(), "7", gomock.Any(), expectedReadChangesOpts).Return(
 						generateChanges("test", "relation", "user", 50), "", nil),
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("7"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckCacheKey("7"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("7"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorCacheKey("7"), gomock.Any(), gomock.Any()),
 				)
 			},
@@ -488,6 +504,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 							}},
 					}, "", nil),
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("8"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckCacheKey("8"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("8"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorCacheKey("8"), gomock.Any(), gomock.Any()),
 				)
 			},
@@ -511,6 +529,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 					// there should be no difference with initial_check_for_invalidation case except to
 					// verify the double negative case.
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("9"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckCacheKey("9"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("9"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorCacheKey("9"), gomock.Any(), gomock.Any()),
 				)
 			},
@@ -532,6 +552,8 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 							}},
 					}, "", nil),
 					cache.EXPECT().Set(storage.GetChangelogCacheKey("10"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckCacheKey("10"), gomock.Any(), gomock.Any()),
+					cache.EXPECT().Set(storage.GetInvalidCheckRecordedCacheKey("10"), gomock.Any(), gomock.Any()),
 					cache.EXPECT().Set(storage.GetInvalidIteratorCacheKey("10"), gomock.Any(), gomock.Any()),
 				)
 			},
@@ -563,3 +585,72 @@ func TestInMemoryCacheController_findChangesAndInvalidateIfNecessary(t *testing.
 		})
 	}
 }
+
+func TestInMemoryCacheController_InvalidationNotifier(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cache, err := storage.NewInMemoryLRUCache[any]()
+	require.NoError(t, err)
+	defer cache.Stop()
+
+	notifier := storage.NewInProcessInvalidationNotifier()
+	defer notifier.Close()
+
+	storeID := "id"
+	changelogLastModified := time.Now().Add(-time.Minute)
+	cache.Set(storage.GetChangelogCacheKey(storeID), &storage.ChangelogCacheEntry{
+		LastModified: changelogLastModified,
+		LastChecked:  time.Now(),
+	}, time.Minute)
+
+	cacheController := NewCacheController(mocks.NewMockOpenFGADatastore(ctrl), cache, 10*time.Second, 10*time.Second, 10*time.Second, WithInvalidationNotifier(notifier))
+	require.Equal(t, changelogLastModified, cacheController.DetermineInvalidationTime(context.Background(), storeID))
+
+	t.Run("object_relations_are_invalidated", func(t *testing.T) {
+		before := time.Now()
+		require.NoError(t, notifier.Notify(context.Background(), storage.Invalidation{StoreID: storeID, ObjectRelations: []string{"document#viewer"}}))
+
+		require.False(t, cacheController.DetermineInvalidationTime(context.Background(), storeID).Before(before))
+
+		entry, ok := cache.Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).(*storage.InvalidEntityCacheEntry)
+		require.True(t, ok)
+		require.False(t, entry.LastModified.Before(before))
+
+		entry, ok = cache.Get(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, "document#viewer")).(*storage.InvalidEntityCacheEntry)
+		require.True(t, ok)
+		require.False(t, entry.LastModified.Before(before))
+
+		entry, ok = cache.Get(storage.GetInvalidCheckRecordedCacheKey(storeID)).(*storage.InvalidEntityCacheEntry)
+		require.True(t, ok)
+		require.False(t, entry.LastModified.Before(before))
+
+		require.Nil(t, cache.Get(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, "document#editor")))
+		require.Nil(t, cache.Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "folder")))
+		require.Nil(t, cache.Get(storage.GetInvalidIteratorCacheKey(storeID)))
+		require.Nil(t, cache.Get(storage.GetInvalidCheckCacheKey(storeID)))
+	})
+
+	t.Run("whole_store_is_invalidated_without_object_relations", func(t *testing.T) {
+		require.NoError(t, notifier.Notify(context.Background(), storage.Invalidation{StoreID: storeID}))
+
+		_, ok := cache.Get(storage.GetInvalidIteratorCacheKey(storeID)).(*storage.InvalidEntityCacheEntry)
+		require.True(t, ok)
+		_, ok = cache.Get(storage.GetInvalidCheckCacheKey(storeID)).(*storage.InvalidEntityCacheEntry)
+		require.True(t, ok)
+	})
+
+	t.Run("other_stores_are_not_invalidated", func(t *testing.T) {
+		require.Nil(t, cache.Get(storage.GetInvalidQueryCacheKey("other")))
+		require.Nil(t, cache.Get(storage.GetInvalidIteratorByObjectTypeCacheKey("other", "document")))
+	})
+	t.Run("close_unsubscribes", func(t *testing.T) {
+		otherStoreID := "other_id"
+		cacheController.Close()
+		require.NoError(t, notifier.Notify(context.Background(), storage.Invalidation{StoreID: otherStoreID}))
+		require.Nil(t, cache.Get(storage.GetInvalidQueryCacheKey(otherStoreID)))
+	})
+}
@@ -0,0 +1,114 @@
+package changelogretention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/openfga/openfga/internal/build"
+	"github.com/openfga/openfga/internal/periodic"
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+var (
+	tracer = otel.Tracer("internal/changelogretention")
+
+	prunedChangesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: build.ProjectName,
+		Name:      "changelog_pruned_changes_count",
+		Help:      "The total number of changes pruned from the changelog by the retention policy.",
+	})
+)
+
+// ErrPruningNotSupported is returned when the datastore does not implement [storage.ChangelogPruner].
+var ErrPruningNotSupported = errors.New("the datastore does not support pruning the changelog")
+
+// PruneStores prunes the changelog of the stores with the given IDs, or of every store if there is
+// none, beyond the retention policy of options. It returns the number of deleted changes per store,
+// for the stores that had some. Pruning goes on with the next store when a store fails, and the
+// errors are joined.
+func PruneStores(ctx context.Context, datastore storage.OpenFGADatastore, storeIDs []string, options storage.PruneChangesOptions) (map[string]int, error) {
+	ctx, span := tracer.Start(ctx, "changelogretention.PruneStores")
+	defer span.End()
+
+	pruner, ok := datastore.(storage.ChangelogPruner)
+	if !ok {
+		return nil, ErrPruningNotSupported
+	}
+
+	deleted := make(map[string]int)
+	var errs []error
+	prune := func(storeID string) {
+		n, err := pruner.PruneChanges(ctx, storeID, options)
+		if n > 0 {
+			deleted[storeID] = n
+			prunedChangesCounter.Add(float64(n))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("prune changes of store %s: %w", storeID, err))
+		}
+	}
+
+	if len(storeIDs) > 0 {
+		for _, storeID := range storeIDs {
+			prune(storeID)
+		}
+		return deleted, errors.Join(errs...)
+	}
+
+	var continuationToken string
+	for {
+		stores, token, err := datastore.ListStores(ctx, storage.ListStoresOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, continuationToken),
+		})
+		if err != nil {
+			return deleted, errors.Join(append(errs, fmt.Errorf("list stores: %w", err))...)
+		}
+		for _, store := range stores {
+			if ctx.Err() != nil {
+				return deleted, errors.Join(append(errs, ctx.Err())...)
+			}
+			prune(store.GetId())
+		}
+		if token == "" {
+			return deleted, errors.Join(errs...)
+		}
+		continuationToken = token
+	}
+}
+
+// NewJanitor returns a [periodic.Runner] pruning the changelog of every store of datastore beyond
+// the retention policy of options every interval, once started. It returns ErrPruningNotSupported
+// if the datastore does not implement [storage.ChangelogPruner].
+func NewJanitor(datastore storage.OpenFGADatastore, options storage.PruneChangesOptions, interval time.Duration, logger logger.Logger) (*periodic.Runner, error) {
+	if _, ok := datastore.(storage.ChangelogPruner); !ok {
+		return nil, ErrPruningNotSupported
+	}
+
+	return periodic.NewRunner(interval, func(ctx context.Context) {
+		start := time.Now()
+		deleted, err := PruneStores(ctx, datastore, nil, options)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Error("failed to prune the changelog", zap.Error(err))
+		}
+
+		total := 0
+		for _, n := range deleted {
+			total += n
+		}
+		logger.Info("pruned the changelog",
+			zap.Int("stores", len(deleted)),
+			zap.Int("changes", total),
+			zap.Duration("duration", time.Since(start)))
+	}), nil
+}
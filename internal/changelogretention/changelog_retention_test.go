@@ -0,0 +1,94 @@
+package changelogretention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/mocks"
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+func writeChanges(t *testing.T, ds storage.OpenFGADatastore, n int) string {
+	t.Helper()
+	ctx := context.Background()
+
+	store, err := ds.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "store"})
+	require.NoError(t, err)
+	for i := 0; i < n; i++ {
+		require.NoError(t, ds.Write(ctx, store.GetId(), nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:"+ulid.Make().String(), "viewer", "user:jon"),
+		}))
+	}
+	return store.GetId()
+}
+
+func readChanges(t *testing.T, ds storage.OpenFGADatastore, store string) int {
+	t.Helper()
+	changes, _, err := ds.ReadChanges(context.Background(), store, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+		Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, ""),
+	})
+	if err != nil {
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	}
+	return len(changes)
+}
+
+func TestPruneStores(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store1 := writeChanges(t, ds, 5)
+	store2 := writeChanges(t, ds, 3)
+
+	t.Run("given_stores", func(t *testing.T) {
+		deleted, err := PruneStores(context.Background(), ds, []string{store1}, storage.PruneChangesOptions{MaxChanges: 4})
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{store1: 1}, deleted)
+		require.Equal(t, 4, readChanges(t, ds, store1))
+		require.Equal(t, 3, readChanges(t, ds, store2))
+	})
+
+	t.Run("all_stores", func(t *testing.T) {
+		deleted, err := PruneStores(context.Background(), ds, nil, storage.PruneChangesOptions{MaxChanges: 2})
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{store1: 2, store2: 1}, deleted)
+		require.Equal(t, 2, readChanges(t, ds, store1))
+		require.Equal(t, 2, readChanges(t, ds, store2))
+	})
+}
+
+func TestJanitor(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store := writeChanges(t, ds, 3)
+
+	janitor, err := NewJanitor(ds, storage.PruneChangesOptions{MaxChanges: 1}, time.Hour, logger.NewNoopLogger())
+	require.NoError(t, err)
+	janitor.Start()
+	t.Cleanup(janitor.Stop)
+
+	require.Eventually(t, func() bool {
+		return readChanges(t, ds, store) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("unsupported_datastore", func(t *testing.T) {
+		_, err := NewJanitor(mocks.NewMockOpenFGADatastore(gomock.NewController(t)), storage.PruneChangesOptions{MaxChanges: 1}, time.Hour, logger.NewNoopLogger())
+		require.ErrorIs(t, err, ErrPruningNotSupported)
+	})
+}
@@ -4,59 +4,12 @@ package dsql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"net/url"
 	"strings"
-
-	"github.com/awslabs/aurora-dsql-connectors/go/pgx/dsql"
+	"time"
 )
 
-// PreparePostgresURI converts a dsql:// URI to a postgres:// URI with IAM authentication.
-// If username is provided, it overrides any username in the URI for token generation.
-func PreparePostgresURI(uri string, username string) (string, error) {
-	// Inject username into URI for token generation if provided.
-	tokenURI := uri
-	if username != "" {
-		pgURI := "postgres" + strings.TrimPrefix(uri, "dsql")
-		parsed, err := url.Parse(pgURI)
-		if err != nil {
-			return "", fmt.Errorf("parse URI for username override: %w", err)
-		}
-		parsed.User = url.User(username)
-		tokenURI = "dsql" + strings.TrimPrefix(parsed.String(), "postgres")
-	}
-
-	token, err := dsql.GenerateTokenConnString(context.Background(), tokenURI)
-	if err != nil {
-		return "", fmt.Errorf("generate DSQL auth token: %w", err)
-	}
-
-	pgURI := "postgres" + strings.TrimPrefix(uri, "dsql")
-	dbURI, err := url.Parse(pgURI)
-	if err != nil {
-		return "", fmt.Errorf("parse database URI: %w", err)
-	}
-
-	// Determine final username: param > URI > default "admin"
-	finalUser := username
-	if finalUser == "" {
-		if dbURI.User != nil {
-			finalUser = dbURI.User.Username()
-		}
-		if finalUser == "" {
-			finalUser = "admin"
-		}
-	}
-	dbURI.User = url.UserPassword(finalUser, token)
-
-	q := dbURI.Query()
-	q.Set("sslmode", "require")
-	q.Del("region")
-	dbURI.RawQuery = q.Encode()
-
-	return dbURI.String(), nil
-}
-
 // GooseTableDDL is the DDL for creating a goose version table compatible with DSQL.
 const GooseTableDDL = `
 	CREATE TABLE IF NOT EXISTS goose_db_version (
@@ -87,3 +40,141 @@ func EnsureGooseTable(db *sql.DB) error {
 
 	return nil
 }
+
+// openFGAIndexesQuery lists the oid, name and validity of the indexes of the OpenFGA tables.
+const openFGAIndexesQuery = `
+	SELECT idx.oid, idx.relname, i.indisvalid
+	FROM pg_index i
+	JOIN pg_class idx ON idx.oid = i.indexrelid
+	JOIN pg_class tbl ON tbl.oid = i.indrelid
+	JOIN pg_namespace ns ON ns.oid = tbl.relnamespace
+	WHERE ns.nspname = current_schema()
+		AND tbl.relname IN ('tuple', 'authorization_model', 'store', 'assertion', 'changelog')
+`
+
+// pendingIndexesQuery lists the indexes of the OpenFGA tables that cannot be used yet, i.e. whose
+// CREATE INDEX ASYNC job has not completed.
+const pendingIndexesQuery = `
+	SELECT idx.relname
+	FROM (` + openFGAIndexesQuery + `) idx
+	WHERE NOT idx.indisvalid
+	ORDER BY idx.relname
+`
+
+// activeJobsQuery lists the DSQL async jobs building an index of the OpenFGA tables that have not
+// finished yet. The jobs of other tables of the cluster are left out. The object_id of an index
+// build job is the oid of the index.
+const activeJobsQuery = `
+	SELECT j.job_id
+	FROM sys.jobs j
+	JOIN (` + openFGAIndexesQuery + `) idx ON idx.oid = j.object_id
+	WHERE j.status IN ('submitted', 'processing')
+	ORDER BY j.job_id
+`
+
+// failedJobsQuery lists the DSQL async jobs building an index of the OpenFGA tables that failed,
+// with the reason of their failure.
+const failedJobsQuery = `
+	SELECT 'job ' || j.job_id || ' (' || idx.relname || '): ' || COALESCE(j.details, 'no details')
+	FROM sys.jobs j
+	JOIN (` + openFGAIndexesQuery + `) idx ON idx.oid = j.object_id
+	WHERE j.status = 'failed'
+	ORDER BY j.job_id
+`
+
+// ErrIndexBuildFailed is returned when an index of the OpenFGA tables can no longer become usable
+// because its asynchronous build failed.
+var ErrIndexBuildFailed = errors.New("index build failed")
+
+// PendingIndexes returns the names of the indexes of the OpenFGA tables that are still being
+// built asynchronously (e.g. idx_user_lookup, or idx_reverse_lookup_user on older schemas),
+// followed by the ids of the DSQL async jobs still building one of them, prefixed by "job ".
+// The sys.jobs view only exists on DSQL, so against Postgres only the indexes are checked.
+//
+// An index that is not usable while no job is running has had its build fail, in which case an
+// ErrIndexBuildFailed error is returned with the details of the failed jobs.
+func PendingIndexes(ctx context.Context, db *sql.DB) ([]string, error) {
+	pending, err := queryNames(ctx, db, pendingIndexesQuery, "")
+	if err != nil {
+		return nil, fmt.Errorf("query pending indexes: %w", err)
+	}
+
+	var hasJobs bool
+	if err := db.QueryRowContext(ctx, `SELECT to_regclass('sys.jobs') IS NOT NULL`).Scan(&hasJobs); err != nil {
+		return nil, fmt.Errorf("check DSQL jobs view: %w", err)
+	}
+	if !hasJobs {
+		return pending, nil
+	}
+
+	jobs, err := queryNames(ctx, db, activeJobsQuery, "job ")
+	if err != nil {
+		return nil, fmt.Errorf("query DSQL jobs: %w", err)
+	}
+
+	if len(pending) > 0 && len(jobs) == 0 {
+		failed, err := queryNames(ctx, db, failedJobsQuery, "")
+		if err != nil {
+			return nil, fmt.Errorf("query failed DSQL jobs: %w", err)
+		}
+		return nil, fmt.Errorf("%w: %s: %s", ErrIndexBuildFailed, strings.Join(pending, ", "), strings.Join(failed, "; "))
+	}
+
+	return append(pending, jobs...), nil
+}
+
+func queryNames(ctx context.Context, db *sql.DB, query, prefix string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, prefix+name)
+	}
+	return names, rows.Err()
+}
+
+// WaitForIndexes polls PendingIndexes every pollInterval until no index is pending. It returns an
+// error if ctx is done first, naming the indexes that were still being built, and returns the
+// ErrIndexBuildFailed error of PendingIndexes as soon as a build fails.
+func WaitForIndexes(ctx context.Context, db *sql.DB, pollInterval time.Duration, onPending func([]string)) error {
+	return waitForIndexes(ctx, pollInterval, func(ctx context.Context) ([]string, error) {
+		return PendingIndexes(ctx, db)
+	}, onPending)
+}
+
+func waitForIndexes(
+	ctx context.Context,
+	pollInterval time.Duration,
+	pendingIndexes func(context.Context) ([]string, error),
+	onPending func([]string),
+) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		pending, err := pendingIndexes(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if onPending != nil {
+			onPending(pending)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("indexes still building (see sys.jobs for details): %s: %w", strings.Join(pending, ", "), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
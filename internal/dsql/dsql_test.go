@@ -0,0 +1,58 @@
+package dsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForIndexes(t *testing.T) {
+	t.Run("returns_once_no_index_is_pending", func(t *testing.T) {
+		polls := 0
+		var reported [][]string
+		err := waitForIndexes(context.Background(), time.Millisecond, func(context.Context) ([]string, error) {
+			polls++
+			if polls < 3 {
+				return []string{"idx_user_lookup"}, nil
+			}
+			return nil, nil
+		}, func(pending []string) {
+			reported = append(reported, pending)
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, polls)
+		require.Equal(t, [][]string{{"idx_user_lookup"}, {"idx_user_lookup"}}, reported)
+	})
+
+	t.Run("times_out_naming_the_pending_indexes", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := waitForIndexes(ctx, time.Millisecond, func(context.Context) ([]string, error) {
+			return []string{"idx_tuple_user", "idx_user_lookup"}, nil
+		}, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "idx_tuple_user, idx_user_lookup")
+	})
+
+	t.Run("returns_query_errors", func(t *testing.T) {
+		queryErr := errors.New("boom")
+		err := waitForIndexes(context.Background(), time.Millisecond, func(context.Context) ([]string, error) {
+			return nil, queryErr
+		}, nil)
+		require.ErrorIs(t, err, queryErr)
+	})
+	t.Run("stops_at_the_first_failed_build", func(t *testing.T) {
+		polls := 0
+		err := waitForIndexes(context.Background(), time.Hour, func(context.Context) ([]string, error) {
+			polls++
+			return nil, fmt.Errorf("%w: idx_user_lookup: job 1: duplicate key", ErrIndexBuildFailed)
+		}, nil)
+		require.ErrorIs(t, err, ErrIndexBuildFailed)
+		require.Equal(t, 1, polls)
+	})
+}
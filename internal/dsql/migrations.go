@@ -0,0 +1,328 @@
+package dsql
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/openfga/openfga/assets"
+)
+
+// MigrationVersionMultiplier spaces the DSQL migration versions so that schema revision N
+// (the revision shared with the other engines, e.g. 5 for "add conditions to tuples") is
+// applied by the migrations numbered N*100+1, N*100+2, and so on, one statement each.
+// Versions below the multiplier come from the earlier one-file-per-revision layout.
+const MigrationVersionMultiplier = 100
+
+// Migration is a parsed goose SQL migration.
+type Migration struct {
+	Up            []string
+	Down          []string
+	NoTransaction bool
+}
+
+// ParseMigration parses the content of a goose SQL migration file. Statements are separated by
+// lines ending with a semicolon; comment lines are dropped.
+func ParseMigration(content string) (*Migration, error) {
+	m := &Migration{}
+	var section *[]string
+	var stmt strings.Builder
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "--") {
+			switch strings.TrimSpace(strings.TrimPrefix(line, "--")) {
+			case "+goose Up":
+				section = &m.Up
+			case "+goose Down":
+				section = &m.Down
+			case "+goose NO TRANSACTION":
+				m.NoTransaction = true
+			}
+			continue
+		}
+		if line == "" && stmt.Len() == 0 {
+			continue
+		}
+		if section == nil {
+			return nil, errors.New("statement outside of a '-- +goose Up' or '-- +goose Down' section")
+		}
+
+		if stmt.Len() > 0 {
+			stmt.WriteByte('\n')
+		}
+		stmt.WriteString(line)
+		if strings.HasSuffix(line, ";") {
+			*section = append(*section, strings.TrimSpace(stmt.String()))
+			stmt.Reset()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if stmt.Len() > 0 {
+		return nil, fmt.Errorf("statement is missing its terminating semicolon: %q", stmt.String())
+	}
+	return m, nil
+}
+
+// Format renders m as a goose SQL migration file.
+func (m *Migration) Format() string {
+	var b strings.Builder
+	writeSection := func(annotation string, stmts []string) {
+		b.WriteString("-- +goose " + annotation + "\n")
+		if m.NoTransaction {
+			b.WriteString("-- +goose NO TRANSACTION\n")
+		}
+		for _, stmt := range stmts {
+			b.WriteString(stmt + "\n")
+		}
+	}
+
+	writeSection("Up", m.Up)
+	if len(m.Down) > 0 {
+		b.WriteString("\n")
+		writeSection("Down", m.Down)
+	}
+	return b.String()
+}
+
+// Split returns one migration per Up statement. Down statements are paired with the Up
+// statements when there are as many of both, otherwise the split migrations have no Down
+// section and the caller has to write them.
+func (m *Migration) Split() []*Migration {
+	split := make([]*Migration, 0, len(m.Up))
+	for i, up := range m.Up {
+		part := &Migration{Up: []string{up}, NoTransaction: true}
+		if len(m.Down) == len(m.Up) {
+			part.Down = []string{m.Down[i]}
+		}
+		split = append(split, part)
+	}
+	return split
+}
+
+var (
+	createRe     = regexp.MustCompile(`^CREATE\s+(UNIQUE\s+)?(TABLE|INDEX)\b`)
+	createOkRe   = regexp.MustCompile(`^CREATE\s+(UNIQUE\s+)?(TABLE|INDEX(\s+ASYNC)?)\s+IF\s+NOT\s+EXISTS\b`)
+	dropRe       = regexp.MustCompile(`^DROP\s+(TABLE|INDEX)\b`)
+	dropOkRe     = regexp.MustCompile(`^DROP\s+(TABLE|INDEX)\s+IF\s+EXISTS\b`)
+	alterRe      = regexp.MustCompile(`^ALTER\s+TABLE\b`)
+	addColumnRe  = regexp.MustCompile(`\bADD\s+COLUMN\s+IF\s+NOT\s+EXISTS\b`)
+	dropColumnRe = regexp.MustCompile(`\bDROP\s+COLUMN\s+IF\s+EXISTS\b`)
+	dmlRe        = regexp.MustCompile(`^(UPDATE|DELETE)\b`)
+	whereRe      = regexp.MustCompile(`\bWHERE\b`)
+)
+
+// Validate checks that m can be applied safely on DSQL, which commits each DDL statement in its
+// own transaction: it must run outside of a goose transaction, hold exactly one statement per
+// direction, and that statement must be a no-op when it already took effect, so that a
+// migration interrupted before goose recorded it can simply be run again.
+func (m *Migration) Validate() error {
+	if !m.NoTransaction {
+		return errors.New("missing '-- +goose NO TRANSACTION'")
+	}
+	if len(m.Up) != 1 {
+		return fmt.Errorf("the Up section must hold exactly one statement, found %d", len(m.Up))
+	}
+	if len(m.Down) > 1 {
+		return fmt.Errorf("the Down section must hold at most one statement, found %d", len(m.Down))
+	}
+	for _, stmt := range append(slices.Clone(m.Up), m.Down...) {
+		if err := validateIdempotent(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIdempotent(stmt string) error {
+	s := strings.ToUpper(strings.Join(strings.Fields(stmt), " "))
+	switch {
+	case createRe.MatchString(s):
+		if !createOkRe.MatchString(s) {
+			return fmt.Errorf("CREATE statement must use IF NOT EXISTS: %q", stmt)
+		}
+	case dropRe.MatchString(s):
+		if !dropOkRe.MatchString(s) {
+			return fmt.Errorf("DROP statement must use IF EXISTS: %q", stmt)
+		}
+	case alterRe.MatchString(s):
+		if !addColumnRe.MatchString(s) && !dropColumnRe.MatchString(s) {
+			return fmt.Errorf("ALTER TABLE statement must be ADD COLUMN IF NOT EXISTS or DROP COLUMN IF EXISTS: %q", stmt)
+		}
+	case dmlRe.MatchString(s):
+		if !whereRe.MatchString(s) {
+			return fmt.Errorf("data migration must have a WHERE clause that skips rows it already migrated: %q", stmt)
+		}
+	default:
+		return fmt.Errorf("unsupported statement: %q", stmt)
+	}
+	return nil
+}
+
+// ValidateMigrations validates every migration in dir, see [Migration.Validate], and checks
+// that their versions follow the N*100+step layout described by [MigrationVersionMultiplier].
+func ValidateMigrations(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		version, err := goose.NumericComponent(entry.Name())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+			continue
+		}
+		if version < MigrationVersionMultiplier || version%MigrationVersionMultiplier == 0 {
+			errs = append(errs, fmt.Errorf("%s: version must be <revision>*%d+<step>", entry.Name(), MigrationVersionMultiplier))
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		m, err := ParseMigration(string(content))
+		if err == nil {
+			err = m.Validate()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// migrationVersions lists the versions of the embedded DSQL migrations, in ascending order.
+var migrationVersions = sync.OnceValues(func() ([]int64, error) {
+	entries, err := fs.ReadDir(assets.EmbedMigrations, assets.DSQLMigrationDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []int64
+	for _, entry := range entries {
+		if path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		version, err := goose.NumericComponent(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions, nil
+})
+
+// SchemaRevision returns the schema revision fully applied by the DSQL migrations up to version.
+// Versions from the one-file-per-revision layout are revisions already.
+func SchemaRevision(version int64) (int64, error) {
+	if version < MigrationVersionMultiplier {
+		return version, nil
+	}
+
+	versions, err := migrationVersions()
+	if err != nil {
+		return 0, err
+	}
+
+	revision := version / MigrationVersionMultiplier
+	for _, v := range versions {
+		if v > version && v/MigrationVersionMultiplier == revision {
+			// Some statements of this revision are still to be applied.
+			return revision - 1, nil
+		}
+	}
+	return revision, nil
+}
+
+// RevisionVersion returns the version of the last DSQL migration of the given schema revision.
+func RevisionVersion(revision int64) (int64, error) {
+	if revision == 0 {
+		return 0, nil
+	}
+
+	versions, err := migrationVersions()
+	if err != nil {
+		return 0, err
+	}
+
+	var last int64
+	for _, v := range versions {
+		if v/MigrationVersionMultiplier == revision {
+			last = v
+		}
+	}
+	if last == 0 {
+		return 0, fmt.Errorf("no DSQL migration for schema revision %d", revision)
+	}
+	return last, nil
+}
+
+// UpgradeLegacyGooseVersions rewrites the goose_db_version rows recorded by the earlier
+// one-file-per-revision layout of the DSQL migrations into the versions of the migrations that
+// replaced them, so that goose does not run them again. It is a no-op once done.
+func UpgradeLegacyGooseVersions(ctx context.Context, db *sql.DB) error {
+	current, err := goose.GetDBVersionContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("read goose version: %w", err)
+	}
+	if current == 0 || current >= MigrationVersionMultiplier {
+		return nil
+	}
+
+	var maxID int64
+	if err := db.QueryRowContext(ctx, `SELECT MAX(id) FROM goose_db_version`).Scan(&maxID); err != nil {
+		return fmt.Errorf("read goose version ids: %w", err)
+	}
+
+	versions, err := migrationVersions()
+	if err != nil {
+		return err
+	}
+
+	txn, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = txn.Rollback() }()
+
+	if _, err := txn.ExecContext(ctx,
+		`DELETE FROM goose_db_version WHERE version_id > 0 AND version_id < $1`, MigrationVersionMultiplier,
+	); err != nil {
+		return fmt.Errorf("delete legacy goose versions: %w", err)
+	}
+
+	// The default id is the transaction time, which would collide within a single transaction.
+	for _, v := range versions {
+		if v/MigrationVersionMultiplier > current {
+			break
+		}
+		maxID++
+		if _, err := txn.ExecContext(ctx,
+			`INSERT INTO goose_db_version (id, version_id, is_applied) VALUES ($1, $2, TRUE)`, maxID, v,
+		); err != nil {
+			return fmt.Errorf("insert goose version %d: %w", v, err)
+		}
+	}
+
+	return txn.Commit()
+}
@@ -0,0 +1,166 @@
+package dsql
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/openfga/openfga/assets"
+)
+
+func TestEmbeddedMigrationsAreValid(t *testing.T) {
+	require.NoError(t, ValidateMigrations(assets.EmbedMigrations, assets.DSQLMigrationDir))
+}
+
+func TestParseMigration(t *testing.T) {
+	m, err := ParseMigration(`-- +goose Up
+-- +goose NO TRANSACTION
+-- a comment
+ALTER TABLE tuple ADD COLUMN condition_name TEXT;
+CREATE TABLE IF NOT EXISTS foo (
+    id TEXT PRIMARY KEY
+);
+
+-- +goose Down
+ALTER TABLE tuple DROP COLUMN condition_name;
+DROP TABLE foo;
+`)
+	require.NoError(t, err)
+	require.True(t, m.NoTransaction)
+	require.Equal(t, []string{
+		"ALTER TABLE tuple ADD COLUMN condition_name TEXT;",
+		"CREATE TABLE IF NOT EXISTS foo (\nid TEXT PRIMARY KEY\n);",
+	}, m.Up)
+	require.Equal(t, []string{
+		"ALTER TABLE tuple DROP COLUMN condition_name;",
+		"DROP TABLE foo;",
+	}, m.Down)
+
+	t.Run("split_pairs_up_and_down_statements", func(t *testing.T) {
+		parts := m.Split()
+		require.Len(t, parts, 2)
+		require.Equal(t, `-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE tuple ADD COLUMN condition_name TEXT;
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE tuple DROP COLUMN condition_name;
+`, parts[0].Format())
+
+		reparsed, err := ParseMigration(parts[1].Format())
+		require.NoError(t, err)
+		require.Equal(t, parts[1], reparsed)
+	})
+
+	t.Run("missing_semicolon", func(t *testing.T) {
+		_, err := ParseMigration("-- +goose Up\nDROP TABLE IF EXISTS foo\n")
+		require.ErrorContains(t, err, "terminating semicolon")
+	})
+
+	t.Run("statement_outside_section", func(t *testing.T) {
+		_, err := ParseMigration("DROP TABLE IF EXISTS foo;\n")
+		require.Error(t, err)
+	})
+}
+
+func TestMigrationValidate(t *testing.T) {
+	tests := map[string]struct {
+		migration   Migration
+		expectedErr string
+	}{
+		"create_index_async": {
+			migration: Migration{NoTransaction: true, Up: []string{"CREATE UNIQUE INDEX ASYNC IF NOT EXISTS idx ON tuple (ulid);"}, Down: []string{"DROP INDEX IF EXISTS idx;"}},
+		},
+		"add_column": {
+			migration: Migration{NoTransaction: true, Up: []string{"ALTER TABLE tuple ADD COLUMN IF NOT EXISTS c TEXT;"}},
+		},
+		"backfill": {
+			migration: Migration{NoTransaction: true, Up: []string{"UPDATE authorization_model SET schema_version = '1.0' WHERE schema_version IS NULL;"}},
+		},
+		"transactional": {
+			migration:   Migration{Up: []string{"DROP TABLE IF EXISTS foo;"}},
+			expectedErr: "missing '-- +goose NO TRANSACTION'",
+		},
+		"several_up_statements": {
+			migration:   Migration{NoTransaction: true, Up: []string{"DROP TABLE IF EXISTS foo;", "DROP TABLE IF EXISTS bar;"}},
+			expectedErr: "the Up section must hold exactly one statement, found 2",
+		},
+		"several_down_statements": {
+			migration:   Migration{NoTransaction: true, Up: []string{"DROP TABLE IF EXISTS foo;"}, Down: []string{"DROP TABLE IF EXISTS foo;", "DROP TABLE IF EXISTS bar;"}},
+			expectedErr: "the Down section must hold at most one statement, found 2",
+		},
+		"create_without_if_not_exists": {
+			migration:   Migration{NoTransaction: true, Up: []string{"CREATE INDEX ASYNC idx ON tuple (ulid);"}},
+			expectedErr: "CREATE statement must use IF NOT EXISTS",
+		},
+		"drop_without_if_exists": {
+			migration:   Migration{NoTransaction: true, Up: []string{"ALTER TABLE tuple ADD COLUMN IF NOT EXISTS c TEXT;"}, Down: []string{"DROP INDEX idx;"}},
+			expectedErr: "DROP statement must use IF EXISTS",
+		},
+		"add_column_without_if_not_exists": {
+			migration:   Migration{NoTransaction: true, Up: []string{"ALTER TABLE tuple ADD COLUMN c TEXT;"}},
+			expectedErr: "ALTER TABLE statement must be ADD COLUMN IF NOT EXISTS or DROP COLUMN IF EXISTS",
+		},
+		"backfill_without_where": {
+			migration:   Migration{NoTransaction: true, Up: []string{"UPDATE authorization_model SET schema_version = '1.0';"}},
+			expectedErr: "data migration must have a WHERE clause",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.migration.Validate()
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateMigrationsChecksVersions(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dsql/101_ok.sql":  {Data: []byte("-- +goose Up\n-- +goose NO TRANSACTION\nDROP TABLE IF EXISTS foo;\n")},
+		"dsql/200_bad.sql": {Data: []byte("-- +goose Up\n-- +goose NO TRANSACTION\nDROP TABLE IF EXISTS foo;\n")},
+		"dsql/7_bad.sql":   {Data: []byte("-- +goose Up\n-- +goose NO TRANSACTION\nDROP TABLE IF EXISTS foo;\n")},
+		"dsql/README.md":   {Data: []byte("not a migration")},
+	}
+	err := ValidateMigrations(fsys, "dsql")
+	require.ErrorContains(t, err, "200_bad.sql: version must be")
+	require.ErrorContains(t, err, "7_bad.sql: version must be")
+	require.NotContains(t, err.Error(), "101_ok.sql")
+}
+
+func TestSchemaRevision(t *testing.T) {
+	tests := map[int64]int64{
+		0:   0,
+		5:   5, // earlier one-file-per-revision layout
+		106: 0,
+		107: 1,
+		201: 1,
+		202: 2,
+		401: 4,
+		503: 4,
+		504: 5,
+		601: 5,
+		602: 6,
+	}
+	for version, expected := range tests {
+		revision, err := SchemaRevision(version)
+		require.NoError(t, err)
+		require.Equal(t, expected, revision, version)
+	}
+}
+
+func TestRevisionVersion(t *testing.T) {
+	for revision, expected := range map[int64]int64{0: 0, 1: 107, 2: 202, 5: 504, 6: 602} {
+		version, err := RevisionVersion(revision)
+		require.NoError(t, err)
+		require.Equal(t, expected, version, revision)
+	}
+
+	_, err := RevisionVersion(99)
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+// Command splitmigration splits a goose SQL migration holding several statements into DSQL
+// migrations of one statement each, numbered <revision>*100+<step>.
+//
+// Usage:
+//
+//	go run ./internal/dsql/splitmigration -revision 7 -out assets/migrations/dsql 007_add_foo.sql
+//
+// The generated files still have to pass [dsql.ValidateMigrations]; in particular statements
+// must be made idempotent (IF NOT EXISTS, IF EXISTS) by hand.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openfga/openfga/internal/dsql"
+)
+
+func main() {
+	revision := flag.Int("revision", 0, "the schema revision the migration applies")
+	out := flag.String("out", ".", "the directory to write the split migrations to")
+	flag.Parse()
+
+	if err := run(*revision, *out, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(revision int, out string, args []string) error {
+	if len(args) != 1 || revision <= 0 {
+		return fmt.Errorf("usage: splitmigration -revision <n> [-out <dir>] <migration.sql>")
+	}
+
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	m, err := dsql.ParseMigration(string(content))
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(args[0]), ".sql")
+	if _, rest, ok := strings.Cut(name, "_"); ok {
+		name = rest
+	}
+
+	parts := m.Split()
+	if len(parts) == 0 {
+		return fmt.Errorf("%s: no Up statement", args[0])
+	}
+	if len(m.Down) > 0 && parts[0].Down == nil {
+		fmt.Fprintf(os.Stderr, "%d Up and %d Down statements, write the Down sections by hand\n", len(m.Up), len(m.Down))
+	}
+	for i, part := range parts {
+		file := filepath.Join(out, fmt.Sprintf("%d_%s_%d.sql", revision*dsql.MigrationVersionMultiplier+i+1, name, i+1))
+		if err := os.WriteFile(file, []byte(part.Format()), 0o600); err != nil {
+			return err
+		}
+		fmt.Println(file)
+	}
+	return nil
+}
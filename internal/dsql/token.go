@@ -0,0 +1,276 @@
+package dsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/feature/dsql/auth"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+const (
+	// DefaultTokenLifetime is how long the generated IAM authentication tokens are valid by default.
+	DefaultTokenLifetime = 15 * time.Minute
+
+	// MaxTokenLifetime is the longest lifetime DSQL accepts for an IAM authentication token.
+	MaxTokenLifetime = 7 * 24 * time.Hour
+
+	// adminUser is the DSQL role that connects with admin tokens, and the default user.
+	adminUser = "admin"
+
+	// tokenRefreshFraction is the fraction of the lifetime of a token after which a new one is
+	// generated, so that connections are never opened with a token about to expire.
+	tokenRefreshFraction = 0.8
+)
+
+// TokenConfig configures the credentials used to generate the IAM authentication tokens.
+type TokenConfig struct {
+	// Profile is the AWS shared config profile to load the credentials from. When empty, the
+	// default credential chain is used.
+	Profile string
+
+	// RoleARN is the IAM role to assume, with the loaded credentials, to generate the tokens.
+	RoleARN string
+
+	// RoleSessionName is the session name used when assuming RoleARN.
+	RoleSessionName string
+
+	// RoleExternalID is the external id required by the trust policy of RoleARN, if any.
+	RoleExternalID string
+
+	// Lifetime is how long each token is valid. Defaults to [DefaultTokenLifetime].
+	Lifetime time.Duration
+}
+
+// Validate returns an error if c holds inconsistent settings.
+func (c TokenConfig) Validate() error {
+	if c.Lifetime < 0 || c.Lifetime > MaxTokenLifetime {
+		return fmt.Errorf("token lifetime must be between 0 and %s, got %s", MaxTokenLifetime, c.Lifetime)
+	}
+	if c.RoleARN == "" && (c.RoleSessionName != "" || c.RoleExternalID != "") {
+		return errors.New("a role session name or external id requires a role ARN")
+	}
+	return nil
+}
+
+// TokenProvider generates the IAM authentication tokens used as the password of DSQL
+// connections. The current token is reused until most of its lifetime has passed, so that
+// long-running commands keep opening connections after the first token expired.
+type TokenProvider struct {
+	lifetime time.Duration
+	generate func(ctx context.Context, lifetime time.Duration) (string, error)
+	now      func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+// NewTokenProvider returns a [TokenProvider] generating tokens for user on the cluster at
+// endpoint, with the credentials described by cfg. The credentials are loaded with ctx.
+func NewTokenProvider(ctx context.Context, endpoint, region, user string, cfg TokenConfig) (*TokenProvider, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	creds, region, err := loadCredentials(ctx, region, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if region == "" {
+		return nil, fmt.Errorf("cannot determine the AWS region of %q, set the 'region' URI parameter", endpoint)
+	}
+
+	generateToken := auth.GenerateDbConnectAuthToken
+	if user == adminUser {
+		generateToken = auth.GenerateDBConnectAdminAuthToken
+	}
+
+	return newTokenProvider(cfg.Lifetime, func(ctx context.Context, lifetime time.Duration) (string, error) {
+		return generateToken(ctx, endpoint, region, creds, func(o *auth.TokenOptions) {
+			o.ExpiresIn = lifetime
+		})
+	}), nil
+}
+
+func newTokenProvider(lifetime time.Duration, generate func(context.Context, time.Duration) (string, error)) *TokenProvider {
+	if lifetime == 0 {
+		lifetime = DefaultTokenLifetime
+	}
+	return &TokenProvider{
+		lifetime: lifetime,
+		generate: generate,
+		now:      time.Now,
+	}
+}
+
+// loadCredentials loads the AWS credentials described by cfg, and returns them along with the
+// region, which defaults to the one of the loaded AWS config.
+func loadCredentials(ctx context.Context, region string, cfg TokenConfig) (aws.CredentialsProvider, string, error) {
+	var opts []func(*config.LoadOptions) error
+	if cfg.Profile != "" {
+		opts = append(opts, config.WithSharedConfigProfile(cfg.Profile))
+	}
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, "", fmt.Errorf("load AWS config: %w", err)
+	}
+
+	creds := awsCfg.Credentials
+	if cfg.RoleARN != "" {
+		assumeRole := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(awsCfg), cfg.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			if cfg.RoleSessionName != "" {
+				o.RoleSessionName = cfg.RoleSessionName
+			}
+			if cfg.RoleExternalID != "" {
+				o.ExternalID = aws.String(cfg.RoleExternalID)
+			}
+		})
+		creds = aws.NewCredentialsCache(assumeRole)
+	}
+	return creds, awsCfg.Region, nil
+}
+
+// Token returns a valid token, generating a new one if the current token is close to expiring.
+// Generating a token stops when ctx is done.
+func (p *TokenProvider) Token(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.token != "" && now.Before(p.refreshAt) {
+		return p.token, nil
+	}
+
+	token, err := p.generate(ctx, p.lifetime)
+	if err != nil {
+		return "", fmt.Errorf("generate DSQL auth token: %w", err)
+	}
+	p.token = token
+	p.refreshAt = now.Add(time.Duration(float64(p.lifetime) * tokenRefreshFraction))
+	return token, nil
+}
+
+// BeforeConnect sets a valid token as the password of the connection about to be opened. It is
+// meant to be used as the pgx BeforeConnect hook.
+func (p *TokenProvider) BeforeConnect(ctx context.Context, cfg *pgx.ConnConfig) error {
+	token, err := p.Token(ctx)
+	if err != nil {
+		return err
+	}
+	cfg.Password = token
+	return nil
+}
+
+// connectionSettings are the settings of a dsql:// URI.
+type connectionSettings struct {
+	pgURI  string
+	host   string
+	region string
+	user   string
+}
+
+// parseURI converts a dsql:// URI into a postgres:// URI without password. If username is
+// provided, it overrides the username of the URI, which defaults to admin. The region is taken
+// from the 'region' URI parameter, or from the cluster endpoint
+// (<cluster>.dsql.<region>.on.aws).
+func parseURI(uri, username string) (*connectionSettings, error) {
+	if !strings.HasPrefix(uri, "dsql://") {
+		return nil, errors.New("DSQL URI must use the dsql:// scheme")
+	}
+
+	dbURI, err := url.Parse("postgres" + strings.TrimPrefix(uri, "dsql"))
+	if err != nil {
+		return nil, fmt.Errorf("parse database URI: %w", err)
+	}
+
+	user := username
+	if user == "" && dbURI.User != nil {
+		user = dbURI.User.Username()
+	}
+	if user == "" {
+		user = adminUser
+	}
+	dbURI.User = url.User(user)
+
+	q := dbURI.Query()
+	region := q.Get("region")
+	if region == "" {
+		if labels := strings.Split(dbURI.Hostname(), "."); len(labels) == 5 && labels[1] == "dsql" {
+			region = labels[2]
+		}
+	}
+	q.Set("sslmode", "require")
+	q.Del("region")
+	dbURI.RawQuery = q.Encode()
+
+	return &connectionSettings{
+		pgURI:  dbURI.String(),
+		host:   dbURI.Hostname(),
+		region: region,
+		user:   user,
+	}, nil
+}
+
+// PoolConfig returns the configuration of a pgx pool connecting to the cluster of the dsql://
+// uri, authenticating every new connection with a fresh IAM token, see [TokenProvider].
+// If username is provided, it overrides the username of the URI, which defaults to admin.
+func PoolConfig(ctx context.Context, uri, username string, cfg TokenConfig) (*pgxpool.Config, error) {
+	settings, err := parseURI(uri, username)
+	if err != nil {
+		return nil, err
+	}
+
+	poolCfg, err := pgxpool.ParseConfig(settings.pgURI)
+	if err != nil {
+		return nil, fmt.Errorf("parse database URI: %w", err)
+	}
+
+	provider, err := NewTokenProvider(ctx, settings.host, settings.region, settings.user, cfg)
+	if err != nil {
+		return nil, err
+	}
+	poolCfg.BeforeConnect = provider.BeforeConnect
+	return poolCfg, nil
+}
+
+// OpenDB opens a database/sql connection pool to the cluster of the dsql:// uri, authenticating
+// every new connection with a fresh IAM token, see [TokenProvider].
+// If username is provided, it overrides the username of the URI, which defaults to admin.
+func OpenDB(ctx context.Context, uri, username string, cfg TokenConfig) (*sql.DB, error) {
+	settings, err := parseURI(uri, username)
+	if err != nil {
+		return nil, err
+	}
+
+	connCfg, err := pgx.ParseConfig(settings.pgURI)
+	if err != nil {
+		return nil, fmt.Errorf("parse database URI: %w", err)
+	}
+
+	provider, err := NewTokenProvider(ctx, settings.host, settings.region, settings.user, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return stdlib.OpenDB(*connCfg, stdlib.OptionBeforeConnect(provider.BeforeConnect)), nil
+}
@@ -0,0 +1,133 @@
+package dsql
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenProvider(t *testing.T) {
+	newProvider := func(lifetime time.Duration) (*TokenProvider, *int, *time.Time) {
+		generated := 0
+		now := time.Now()
+		p := newTokenProvider(lifetime, func(_ context.Context, lifetime time.Duration) (string, error) {
+			generated++
+			return "token-" + strconv.Itoa(generated) + "-" + lifetime.String(), nil
+		})
+		p.now = func() time.Time { return now }
+		return p, &generated, &now
+	}
+
+	t.Run("reuses_the_token_until_it_is_close_to_expiring", func(t *testing.T) {
+		p, generated, now := newProvider(10 * time.Minute)
+
+		token, err := p.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token-1-10m0s", token)
+
+		*now = now.Add(7 * time.Minute)
+		token, err = p.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token-1-10m0s", token)
+
+		*now = now.Add(1 * time.Minute)
+		token, err = p.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token-2-10m0s", token)
+		require.Equal(t, 2, *generated)
+	})
+
+	t.Run("lifetime_defaults", func(t *testing.T) {
+		p, _, _ := newProvider(0)
+		token, err := p.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token-1-15m0s", token)
+	})
+
+	t.Run("stops_on_context_cancellation", func(t *testing.T) {
+		p, generated, _ := newProvider(time.Minute)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := p.Token(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Zero(t, *generated)
+	})
+
+	t.Run("generation_errors_are_not_cached", func(t *testing.T) {
+		attempts := 0
+		p := newTokenProvider(time.Minute, func(context.Context, time.Duration) (string, error) {
+			attempts++
+			if attempts == 1 {
+				return "", errors.New("expired credentials")
+			}
+			return "token", nil
+		})
+
+		_, err := p.Token(context.Background())
+		require.ErrorContains(t, err, "expired credentials")
+
+		token, err := p.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token", token)
+	})
+
+	t.Run("before_connect_sets_the_password", func(t *testing.T) {
+		p, _, _ := newProvider(time.Minute)
+		cfg := &pgx.ConnConfig{}
+		require.NoError(t, p.BeforeConnect(context.Background(), cfg))
+		require.Equal(t, "token-1-1m0s", cfg.Password)
+	})
+}
+
+func TestTokenConfigValidate(t *testing.T) {
+	require.NoError(t, TokenConfig{}.Validate())
+	require.NoError(t, TokenConfig{
+		RoleARN:         "arn:aws:iam::123456789012:role/openfga",
+		RoleSessionName: "openfga",
+		RoleExternalID:  "external",
+		Lifetime:        time.Hour,
+	}.Validate())
+
+	require.ErrorContains(t, TokenConfig{Lifetime: -time.Second}.Validate(), "token lifetime")
+	require.ErrorContains(t, TokenConfig{Lifetime: MaxTokenLifetime + time.Second}.Validate(), "token lifetime")
+	require.ErrorContains(t, TokenConfig{RoleSessionName: "openfga"}.Validate(), "requires a role ARN")
+}
+
+func TestParseURI(t *testing.T) {
+	t.Run("region_from_endpoint", func(t *testing.T) {
+		settings, err := parseURI("dsql://abc.dsql.eu-west-1.on.aws/postgres", "")
+		require.NoError(t, err)
+		require.Equal(t, &connectionSettings{
+			pgURI:  "postgres://admin@abc.dsql.eu-west-1.on.aws/postgres?sslmode=require",
+			host:   "abc.dsql.eu-west-1.on.aws",
+			region: "eu-west-1",
+			user:   "admin",
+		}, settings)
+	})
+
+	t.Run("region_parameter_and_username_override", func(t *testing.T) {
+		settings, err := parseURI("dsql://reader@cluster.example.com:5432/postgres?region=us-east-2", "openfga")
+		require.NoError(t, err)
+		require.Equal(t, "postgres://openfga@cluster.example.com:5432/postgres?sslmode=require", settings.pgURI)
+		require.Equal(t, "cluster.example.com", settings.host)
+		require.Equal(t, "us-east-2", settings.region)
+		require.Equal(t, "openfga", settings.user)
+	})
+
+	t.Run("username_from_uri", func(t *testing.T) {
+		settings, err := parseURI("dsql://reader@abc.dsql.us-east-1.on.aws/postgres", "")
+		require.NoError(t, err)
+		require.Equal(t, "reader", settings.user)
+	})
+
+	t.Run("rejects_other_schemes", func(t *testing.T) {
+		_, err := parseURI("postgres://abc.dsql.us-east-1.on.aws/postgres", "")
+		require.ErrorContains(t, err, "dsql://")
+	})
+}
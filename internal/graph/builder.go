@@ -6,6 +6,7 @@ type CheckResolverOrderedBuilder struct {
 	shadowLocalCheckerOptions              []LocalCheckerOption
 	shadowResolverEnabled                  bool
 	shadowResolverOptions                  []ShadowResolverOpt
+	requestScopedCheckResolverEnabled      bool
 	cachedCheckResolverEnabled             bool
 	cachedCheckResolverOptions             []CachedCheckResolverOpt
 	dispatchThrottlingCheckResolverEnabled bool
@@ -39,6 +40,14 @@ func WithShadowResolverOpts(opts ...ShadowResolverOpt) CheckResolverOrderedBuild
 	}
 }
 
+// WithRequestScopedCheckResolverEnabled adds a RequestScopedCheckResolver at the head of the chain,
+// for the resolvers built for a single request.
+func WithRequestScopedCheckResolverEnabled(enabled bool) CheckResolverOrderedBuilderOpt {
+	return func(r *CheckResolverOrderedBuilder) {
+		r.requestScopedCheckResolverEnabled = enabled
+	}
+}
+
 // WithCachedCheckResolverOpts sets the opts to be used to build CachedCheckResolver.
 func WithCachedCheckResolverOpts(enabled bool, opts ...CachedCheckResolverOpt) CheckResolverOrderedBuilderOpt {
 	return func(r *CheckResolverOrderedBuilder) {
@@ -73,6 +82,10 @@ func NewOrderedCheckResolvers(opts ...CheckResolverOrderedBuilderOpt) *CheckReso
 func (c *CheckResolverOrderedBuilder) Build() (CheckResolver, CheckResolverCloser, error) {
 	c.resolvers = []CheckResolver{}
 
+	if c.requestScopedCheckResolverEnabled {
+		c.resolvers = append(c.resolvers, NewRequestScopedCheckResolver())
+	}
+
 	if c.cachedCheckResolverEnabled {
 		cachedCheckResolver, err := NewCachedCheckResolver(c.cachedCheckResolverOptions...)
 		if err != nil {
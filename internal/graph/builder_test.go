@@ -13,6 +13,7 @@ func TestNewOrderedCheckResolverBuilder(t *testing.T) {
 		CachedCheckResolverEnabled             bool
 		DispatchThrottlingCheckResolverEnabled bool
 		ShadowResolverEnabled                  bool
+		RequestScopedCheckResolverEnabled      bool
 		expectedResolverOrder                  []CheckResolver
 	}
 
@@ -44,6 +45,12 @@ func TestNewOrderedCheckResolverBuilder(t *testing.T) {
 			ShadowResolverEnabled:                  true,
 			expectedResolverOrder:                  []CheckResolver{&CachedCheckResolver{}, &DispatchThrottlingCheckResolver{}, &ShadowResolver{main: &LocalChecker{}, shadow: &LocalChecker{}}},
 		},
+		{
+			name:                              "when_request_scoped_and_cache_are_enabled",
+			CachedCheckResolverEnabled:        true,
+			RequestScopedCheckResolverEnabled: true,
+			expectedResolverOrder:             []CheckResolver{&RequestScopedCheckResolver{}, &CachedCheckResolver{}, &LocalChecker{}},
+		},
 	}
 
 	for _, test := range tests {
@@ -52,6 +59,7 @@ func TestNewOrderedCheckResolverBuilder(t *testing.T) {
 				WithCachedCheckResolverOpts(test.CachedCheckResolverEnabled),
 				WithDispatchThrottlingCheckResolverOpts(test.DispatchThrottlingCheckResolverEnabled),
 				WithShadowResolverEnabled(test.ShadowResolverEnabled),
+				WithRequestScopedCheckResolverEnabled(test.RequestScopedCheckResolverEnabled),
 			}...)
 			checkResolver, checkResolverCloser, err := builder.Build()
 			require.NoError(t, err)
@@ -19,6 +19,7 @@ import (
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/telemetry"
 	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
 )
 
 const (
@@ -51,6 +52,15 @@ var _ storage.CacheItem = (*CheckResponseCacheEntry)(nil)
 type CheckResponseCacheEntry struct {
 	LastModified  time.Time
 	CheckResponse *ResolveCheckResponse
+
+	// Dependencies are the object types and relations, formatted as "objectType#relation", whose
+	// tuples the resolution could read. When set, the entry is only invalidated by writes to them
+	// (see storage.GetInvalidCheckByObjectRelationCacheKey) rather than by any write to the store.
+	Dependencies []string
+
+	// ExpiresAt is the earliest expiry of the tuples read by the resolution, or the zero time if
+	// none of them expires. The entry is not cached past it.
+	ExpiresAt time.Time
 }
 
 func (c *CheckResponseCacheEntry) CacheEntityType() string {
@@ -156,13 +166,14 @@ func (c *CachedCheckResolver) ResolveCheck(
 
 	cacheKey := BuildCacheKey(*req)
 
-	tryCache := req.Consistency != openfgav1.ConsistencyPreference_HIGHER_CONSISTENCY
+	// An explained request is resolved to record the proof of its outcome, which is not cached.
+	tryCache := req.Consistency != openfgav1.ConsistencyPreference_HIGHER_CONSISTENCY && !req.GetExplain()
 
 	if tryCache {
 		checkCacheTotalCounter.Inc()
 		if cachedResp := c.cache.Get(cacheKey); cachedResp != nil {
 			res := cachedResp.(*CheckResponseCacheEntry)
-			isValid := res.LastModified.After(req.LastCacheInvalidationTime)
+			isValid := c.isValid(req, res)
 			c.logger.Debug("CachedCheckResolver found cache key",
 				zap.String("store_id", req.GetStoreID()),
 				zap.String("authorization_model_id", req.GetAuthorizationModelID()),
@@ -172,6 +183,7 @@ func (c *CachedCheckResolver) ResolveCheck(
 			span.SetAttributes(attribute.Bool("cached", isValid))
 			if isValid {
 				checkCacheHitCounter.Inc()
+				storage.ObserveTupleExpiry(ctx, res.ExpiresAt)
 				// return a copy to avoid races across goroutines
 				return res.CheckResponse.clone(), nil
 			}
@@ -187,7 +199,8 @@ func (c *CachedCheckResolver) ResolveCheck(
 	}
 
 	// not in cache, or consistency options experimental flag is set, and consistency param set to HIGHER_CONSISTENCY
-	resp, err := c.delegate.ResolveCheck(ctx, req)
+	var expiry storage.TupleExpiryRecorder
+	resp, err := c.delegate.ResolveCheck(storage.ContextWithTupleExpiryObserver(ctx, expiry.Observe), req)
 	if err != nil {
 		telemetry.TraceError(span, err)
 		return nil, err
@@ -205,12 +218,58 @@ func (c *CachedCheckResolver) ResolveCheck(
 		return resp, nil
 	}
 
+	expiresAt := expiry.Earliest()
+	ttl := storage.TTLUntilExpiry(c.cacheTTL, expiresAt)
+	if ttl <= 0 {
+		return resp, nil
+	}
+
 	clonedResp := resp.clone()
 
-	c.cache.Set(cacheKey, &CheckResponseCacheEntry{LastModified: time.Now(), CheckResponse: clonedResp}, c.cacheTTL)
+	entry := &CheckResponseCacheEntry{LastModified: time.Now(), CheckResponse: clonedResp, ExpiresAt: expiresAt}
+	if typesys, ok := typesystem.TypesystemFromContext(ctx); ok {
+		objectType := tuple.GetType(req.GetTupleKey().GetObject())
+		entry.Dependencies, _ = typesys.GetRelationDependencies(objectType, req.GetTupleKey().GetRelation())
+	}
+
+	c.cache.Set(cacheKey, entry, ttl)
 	return resp, nil
 }
 
+// isValid returns whether the cached entry was cached after the last write that could change it. An
+// entry cached before the last write to the store remains valid if it has dependencies, none of them
+// were written since, and the invalidations by object type and relation account for the last write.
+func (c *CachedCheckResolver) isValid(req *ResolveCheckRequest, entry *CheckResponseCacheEntry) bool {
+	if entry.LastModified.After(req.LastCacheInvalidationTime) {
+		return true
+	}
+	if entry.Dependencies == nil {
+		return false
+	}
+
+	storeID := req.GetStoreID()
+	recorded, ok := c.cache.Get(storage.GetInvalidCheckRecordedCacheKey(storeID)).(*storage.InvalidEntityCacheEntry)
+	if !ok || recorded.LastModified.Before(req.LastCacheInvalidationTime) {
+		return false
+	}
+
+	if !c.isCachedAfter(storage.GetInvalidCheckCacheKey(storeID), entry.LastModified) {
+		return false
+	}
+	for _, objectRelation := range entry.Dependencies {
+		if !c.isCachedAfter(storage.GetInvalidCheckByObjectRelationCacheKey(storeID, objectRelation), entry.LastModified) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCachedAfter returns whether lastModified is after the invalidation held by the cache under key, if any.
+func (c *CachedCheckResolver) isCachedAfter(key string, lastModified time.Time) bool {
+	invalidEntry, ok := c.cache.Get(key).(*storage.InvalidEntityCacheEntry)
+	return !ok || lastModified.After(invalidEntry.LastModified)
+}
+
 func BuildCacheKey(req ResolveCheckRequest) string {
 	tup := tuple.From(req.GetTupleKey())
 	cacheKeyString := tup.String() + req.GetInvariantCacheKey()
@@ -13,7 +13,10 @@ import (
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/testutils"
 	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
 )
 
 func TestResolveCheckFromCache(t *testing.T) {
@@ -553,6 +556,73 @@ func TestResolveCheckExpired(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestResolveCheckTupleExpiry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	newRequest := func(object string) *ResolveCheckRequest {
+		return &ResolveCheckRequest{
+			StoreID:              "12",
+			AuthorizationModelID: "33",
+			TupleKey:             tuple.NewTupleKey(object, "reader", "user:XYZ"),
+			RequestMetadata:      NewCheckRequestMetadata(),
+		}
+	}
+	result := &ResolveCheckResponse{Allowed: true}
+
+	dut, err := NewCachedCheckResolver(WithCacheTTL(1 * time.Hour))
+	require.NoError(t, err)
+	defer dut.Close()
+
+	t.Run("cached_until_the_earliest_expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Minute)
+		req := newRequest("document:abc")
+
+		mockResolver := NewMockCheckResolver(ctrl)
+		mockResolver.EXPECT().ResolveCheck(gomock.Any(), req).Times(1).DoAndReturn(
+			func(ctx context.Context, _ *ResolveCheckRequest) (*ResolveCheckResponse, error) {
+				storage.ObserveTupleExpiry(ctx, expiresAt.Add(time.Minute))
+				storage.ObserveTupleExpiry(ctx, expiresAt)
+				return result, nil
+			})
+		dut.SetDelegate(mockResolver)
+
+		var recorder storage.TupleExpiryRecorder
+		_, err := dut.ResolveCheck(storage.ContextWithTupleExpiryObserver(ctx, recorder.Observe), req)
+		require.NoError(t, err)
+		require.Equal(t, expiresAt, recorder.Earliest())
+
+		entry, ok := dut.cache.Get(BuildCacheKey(*req)).(*CheckResponseCacheEntry)
+		require.True(t, ok)
+		require.Equal(t, expiresAt, entry.ExpiresAt)
+
+		// A cache hit reports the expiry of the cached response too.
+		recorder = storage.TupleExpiryRecorder{}
+		_, err = dut.ResolveCheck(storage.ContextWithTupleExpiryObserver(ctx, recorder.Observe), req)
+		require.NoError(t, err)
+		require.Equal(t, expiresAt, recorder.Earliest())
+	})
+
+	t.Run("not_cached_past_the_expiry", func(t *testing.T) {
+		req := newRequest("document:expired")
+
+		mockResolver := NewMockCheckResolver(ctrl)
+		mockResolver.EXPECT().ResolveCheck(gomock.Any(), req).Times(2).DoAndReturn(
+			func(ctx context.Context, _ *ResolveCheckRequest) (*ResolveCheckResponse, error) {
+				storage.ObserveTupleExpiry(ctx, time.Now().Add(-time.Second))
+				return result, nil
+			})
+		dut.SetDelegate(mockResolver)
+
+		for range 2 {
+			_, err := dut.ResolveCheck(ctx, req)
+			require.NoError(t, err)
+		}
+	})
+}
+
 func TestResolveCheckLastChangelogRecent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -641,3 +711,92 @@ func TestBuildCacheKey(t *testing.T) {
 	result := BuildCacheKey(*req)
 	require.NotEmpty(t, result)
 }
+
+func TestResolveCheckFromCacheWithDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ts, err := typesystem.New(testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type folder
+			relations
+				define viewer: [user]
+		type document
+			relations
+				define parent: [folder]
+				define viewer: [user] or viewer from parent
+				define editor: [user]`))
+	require.NoError(t, err)
+	ctx := typesystem.ContextWithTypesystem(context.Background(), ts)
+
+	cache, err := storage.NewInMemoryLRUCache[any]()
+	require.NoError(t, err)
+	defer cache.Stop()
+
+	req := &ResolveCheckRequest{
+		StoreID:              "12",
+		AuthorizationModelID: "33",
+		TupleKey:             tuple.NewTupleKey("document:abc", "viewer", "user:XYZ"),
+		RequestMetadata:      NewCheckRequestMetadata(),
+	}
+
+	mockResolver := NewMockCheckResolver(ctrl)
+	dut, err := NewCachedCheckResolver(WithExistingCache(cache))
+	require.NoError(t, err)
+	defer dut.Close()
+	dut.SetDelegate(mockResolver)
+
+	mockResolver.EXPECT().ResolveCheck(gomock.Any(), req).Times(1).Return(&ResolveCheckResponse{Allowed: true}, nil)
+	_, err = dut.ResolveCheck(ctx, req)
+	require.NoError(t, err)
+
+	entry, ok := cache.Get(BuildCacheKey(*req)).(*CheckResponseCacheEntry)
+	require.True(t, ok)
+	require.Equal(t, []string{"document#parent", "document#viewer", "folder#viewer"}, entry.Dependencies)
+
+	t.Run("unrecorded_write_invalidates_entry", func(t *testing.T) {
+		// without recorded invalidations by object type and relation, the store-wide invalidation time applies.
+		req.LastCacheInvalidationTime = time.Now()
+		defer func() { req.LastCacheInvalidationTime = time.Time{} }()
+
+		mockResolver.EXPECT().ResolveCheck(gomock.Any(), req).Times(1).Return(&ResolveCheckResponse{Allowed: true}, nil)
+		_, err := dut.ResolveCheck(ctx, req)
+		require.NoError(t, err)
+	})
+
+	// invalidate records a write invalidating the cache key as the cache controller does.
+	invalidate := func(t *testing.T, key string) {
+		now := time.Now()
+		cache.Set(key, &storage.InvalidEntityCacheEntry{LastModified: now}, time.Hour)
+		cache.Set(storage.GetInvalidCheckRecordedCacheKey("12"), &storage.InvalidEntityCacheEntry{LastModified: now}, time.Hour)
+		req.LastCacheInvalidationTime = now
+		t.Cleanup(func() { req.LastCacheInvalidationTime = time.Time{} })
+	}
+
+	t.Run("unrelated_write_keeps_entry", func(t *testing.T) {
+		invalidate(t, storage.GetInvalidCheckByObjectRelationCacheKey("12", "document#editor"))
+
+		_, err := dut.ResolveCheck(ctx, req)
+		require.NoError(t, err)
+	})
+
+	t.Run("dependency_write_invalidates_entry", func(t *testing.T) {
+		invalidate(t, storage.GetInvalidCheckByObjectRelationCacheKey("12", "folder#viewer"))
+
+		mockResolver.EXPECT().ResolveCheck(gomock.Any(), req).Times(1).Return(&ResolveCheckResponse{Allowed: false}, nil)
+		resp, err := dut.ResolveCheck(ctx, req)
+		require.NoError(t, err)
+		require.False(t, resp.GetAllowed())
+	})
+
+	t.Run("store_invalidation_invalidates_entry", func(t *testing.T) {
+		invalidate(t, storage.GetInvalidCheckCacheKey("12"))
+
+		mockResolver.EXPECT().ResolveCheck(gomock.Any(), req).Times(1).Return(&ResolveCheckResponse{Allowed: true}, nil)
+		resp, err := dut.ResolveCheck(ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.GetAllowed())
+	})
+}
@@ -407,12 +407,12 @@ func (c *LocalChecker) ResolveCheck(
 	cycle := c.hasCycle(req)
 	if cycle {
 		span.SetAttributes(attribute.Bool("cycle_detected", true))
-		return &ResolveCheckResponse{
+		return c.explainCheck(req, &ResolveCheckResponse{
 			Allowed: false,
 			ResolutionMetadata: ResolveCheckResponseMetadata{
 				CycleDetected: true,
 			},
-		}, nil
+		}, "cycle detected"), nil
 	}
 
 	tupleKey := req.GetTupleKey()
@@ -420,9 +420,9 @@ func (c *LocalChecker) ResolveCheck(
 	relation := tupleKey.GetRelation()
 
 	if tuple.IsSelfDefining(req.GetTupleKey()) {
-		return &ResolveCheckResponse{
+		return c.explainCheck(req, &ResolveCheckResponse{
 			Allowed: true,
-		}, nil
+		}, "the user is the userset of the check"), nil
 	}
 
 	typesys, ok := typesystem.TypesystemFromContext(ctx)
@@ -445,9 +445,9 @@ func (c *LocalChecker) ResolveCheck(
 		return nil, err
 	}
 	if !hasPath {
-		return &ResolveCheckResponse{
+		return c.explainCheck(req, &ResolveCheckResponse{
 			Allowed: false,
-		}, nil
+		}, "the model has no path from the user to the relation"), nil
 	}
 
 	resp, err := c.CheckRewrite(ctx, req, rel.GetRewrite())(ctx)
@@ -456,7 +456,19 @@ func (c *LocalChecker) ResolveCheck(
 		return nil, err
 	}
 
-	return resp, nil
+	return c.explainCheck(req, resp, ""), nil
+}
+
+// explainCheck returns resp explained by the node of the check of req, with the explanation of
+// resp as its child or reason as the reason of its outcome. It returns resp as is if req is not explained.
+func (c *LocalChecker) explainCheck(req *ResolveCheckRequest, resp *ResolveCheckResponse, reason string) *ResolveCheckResponse {
+	if !req.GetExplain() {
+		return resp
+	}
+	explanation := checkExplanation(req.GetTupleKey())
+	explanation.Reason = reason
+	explanation.Children = explanationChildren(resp.GetExplanation())
+	return explainedResponse(resp, explanation)
 }
 
 // hasCycle returns true if a cycle has been found. It modifies the request object.
@@ -518,18 +530,31 @@ func (c *LocalChecker) checkPublicAssignable(ctx context.Context, req *ResolveCh
 		)
 		defer filteredIter.Stop()
 
-		_, err = filteredIter.Next(ctx)
+		t, err := filteredIter.Next(ctx)
 		if err != nil {
 			if errors.Is(err, storage.ErrIteratorDone) {
-				return response, nil
+				return explainLookup(req, response, ExplanationKindPublicWildcard, nil, "no tuple found"), nil
 			}
 			return nil, err
 		}
 		// when we get to here, it means there is public wild card assigned
 		span.SetAttributes(attribute.Bool("allowed", true))
 		response.Allowed = true
-		return response, nil
+		return explainLookup(req, response, ExplanationKindPublicWildcard, t, ""), nil
+	}
+}
+
+// explainLookup returns response explained by a lookup node of the given kind, with the tuple
+// found, if any, and reason as the reason of its outcome. It returns response as is if req is not explained.
+func explainLookup(req *ResolveCheckRequest, response *ResolveCheckResponse, kind ExplanationKind, t *openfgav1.TupleKey, reason string) *ResolveCheckResponse {
+	if !req.GetExplain() {
+		return response
 	}
+	explanation := &CheckExplanation{Kind: kind, Reason: reason}
+	if t != nil {
+		explanation.Tuple = tuple.TupleKeyWithConditionToString(t)
+	}
+	return explainedResponse(response, explanation)
 }
 
 func (c *LocalChecker) checkDirectUserTuple(ctx context.Context, req *ResolveCheckRequest) CheckHandlerFunc {
@@ -557,7 +582,7 @@ func (c *LocalChecker) checkDirectUserTuple(ctx context.Context, req *ResolveChe
 		t, err := ds.ReadUserTuple(ctx, storeID, storage.ReadUserTupleFilter{Object: reqTupleKey.GetObject(), Relation: reqTupleKey.GetRelation(), User: reqTupleKey.GetUser()}, opts)
 		if err != nil {
 			if errors.Is(err, storage.ErrNotFound) {
-				return response, nil
+				return explainLookup(req, response, ExplanationKindDirectUserTuple, nil, "no tuple found"), nil
 			}
 
 			return nil, err
@@ -567,7 +592,7 @@ func (c *LocalChecker) checkDirectUserTuple(ctx context.Context, req *ResolveChe
 		tupleKey := t.GetKey()
 		err = validation.ValidateTupleForRead(typesys, tupleKey)
 		if err != nil {
-			return response, nil
+			return explainLookup(req, response, ExplanationKindDirectUserTuple, tupleKey, "the tuple is invalid in the model"), nil
 		}
 		tupleKeyConditionFilter := checkutil.BuildTupleKeyConditionFilter(ctx, req.Context, typesys)
 		conditionMet, err := tupleKeyConditionFilter(tupleKey)
@@ -578,8 +603,9 @@ func (c *LocalChecker) checkDirectUserTuple(ctx context.Context, req *ResolveChe
 		if conditionMet {
 			span.SetAttributes(attribute.Bool("allowed", true))
 			response.Allowed = true
+			return explainLookup(req, response, ExplanationKindDirectUserTuple, tupleKey, ""), nil
 		}
-		return response, nil
+		return explainLookup(req, response, ExplanationKindDirectUserTuple, tupleKey, "the condition of the tuple is not met"), nil
 	}
 }
 
@@ -649,8 +675,9 @@ func (c *LocalChecker) checkDirectUsersetTuples(ctx context.Context, req *Resolv
 		directlyRelatedUsersetTypes, _ := typesys.DirectlyRelatedUsersets(objectType, relation)
 		isUserset := tuple.IsObjectRelation(reqTupleKey.GetUser())
 
-		// if user in request is userset, we do not have additional strategies to apply
-		if isUserset {
+		// if user in request is userset, we do not have additional strategies to apply. An explained
+		// request also uses the default strategy, which dispatches a check for each userset.
+		if isUserset || req.GetExplain() {
 			iter, err := checkutil.IteratorReadUsersetTuples(ctx, req, directlyRelatedUsersetTypes)
 			if err != nil {
 				return nil, err
@@ -776,13 +803,14 @@ func (c *LocalChecker) checkDirect(parentctx context.Context, req *ResolveCheckR
 			checkFuncs = append(checkFuncs, c.checkDirectUsersetTuples(parentctx, req))
 		}
 
-		resp, err := union(ctx, c.concurrencyLimit, checkFuncs...)
+		recorder := newExplanationRecorder(req)
+		resp, err := union(ctx, c.concurrencyLimit, recorder.wrap(checkFuncs...)...)
 		if err != nil {
 			telemetry.TraceError(span, err)
 			return nil, err
 		}
 
-		return resp, nil
+		return recorder.explain(resp, &CheckExplanation{Kind: ExplanationKindDirect}), nil
 	}
 }
 
@@ -801,7 +829,15 @@ func (c *LocalChecker) checkComputedUserset(_ context.Context, req *ResolveCheck
 		ctx, span := tracer.Start(ctx, "checkComputedUserset")
 		defer span.End()
 		// No dispatch here, as we don't want to increase resolution depth.
-		return c.ResolveCheck(ctx, childRequest)
+		resp, err := c.ResolveCheck(ctx, childRequest)
+		if err != nil || !req.GetExplain() {
+			return resp, err
+		}
+		return explainedResponse(resp, &CheckExplanation{
+			Kind:     ExplanationKindComputedUserset,
+			Relation: rewrittenTupleKey.GetRelation(),
+			Children: explanationChildren(resp.GetExplanation()),
+		}), nil
 	}
 }
 
@@ -867,7 +903,8 @@ func (c *LocalChecker) checkTTU(parentctx context.Context, req *ResolveCheckRequ
 		}
 		isUserset := tuple.IsObjectRelation(tk.GetUser())
 
-		if !isUserset {
+		// An explained request uses the default strategy, which dispatches a check for each tupleset tuple.
+		if !isUserset && !req.GetExplain() {
 			if typesys.TTUUseWeight2Resolver(objectType, relation, userType, rewrite.GetTupleToUserset()) {
 				possibleStrategies[weightTwoResolver] = weight2Plan
 				resolver = c.weight2TTU
@@ -921,25 +958,32 @@ func (c *LocalChecker) checkSetOperation(
 	children ...*openfgav1.Userset,
 ) CheckHandlerFunc {
 	var handlers []CheckHandlerFunc
+	var recorder *explanationRecorder
 
 	var reducerKey string
+	var explanationKind ExplanationKind
 	switch setOpType {
 	case unionSetOperator, intersectionSetOperator, exclusionSetOperator:
 		if setOpType == unionSetOperator {
 			reducerKey = "union"
+			explanationKind = ExplanationKindUnion
 		}
 
 		if setOpType == intersectionSetOperator {
 			reducerKey = "intersection"
+			explanationKind = ExplanationKindIntersection
 		}
 
 		if setOpType == exclusionSetOperator {
 			reducerKey = "exclusion"
+			explanationKind = ExplanationKindExclusion
 		}
 
 		for _, child := range children {
 			handlers = append(handlers, c.CheckRewrite(ctx, req, child))
 		}
+		recorder = newExplanationRecorder(req)
+		handlers = recorder.wrap(handlers...)
 	default:
 		return func(ctx context.Context) (*ResolveCheckResponse, error) {
 			return nil, ErrUnknownSetOperator
@@ -958,7 +1002,10 @@ func (c *LocalChecker) checkSetOperation(
 		}()
 
 		resp, err = reducer(ctx, c.concurrencyLimit, handlers...)
-		return resp, err
+		if err != nil {
+			return nil, err
+		}
+		return recorder.explain(resp, &CheckExplanation{Kind: explanationKind}), nil
 	}
 }
 
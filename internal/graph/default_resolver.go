@@ -52,6 +52,8 @@ type dispatchMsg struct {
 	err            error
 	shortCircuit   bool
 	dispatchParams *dispatchParams
+	// tuple is the tuple that produced the message, only set when the request is explained.
+	tuple *openfgav1.TupleKey
 }
 
 // defaultUserset will check userset path.
@@ -74,7 +76,12 @@ func (c *LocalChecker) defaultUserset(_ context.Context, req *ResolveCheckReques
 			return nil
 		})
 
-		return c.consumeDispatches(ctx, c.concurrencyLimit, dispatchChan)
+		recorder := newExplanationRecorder(req)
+		resp, err := c.consumeDispatches(ctx, c.concurrencyLimit, dispatchChan, recorder)
+		if err != nil {
+			return nil, err
+		}
+		return recorder.explain(resp, &CheckExplanation{Kind: ExplanationKindUsersetTuples}), nil
 	}
 }
 
@@ -101,14 +108,14 @@ func (c *LocalChecker) produceUsersetDispatches(ctx context.Context, req *Resolv
 			wildcardType := tuple.GetType(usersetObject)
 
 			if tuple.GetType(reqTupleKey.GetUser()) == wildcardType {
-				concurrency.TrySendThroughChannel(ctx, dispatchMsg{shortCircuit: true}, dispatches)
+				concurrency.TrySendThroughChannel(ctx, explainedDispatchMsg(req, dispatchMsg{shortCircuit: true}, t), dispatches)
 				break
 			}
 		}
 
 		if usersetRelation != "" {
 			tupleKey := tuple.NewTupleKey(usersetObject, usersetRelation, reqTupleKey.GetUser())
-			concurrency.TrySendThroughChannel(ctx, dispatchMsg{dispatchParams: &dispatchParams{parentReq: req, tk: tupleKey}}, dispatches)
+			concurrency.TrySendThroughChannel(ctx, explainedDispatchMsg(req, dispatchMsg{dispatchParams: &dispatchParams{parentReq: req, tk: tupleKey}}, t), dispatches)
 		}
 	}
 }
@@ -136,10 +143,27 @@ func (c *LocalChecker) defaultTTU(_ context.Context, req *ResolveCheckRequest, r
 			return nil
 		})
 
-		return c.consumeDispatches(ctx, c.concurrencyLimit, dispatchChan)
+		recorder := newExplanationRecorder(req)
+		resp, err := c.consumeDispatches(ctx, c.concurrencyLimit, dispatchChan, recorder)
+		if err != nil {
+			return nil, err
+		}
+		return recorder.explain(resp, &CheckExplanation{
+			Kind:     ExplanationKindTupleToUserset,
+			Relation: computedRelation,
+			Tupleset: rewrite.GetTupleToUserset().GetTupleset().GetRelation(),
+		}), nil
 	}
 }
 
+// explainedDispatchMsg returns msg with the tuple t that produced it if req is explained.
+func explainedDispatchMsg(req *ResolveCheckRequest, msg dispatchMsg, t *openfgav1.TupleKey) dispatchMsg {
+	if req.GetExplain() {
+		msg.tuple = t
+	}
+	return msg
+}
+
 func (c *LocalChecker) produceTTUDispatches(ctx context.Context, computedRelation string, req *ResolveCheckRequest, dispatches chan dispatchMsg, iter storage.TupleKeyIterator) {
 	defer close(dispatches)
 	reqTupleKey := req.GetTupleKey()
@@ -168,13 +192,15 @@ func (c *LocalChecker) produceTTUDispatches(ctx context.Context, computedRelatio
 			User:     reqTupleKey.GetUser(),
 		}
 
-		concurrency.TrySendThroughChannel(ctx, dispatchMsg{dispatchParams: &dispatchParams{parentReq: req, tk: tupleKey}}, dispatches)
+		concurrency.TrySendThroughChannel(ctx, explainedDispatchMsg(req, dispatchMsg{dispatchParams: &dispatchParams{parentReq: req, tk: tupleKey}}, t), dispatches)
 	}
 }
 
-func (c *LocalChecker) consumeDispatches(ctx context.Context, limit int, dispatchChan chan dispatchMsg) (*ResolveCheckResponse, error) {
+// consumeDispatches resolves the dispatches of dispatchChan until one is allowed. The explanations
+// of the outcomes are recorded by recorder, which may be nil.
+func (c *LocalChecker) consumeDispatches(ctx context.Context, limit int, dispatchChan chan dispatchMsg, recorder *explanationRecorder) (*ResolveCheckResponse, error) {
 	cancellableCtx, cancel := context.WithCancel(ctx)
-	outcomeChannel := c.processDispatches(cancellableCtx, limit, dispatchChan)
+	outcomeChannel := c.processDispatches(cancellableCtx, limit, dispatchChan, recorder)
 
 	var finalErr error
 	finalResult := &ResolveCheckResponse{
@@ -219,7 +245,8 @@ ConsumerLoop:
 }
 
 // processDispatches returns a channel where the outcomes of the dispatched checks are sent, and begins sending messages to this channel.
-func (c *LocalChecker) processDispatches(ctx context.Context, limit int, dispatchChan chan dispatchMsg) <-chan checkOutcome {
+// The outcomes of explained messages are explained by a node of the tuple of the message, recorded by recorder.
+func (c *LocalChecker) processDispatches(ctx context.Context, limit int, dispatchChan chan dispatchMsg, recorder *explanationRecorder) <-chan checkOutcome {
 	outcomes := make(chan checkOutcome, limit)
 	dispatchPool := concurrency.NewPool(ctx, limit)
 
@@ -246,14 +273,34 @@ func (c *LocalChecker) processDispatches(ctx context.Context, limit int, dispatc
 					resp := &ResolveCheckResponse{
 						Allowed: true,
 					}
+					if msg.tuple != nil {
+						recorder.start()
+						resp = explainedResponse(resp, &CheckExplanation{
+							Kind:   ExplanationKindTuple,
+							Tuple:  tuple.TupleKeyWithConditionToString(msg.tuple),
+							Reason: "the userset is a wildcard of the type of the user",
+						})
+						recorder.record(resp.Explanation)
+					}
 					concurrency.TrySendThroughChannel(ctx, checkOutcome{resp: resp}, outcomes)
 					return
 				}
 
 				if msg.dispatchParams != nil {
+					if msg.tuple != nil {
+						recorder.start()
+					}
 					dispatchPool.Go(func(ctx context.Context) error {
 						recoveredError := panics.Try(func() {
 							resp, err := c.dispatch(ctx, msg.dispatchParams.parentReq, msg.dispatchParams.tk)(ctx)
+							if err == nil && msg.tuple != nil {
+								resp = explainedResponse(resp, &CheckExplanation{
+									Kind:     ExplanationKindTuple,
+									Tuple:    tuple.TupleKeyWithConditionToString(msg.tuple),
+									Children: explanationChildren(resp.GetExplanation()),
+								})
+								recorder.record(resp.Explanation)
+							}
 							concurrency.TrySendThroughChannel(ctx, checkOutcome{resp: resp, err: err}, outcomes)
 						})
 						if recoveredError != nil {
@@ -695,7 +695,7 @@ func TestProcessDispatch(t *testing.T) {
 				dispatchMsgChan <- dispatchMsg
 			}
 
-			outcomeChan := checker.processDispatches(ctx, tt.poolSize, dispatchMsgChan)
+			outcomeChan := checker.processDispatches(ctx, tt.poolSize, dispatchMsgChan, nil)
 
 			// now, close the channel to simulate everything is sent
 			close(dispatchMsgChan)
@@ -717,7 +717,7 @@ func TestProcessDispatch(t *testing.T) {
 		checker.SetDelegate(mockResolver)
 
 		dispatchChan := make(chan dispatchMsg, 1)
-		outcomeChan := checker.processDispatches(ctx, 1, dispatchChan)
+		outcomeChan := checker.processDispatches(ctx, 1, dispatchChan, nil)
 		dispatchChan <- dispatchMsg{
 			dispatchParams: &dispatchParams{
 				parentReq: nil, // This will cause a panic when accessed in `dispatch`
@@ -909,7 +909,7 @@ func TestConsumeDispatch(t *testing.T) {
 			}
 			close(dispatchMsgChan)
 
-			resp, err := checker.consumeDispatches(ctx, tt.limit, dispatchMsgChan)
+			resp, err := checker.consumeDispatches(ctx, tt.limit, dispatchMsgChan, nil)
 			require.Equal(t, tt.expectedError, err)
 			require.Equal(t, tt.expected, resp)
 		})
@@ -929,7 +929,7 @@ func TestConsumeDispatch(t *testing.T) {
 		}
 		close(dispatchChan)
 
-		_, err := checker.consumeDispatches(ctx, 1, dispatchChan)
+		_, err := checker.consumeDispatches(ctx, 1, dispatchChan, nil)
 
 		require.ErrorContains(t, err, "invalid memory address or nil pointer")
 		require.ErrorIs(t, err, ErrPanic)
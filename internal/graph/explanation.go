@@ -0,0 +1,190 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+// ExplanationKind is what a node of a [CheckExplanation] evaluated.
+type ExplanationKind string
+
+const (
+	// ExplanationKindCheck is the evaluation of a check, e.g. `document:1#viewer@user:anne`, which
+	// has the rewrite of the relation as its child.
+	ExplanationKindCheck ExplanationKind = "check"
+	// ExplanationKindDirect is the evaluation of the type restrictions of a relation, e.g. `[user, group#member]`.
+	ExplanationKindDirect ExplanationKind = "direct"
+	// ExplanationKindDirectUserTuple is the lookup of the tuple relating the user to the object.
+	ExplanationKindDirectUserTuple ExplanationKind = "direct_user_tuple"
+	// ExplanationKindPublicWildcard is the lookup of the tuple relating all the users of the type of
+	// the user to the object, e.g. `user:*`.
+	ExplanationKindPublicWildcard ExplanationKind = "public_wildcard"
+	// ExplanationKindUsersetTuples is the lookup of the tuples relating usersets to the object,
+	// e.g. `group:1#member`, and the checks of the user against them.
+	ExplanationKindUsersetTuples ExplanationKind = "userset_tuples"
+	// ExplanationKindComputedUserset is the evaluation of another relation of the object, e.g. `editor`.
+	ExplanationKindComputedUserset ExplanationKind = "computed_userset"
+	// ExplanationKindTupleToUserset is the evaluation of a relation of the objects related to the
+	// object through a tupleset relation, e.g. `viewer from parent`.
+	ExplanationKindTupleToUserset ExplanationKind = "tuple_to_userset"
+	// ExplanationKindTuple is a tuple read from the store, which has the check it led to as its child.
+	ExplanationKindTuple        ExplanationKind = "tuple"
+	ExplanationKindUnion        ExplanationKind = "union"
+	ExplanationKindIntersection ExplanationKind = "intersection"
+	ExplanationKindExclusion    ExplanationKind = "exclusion"
+)
+
+// CheckExplanation is a node of the proof tree of a check, recorded when the check is resolved
+// with [ResolveCheckRequest.Explain]. An allowed node lists the branches that allowed it, and a
+// denied node lists the branches that were explored. Branches whose evaluation was stopped because
+// the outcome of the node was already known are only counted, as pruned branches.
+type CheckExplanation struct {
+	Kind    ExplanationKind `json:"kind"`
+	Allowed bool            `json:"allowed"`
+
+	// Check is the check of a check node, as `object#relation@user`.
+	Check string `json:"check,omitempty"`
+	// Tuple is the tuple of a tuple node, or the tuple found by a lookup.
+	Tuple string `json:"tuple,omitempty"`
+	// Relation is the relation of a computed userset or tuple to userset node.
+	Relation string `json:"relation,omitempty"`
+	// Tupleset is the tupleset relation of a tuple to userset node.
+	Tupleset string `json:"tupleset,omitempty"`
+	// Reason explains the outcome of nodes without children, e.g. `cycle detected`.
+	Reason string `json:"reason,omitempty"`
+
+	Children       []*CheckExplanation `json:"children,omitempty"`
+	PrunedBranches int                 `json:"pruned_branches,omitempty"`
+	// Truncated is set on the nodes whose children were removed, see [CheckExplanation.Truncate].
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Truncate returns a copy of e without the nodes deeper than depth, e being at depth 0. The nodes at
+// depth that had children are marked as truncated.
+func (e *CheckExplanation) Truncate(depth int) *CheckExplanation {
+	if e == nil {
+		return nil
+	}
+
+	truncated := *e
+	if len(e.Children) == 0 {
+		return &truncated
+	}
+	if depth <= 0 {
+		truncated.Children = nil
+		truncated.Truncated = true
+		return &truncated
+	}
+
+	truncated.Children = make([]*CheckExplanation, 0, len(e.Children))
+	for _, child := range e.Children {
+		truncated.Children = append(truncated.Children, child.Truncate(depth-1))
+	}
+	return &truncated
+}
+
+// explainedResponse returns a response with the outcome of resp explained by explanation.
+func explainedResponse(resp *ResolveCheckResponse, explanation *CheckExplanation) *ResolveCheckResponse {
+	explanation.Allowed = resp.GetAllowed()
+	return &ResolveCheckResponse{
+		Allowed:            resp.GetAllowed(),
+		ResolutionMetadata: resp.GetResolutionMetadata(),
+		Explanation:        explanation,
+	}
+}
+
+// explanationRecorder collects the explanations of the branches of a node, which may be evaluated
+// concurrently. A nil recorder records nothing, so that the branches can be wrapped unconditionally.
+type explanationRecorder struct {
+	mu       sync.Mutex
+	branches int
+	children []*CheckExplanation
+	// explained is set once the node is explained, after which the branches still running, which
+	// were pruned, are not recorded.
+	explained bool
+}
+
+// newExplanationRecorder returns a recorder if req is explained, nil otherwise.
+func newExplanationRecorder(req *ResolveCheckRequest) *explanationRecorder {
+	if !req.GetExplain() {
+		return nil
+	}
+	return &explanationRecorder{}
+}
+
+// start counts a branch whose explanation will be recorded once evaluated.
+func (r *explanationRecorder) start() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.branches++
+}
+
+func (r *explanationRecorder) record(explanation *CheckExplanation) {
+	if r == nil || explanation == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.explained {
+		r.children = append(r.children, explanation)
+	}
+}
+
+// wrap returns handlers recording the explanations of their responses.
+func (r *explanationRecorder) wrap(handlers ...CheckHandlerFunc) []CheckHandlerFunc {
+	if r == nil {
+		return handlers
+	}
+
+	wrapped := make([]CheckHandlerFunc, 0, len(handlers))
+	for _, handler := range handlers {
+		r.start()
+		wrapped = append(wrapped, func(ctx context.Context) (*ResolveCheckResponse, error) {
+			resp, err := handler(ctx)
+			if err == nil {
+				r.record(resp.GetExplanation())
+			}
+			return resp, err
+		})
+	}
+	return wrapped
+}
+
+// explain returns resp explained by explanation, with the recorded explanations as its children.
+// It returns resp as is if the recorder is nil or resp is nil.
+func (r *explanationRecorder) explain(resp *ResolveCheckResponse, explanation *CheckExplanation) *ResolveCheckResponse {
+	if r == nil || resp == nil {
+		return resp
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.explained = true
+	explanation.Children = r.children
+	if r.branches > len(r.children) {
+		explanation.PrunedBranches = r.branches - len(r.children)
+	}
+	return explainedResponse(resp, explanation)
+}
+
+// explanationChildren returns the explanations that are set.
+func explanationChildren(explanations ...*CheckExplanation) []*CheckExplanation {
+	var children []*CheckExplanation
+	for _, explanation := range explanations {
+		if explanation != nil {
+			children = append(children, explanation)
+		}
+	}
+	return children
+}
+
+// checkExplanation returns the node of the check of tk.
+func checkExplanation(tk *openfgav1.TupleKey) *CheckExplanation {
+	return &CheckExplanation{Kind: ExplanationKindCheck, Check: tuple.TupleKeyToString(tk)}
+}
@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/testutils"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// findExplanations returns the nodes of the given kind of the proof tree rooted at explanation.
+func findExplanations(explanation *CheckExplanation, kind ExplanationKind) []*CheckExplanation {
+	var found []*CheckExplanation
+	if explanation.Kind == kind {
+		found = append(found, explanation)
+	}
+	for _, child := range explanation.Children {
+		found = append(found, findExplanations(child, kind)...)
+	}
+	return found
+}
+
+func TestCheckExplanation(t *testing.T) {
+	checker, checkResolverCloser, err := NewOrderedCheckResolvers(WithCachedCheckResolverOpts(true)).Build()
+	require.NoError(t, err)
+	t.Cleanup(checkResolverCloser)
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	storeID := ulid.Make().String()
+	require.NoError(t, ds.Write(context.Background(), storeID, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("group:eng", "member", "user:anne"),
+		tuple.NewTupleKey("folder:x", "viewer", "group:eng#member"),
+		tuple.NewTupleKey("document:1", "parent", "folder:x"),
+		tuple.NewTupleKey("document:1", "blocked", "user:bob"),
+	}))
+
+	ts, err := typesystem.New(testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type group
+			relations
+				define member: [user]
+		type folder
+			relations
+				define viewer: [user, group#member]
+		type document
+			relations
+				define parent: [folder]
+				define blocked: [user]
+				define owner: [user]
+				define editor: [user] or owner
+				define viewer: (editor or viewer from parent) but not blocked
+	`))
+	require.NoError(t, err)
+	ctx := setRequestContext(context.Background(), ts, ds, nil)
+
+	check := func(t *testing.T, user string, explain bool) *ResolveCheckResponse {
+		t.Helper()
+		req, err := NewResolveCheckRequest(ResolveCheckRequestParams{
+			StoreID:              storeID,
+			AuthorizationModelID: ts.GetAuthorizationModelID(),
+			TupleKey:             tuple.NewTupleKey("document:1", "viewer", user),
+			Explain:              explain,
+		})
+		require.NoError(t, err)
+
+		resp, err := checker.ResolveCheck(ctx, req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("not_explained", func(t *testing.T) {
+		resp := check(t, "user:anne", false)
+		require.True(t, resp.GetAllowed())
+		require.Nil(t, resp.GetExplanation())
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		// The previous check cached the outcome, which must not hide the proof.
+		resp := check(t, "user:anne", true)
+		require.True(t, resp.GetAllowed())
+
+		explanation := resp.GetExplanation()
+		require.NotNil(t, explanation)
+		require.Equal(t, ExplanationKindCheck, explanation.Kind)
+		require.Equal(t, "document:1#viewer@user:anne", explanation.Check)
+		require.True(t, explanation.Allowed)
+		require.Len(t, explanation.Children, 1)
+		require.Equal(t, ExplanationKindExclusion, explanation.Children[0].Kind)
+
+		ttus := findExplanations(explanation, ExplanationKindTupleToUserset)
+		require.Len(t, ttus, 1)
+		require.True(t, ttus[0].Allowed)
+		require.Equal(t, "viewer", ttus[0].Relation)
+		require.Equal(t, "parent", ttus[0].Tupleset)
+
+		var allowedTuples []string
+		for _, node := range findExplanations(explanation, ExplanationKindTuple) {
+			if node.Allowed {
+				allowedTuples = append(allowedTuples, node.Tuple)
+			}
+		}
+		require.ElementsMatch(t, []string{"document:1#parent@folder:x", "folder:x#viewer@group:eng#member"}, allowedTuples)
+
+		var directTuples []string
+		for _, node := range findExplanations(explanation, ExplanationKindDirectUserTuple) {
+			if node.Allowed {
+				directTuples = append(directTuples, node.Tuple)
+			}
+		}
+		require.Equal(t, []string{"group:eng#member@user:anne"}, directTuples)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		resp := check(t, "user:bob", true)
+		require.False(t, resp.GetAllowed())
+
+		explanation := resp.GetExplanation()
+		require.NotNil(t, explanation)
+		require.False(t, explanation.Allowed)
+
+		blocked := findExplanations(explanation, ExplanationKindDirectUserTuple)
+		require.NotEmpty(t, blocked)
+		var found bool
+		for _, node := range blocked {
+			if node.Tuple == "document:1#blocked@user:bob" {
+				found = true
+				require.True(t, node.Allowed)
+			}
+		}
+		require.True(t, found)
+	})
+
+	t.Run("no_path", func(t *testing.T) {
+		resp := check(t, "group:eng", true)
+		require.False(t, resp.GetAllowed())
+		require.NotEmpty(t, resp.GetExplanation().Reason)
+	})
+}
+
+func TestCheckExplanationTruncate(t *testing.T) {
+	explanation := &CheckExplanation{
+		Kind:    ExplanationKindCheck,
+		Allowed: true,
+		Children: []*CheckExplanation{
+			{
+				Kind:     ExplanationKindUnion,
+				Allowed:  true,
+				Children: []*CheckExplanation{{Kind: ExplanationKindDirectUserTuple, Allowed: true}},
+			},
+			{Kind: ExplanationKindComputedUserset, PrunedBranches: 1},
+		},
+	}
+
+	require.Equal(t, &CheckExplanation{Kind: ExplanationKindCheck, Allowed: true, Truncated: true}, explanation.Truncate(0))
+	require.Equal(t, &CheckExplanation{
+		Kind:    ExplanationKindCheck,
+		Allowed: true,
+		Children: []*CheckExplanation{
+			{Kind: ExplanationKindUnion, Allowed: true, Truncated: true},
+			{Kind: ExplanationKindComputedUserset, PrunedBranches: 1},
+		},
+	}, explanation.Truncate(1))
+	require.Equal(t, explanation, explanation.Truncate(2))
+	require.Nil(t, (*CheckExplanation)(nil).Truncate(1))
+}
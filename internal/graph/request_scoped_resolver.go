@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// RequestScopedCheckResolver remembers the check sub-problems that it resolved before delegating
+// the request to some underlying CheckResolver, so that the sub-problems that the checks of a single
+// request have in common, like the relations of a ListRelations request, are resolved once. It is
+// meant to be built for a single request: its results are never invalidated, and they are kept
+// until it is closed.
+//
+// Sub-problems being resolved are not waited for, since a sub-problem may depend on another one
+// that depends on it, so concurrent checks may still resolve the same sub-problem.
+type RequestScopedCheckResolver struct {
+	delegate CheckResolver
+
+	mu       sync.Mutex
+	resolved map[string]requestScopedResult
+}
+
+var _ CheckResolver = (*RequestScopedCheckResolver)(nil)
+
+// requestScopedResult is a sub-problem resolved by a RequestScopedCheckResolver.
+type requestScopedResult struct {
+	resp *ResolveCheckResponse
+	// expiresAt is the earliest expiry of the tuples read by the resolution, if any.
+	expiresAt time.Time
+}
+
+func NewRequestScopedCheckResolver() *RequestScopedCheckResolver {
+	checker := &RequestScopedCheckResolver{
+		resolved: make(map[string]requestScopedResult),
+	}
+	checker.delegate = checker
+	return checker
+}
+
+// SetDelegate sets this RequestScopedCheckResolver's dispatch delegate.
+func (r *RequestScopedCheckResolver) SetDelegate(delegate CheckResolver) {
+	r.delegate = delegate
+}
+
+// GetDelegate returns this RequestScopedCheckResolver's dispatch delegate.
+func (r *RequestScopedCheckResolver) GetDelegate() CheckResolver {
+	return r.delegate
+}
+
+// Close forgets the resolved sub-problems.
+func (r *RequestScopedCheckResolver) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(r.resolved)
+}
+
+func (r *RequestScopedCheckResolver) ResolveCheck(
+	ctx context.Context,
+	req *ResolveCheckRequest,
+) (*ResolveCheckResponse, error) {
+	// An explained request is resolved to record the proof of its outcome, which is not remembered.
+	if req.GetExplain() {
+		return r.delegate.ResolveCheck(ctx, req)
+	}
+
+	key := BuildCacheKey(*req)
+
+	r.mu.Lock()
+	result, ok := r.resolved[key]
+	r.mu.Unlock()
+	if ok {
+		storage.ObserveTupleExpiry(ctx, result.expiresAt)
+		// return a copy to avoid races across goroutines
+		return result.resp.clone(), nil
+	}
+
+	var expiry storage.TupleExpiryRecorder
+	resp, err := r.delegate.ResolveCheck(storage.ContextWithTupleExpiryObserver(ctx, expiry.Observe), req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The outcome of a sub-problem in a cycle depends on the parent of the cycle, see CachedCheckResolver.
+	if resp.GetCycleDetected() {
+		return resp, nil
+	}
+
+	r.mu.Lock()
+	r.resolved[key] = requestScopedResult{resp: resp.clone(), expiresAt: expiry.Earliest()}
+	r.mu.Unlock()
+	return resp, nil
+}
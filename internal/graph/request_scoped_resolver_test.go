@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+func TestRequestScopedCheckResolver(t *testing.T) {
+	ctx := context.Background()
+
+	newRequest := func(object string) *ResolveCheckRequest {
+		req, err := NewResolveCheckRequest(ResolveCheckRequestParams{
+			StoreID:              "12",
+			AuthorizationModelID: "33",
+			TupleKey:             tuple.NewTupleKey(object, "reader", "user:XYZ"),
+		})
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("resolved_once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockResolver := NewMockCheckResolver(ctrl)
+		resolver := NewRequestScopedCheckResolver()
+		resolver.SetDelegate(mockResolver)
+		t.Cleanup(resolver.Close)
+
+		mockResolver.EXPECT().ResolveCheck(gomock.Any(), gomock.Any()).Times(2).Return(&ResolveCheckResponse{Allowed: true}, nil)
+
+		for range 2 {
+			resp, err := resolver.ResolveCheck(ctx, newRequest("document:1"))
+			require.NoError(t, err)
+			require.True(t, resp.GetAllowed())
+		}
+		resp, err := resolver.ResolveCheck(ctx, newRequest("document:2"))
+		require.NoError(t, err)
+		require.True(t, resp.GetAllowed())
+	})
+
+	t.Run("errors_and_cycles_are_not_remembered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockResolver := NewMockCheckResolver(ctrl)
+		resolver := NewRequestScopedCheckResolver()
+		resolver.SetDelegate(mockResolver)
+		t.Cleanup(resolver.Close)
+
+		gomock.InOrder(
+			mockResolver.EXPECT().ResolveCheck(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed")),
+			mockResolver.EXPECT().ResolveCheck(gomock.Any(), gomock.Any()).Return(&ResolveCheckResponse{
+				ResolutionMetadata: ResolveCheckResponseMetadata{CycleDetected: true},
+			}, nil),
+			mockResolver.EXPECT().ResolveCheck(gomock.Any(), gomock.Any()).Return(&ResolveCheckResponse{Allowed: true}, nil),
+		)
+
+		_, err := resolver.ResolveCheck(ctx, newRequest("document:1"))
+		require.Error(t, err)
+
+		resp, err := resolver.ResolveCheck(ctx, newRequest("document:1"))
+		require.NoError(t, err)
+		require.True(t, resp.GetCycleDetected())
+
+		resp, err = resolver.ResolveCheck(ctx, newRequest("document:1"))
+		require.NoError(t, err)
+		require.True(t, resp.GetAllowed())
+	})
+}
@@ -26,6 +26,11 @@ type ResolveCheckRequest struct {
 	Consistency               openfgav1.ConsistencyPreference
 	LastCacheInvalidationTime time.Time
 
+	// Explain records the proof tree of the outcome in [ResolveCheckResponse.Explanation]. It is not
+	// part of the cache key: explained requests bypass the check cache so that every branch is
+	// evaluated, and their outcomes are cached without the proof.
+	Explain bool
+
 	// Invariant parts of a check request are those that don't change in sub-problems
 	// AuthorizationModelID, StoreID, Context, and ContextualTuples.
 	// the invariantCacheKey is computed once per request, and passed to sub-problems via copy in .clone()
@@ -59,6 +64,7 @@ type ResolveCheckRequestParams struct {
 	Consistency               openfgav1.ConsistencyPreference
 	LastCacheInvalidationTime time.Time
 	AuthorizationModelID      string
+	Explain                   bool
 }
 
 func NewCheckRequestMetadata() *ResolveCheckRequestMetadata {
@@ -91,6 +97,7 @@ func NewResolveCheckRequest(
 		Consistency:          params.Consistency,
 		// avoid having to read from cache consistently by propagating it
 		LastCacheInvalidationTime: params.LastCacheInvalidationTime,
+		Explain:                   params.Explain,
 	}
 
 	keyBuilder := &strings.Builder{}
@@ -136,6 +143,7 @@ func (r *ResolveCheckRequest) clone() *ResolveCheckRequest {
 		VisitedPaths:              maps.Clone(r.GetVisitedPaths()),
 		Consistency:               r.GetConsistency(),
 		LastCacheInvalidationTime: r.GetLastCacheInvalidationTime(),
+		Explain:                   r.GetExplain(),
 		invariantCacheKey:         r.GetInvariantCacheKey(),
 	}
 }
@@ -203,6 +211,13 @@ func (r *ResolveCheckRequest) GetLastCacheInvalidationTime() time.Time {
 	return r.LastCacheInvalidationTime
 }
 
+func (r *ResolveCheckRequest) GetExplain() bool {
+	if r == nil {
+		return false
+	}
+	return r.Explain
+}
+
 func (r *ResolveCheckRequest) GetInvariantCacheKey() string {
 	if r == nil {
 		return ""
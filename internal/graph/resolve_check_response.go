@@ -16,7 +16,7 @@ type ResolveCheckResponseMetadata struct {
 	Duration time.Duration
 }
 
-// clone clones the provided ResolveCheckResponse.
+// clone clones the provided ResolveCheckResponse, without its explanation.
 func (r *ResolveCheckResponse) clone() *ResolveCheckResponse {
 	return &ResolveCheckResponse{
 		Allowed:            r.GetAllowed(),
@@ -27,6 +27,9 @@ func (r *ResolveCheckResponse) clone() *ResolveCheckResponse {
 type ResolveCheckResponse struct {
 	Allowed            bool
 	ResolutionMetadata ResolveCheckResponseMetadata
+
+	// Explanation is the proof tree of the outcome, only set if the request was explained.
+	Explanation *CheckExplanation
 }
 
 func (r *ResolveCheckResponse) GetCycleDetected() bool {
@@ -49,3 +52,10 @@ func (r *ResolveCheckResponse) GetResolutionMetadata() ResolveCheckResponseMetad
 	}
 	return r.ResolutionMetadata
 }
+
+func (r *ResolveCheckResponse) GetExplanation() *CheckExplanation {
+	if r == nil {
+		return nil
+	}
+	return r.Explanation
+}
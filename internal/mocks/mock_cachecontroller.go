@@ -41,6 +41,18 @@ func (m *MockCacheController) EXPECT() *MockCacheControllerMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockCacheController) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockCacheControllerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCacheController)(nil).Close))
+}
+
 // DetermineInvalidationTime mocks base method.
 func (m *MockCacheController) DetermineInvalidationTime(arg0 context.Context, arg1 string) time.Time {
 	m.ctrl.T.Helper()
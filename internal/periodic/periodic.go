@@ -0,0 +1,58 @@
+// Package periodic runs tasks at a regular interval, in the background.
+package periodic
+
+import (
+	"context"
+	"time"
+)
+
+// Runner runs a task at a regular interval, in the background.
+type Runner struct {
+	task     func(ctx context.Context)
+	interval time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRunner returns a [Runner] running task every interval, once started. The context passed to
+// task is canceled when the Runner is stopped.
+func NewRunner(interval time.Duration, task func(ctx context.Context)) *Runner {
+	return &Runner{
+		task:     task,
+		interval: interval,
+	}
+}
+
+// Start runs the task right away, then every interval until Stop is called.
+func (r *Runner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			r.task(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops running the task, and waits for the run in progress to be canceled. It does nothing
+// if the Runner is not started, and can be called several times.
+func (r *Runner) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	<-r.done
+}
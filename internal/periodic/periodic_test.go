@@ -0,0 +1,47 @@
+package periodic
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestRunner(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	t.Run("runs_right_away_then_every_interval", func(t *testing.T) {
+		var runs atomic.Int32
+		runner := NewRunner(10*time.Millisecond, func(context.Context) {
+			runs.Add(1)
+		})
+		runner.Start()
+		t.Cleanup(runner.Stop)
+
+		require.Eventually(t, func() bool {
+			return runs.Load() >= 3
+		}, 5*time.Second, time.Millisecond)
+	})
+
+	t.Run("stop_cancels_the_run_in_progress", func(t *testing.T) {
+		started := make(chan struct{})
+		runner := NewRunner(time.Hour, func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+		})
+		runner.Start()
+		<-started
+
+		runner.Stop()
+		runner.Stop()
+	})
+
+	t.Run("stop_without_start", func(t *testing.T) {
+		NewRunner(time.Hour, func(context.Context) {}).Stop()
+	})
+}
@@ -38,6 +38,14 @@ func WithShadowCacheController(cacheController cachecontroller.CacheController)
 	}
 }
 
+// WithInvalidationNotifier subscribes the cache controllers created in NewSharedDatastoreResources() to the
+// invalidations broadcast by notifier.
+func WithInvalidationNotifier(notifier storage.InvalidationNotifier) SharedDatastoreResourcesOpt {
+	return func(scr *SharedDatastoreResources) {
+		scr.invalidationNotifier = notifier
+	}
+}
+
 // SharedDatastoreResources contains resources that can be shared across Check requests.
 type SharedDatastoreResources struct {
 	SingleflightGroup     *singleflight.Group
@@ -49,6 +57,12 @@ type SharedDatastoreResources struct {
 	ShadowCacheController cachecontroller.CacheController
 	Logger                logger.Logger
 	SharedIteratorStorage *sharediterator.Storage
+
+	invalidationNotifier storage.InvalidationNotifier
+
+	// cacheControllers are the cache controllers created by NewSharedDatastoreResources,
+	// the ones set via opts are closed by their owner.
+	cacheControllers []cachecontroller.CacheController
 }
 
 func NewSharedDatastoreResources(
@@ -86,9 +100,15 @@ func NewSharedDatastoreResources(
 		}
 	}
 
+	cacheControllerOpts := []cachecontroller.InMemoryCacheControllerOpt{cachecontroller.WithLogger(s.Logger)}
+	if s.invalidationNotifier != nil {
+		cacheControllerOpts = append(cacheControllerOpts, cachecontroller.WithInvalidationNotifier(s.invalidationNotifier))
+	}
+
 	// Only create a cache controller if it wasn't already set via opts.
 	if settings.ShouldCreateCacheController() && s.CacheController == defaultCacheController {
-		s.CacheController = cachecontroller.NewCacheController(ds, s.CheckCache, settings.CacheControllerTTL, settings.CheckQueryCacheTTL, settings.CheckIteratorCacheTTL, cachecontroller.WithLogger(s.Logger))
+		s.CacheController = cachecontroller.NewCacheController(ds, s.CheckCache, settings.CacheControllerTTL, settings.CheckQueryCacheTTL, settings.CheckIteratorCacheTTL, cacheControllerOpts...)
+		s.cacheControllers = append(s.cacheControllers, s.CacheController)
 	}
 
 	// The default behavior is to use the same cache instance for both the
@@ -115,7 +135,8 @@ func NewSharedDatastoreResources(
 
 	// Only create a shadow cache controller if it wasn't already set via opts.
 	if settings.ShouldCreateShadowCacheController() && s.ShadowCacheController == s.CacheController {
-		s.ShadowCacheController = cachecontroller.NewCacheController(ds, s.ShadowCheckCache, settings.CacheControllerTTL, settings.CheckQueryCacheTTL, settings.CheckIteratorCacheTTL, cachecontroller.WithLogger(s.Logger))
+		s.ShadowCacheController = cachecontroller.NewCacheController(ds, s.ShadowCheckCache, settings.CacheControllerTTL, settings.CheckQueryCacheTTL, settings.CheckIteratorCacheTTL, cacheControllerOpts...)
+		s.cacheControllers = append(s.cacheControllers, s.ShadowCacheController)
 	}
 
 	return s, nil
@@ -125,6 +146,9 @@ func (s *SharedDatastoreResources) Close() {
 	// wait for any goroutines still in flight before
 	// closing the cache instance to avoid data races
 	s.WaitGroup.Wait()
+	for _, cacheController := range s.cacheControllers {
+		cacheController.Close()
+	}
 	if s.CheckCache != nil {
 		s.CheckCache.Stop()
 	}
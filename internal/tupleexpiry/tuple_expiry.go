@@ -0,0 +1,87 @@
+package tupleexpiry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/openfga/openfga/internal/build"
+	"github.com/openfga/openfga/internal/periodic"
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+var (
+	tracer = otel.Tracer("internal/tupleexpiry")
+
+	deletedTuplesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: build.ProjectName,
+		Name:      "expired_tuples_deleted_count",
+		Help:      "The total number of expired tuples deleted by the tuple expiry reaper.",
+	})
+)
+
+// ErrExpiryNotSupported is returned when the datastore does not implement [storage.TupleExpirer].
+var ErrExpiryNotSupported = errors.New("the datastore does not support expiring tuples")
+
+// DeleteExpired deletes the tuples of every store that expired at or before now, batchSize tuples
+// at a time, until there is none left or ctx is done. It returns the number of deleted tuples.
+func DeleteExpired(ctx context.Context, datastore storage.OpenFGADatastore, now time.Time, batchSize int) (int, error) {
+	ctx, span := tracer.Start(ctx, "tupleexpiry.DeleteExpired")
+	defer span.End()
+
+	expirer, ok := datastore.(storage.TupleExpirer)
+	if !ok {
+		return 0, ErrExpiryNotSupported
+	}
+	if batchSize <= 0 {
+		batchSize = storage.DefaultDeleteExpiredTuplesBatchSize
+	}
+
+	total := 0
+	for {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+		n, err := expirer.DeleteExpiredTuples(ctx, now, batchSize)
+		total += n
+		deletedTuplesCounter.Add(float64(n))
+		if err != nil {
+			return total, err
+		}
+		// Datastores may delete fewer tuples than batchSize even when more expired, as DSQL
+		// caps the rows deleted by a transaction, so only an empty batch ends the deletion.
+		if n == 0 {
+			return total, nil
+		}
+	}
+}
+
+// NewReaper returns a [periodic.Runner] deleting the expired tuples of every store of datastore,
+// batchSize tuples at a time, every interval, once started. It returns ErrExpiryNotSupported if the
+// datastore does not implement [storage.TupleExpirer].
+func NewReaper(datastore storage.OpenFGADatastore, batchSize int, interval time.Duration, logger logger.Logger) (*periodic.Runner, error) {
+	if _, ok := datastore.(storage.TupleExpirer); !ok {
+		return nil, ErrExpiryNotSupported
+	}
+
+	return periodic.NewRunner(interval, func(ctx context.Context) {
+		start := time.Now()
+		deleted, err := DeleteExpired(ctx, datastore, start, batchSize)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Error("failed to delete the expired tuples", zap.Error(err))
+		}
+
+		logger.Info("deleted the expired tuples",
+			zap.Int("tuples", deleted),
+			zap.Duration("duration", time.Since(start)))
+	}), nil
+}
@@ -0,0 +1,111 @@
+package tupleexpiry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/mocks"
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+func writeExpiredTuples(t *testing.T, ds storage.OpenFGADatastore, n int) string {
+	t.Helper()
+	ctx := context.Background()
+
+	store := ulid.Make().String()
+	for i := 0; i < n; i++ {
+		require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:"+ulid.Make().String(), "viewer", "user:jon"),
+		}, storage.WithExpiresAt(time.Now().Add(-time.Second))))
+	}
+	return store
+}
+
+func countChanges(t *testing.T, ds storage.OpenFGADatastore, store string, operation openfgav1.TupleOperation) int {
+	t.Helper()
+	changes, _, err := ds.ReadChanges(context.Background(), store, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+		Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, ""),
+	})
+	require.NoError(t, err)
+
+	count := 0
+	for _, change := range changes {
+		if change.GetOperation() == operation {
+			count++
+		}
+	}
+	return count
+}
+
+// cappedExpirer deletes at most one expired tuple per call, whatever the limit.
+type cappedExpirer struct {
+	storage.OpenFGADatastore
+}
+
+func (c cappedExpirer) DeleteExpiredTuples(ctx context.Context, now time.Time, _ int) (int, error) {
+	return c.OpenFGADatastore.(storage.TupleExpirer).DeleteExpiredTuples(ctx, now, 1)
+}
+
+func TestDeleteExpired(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store := writeExpiredTuples(t, ds, 5)
+
+	deleted, err := DeleteExpired(context.Background(), ds, time.Now(), 2)
+	require.NoError(t, err)
+	require.Equal(t, 5, deleted)
+	require.Equal(t, 5, countChanges(t, ds, store, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE))
+
+	deleted, err = DeleteExpired(context.Background(), ds, time.Now(), 2)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	t.Run("batches_smaller_than_batch_size", func(t *testing.T) {
+		ds := memory.New()
+		t.Cleanup(ds.Close)
+
+		store := writeExpiredTuples(t, ds, 3)
+
+		deleted, err := DeleteExpired(context.Background(), cappedExpirer{ds}, time.Now(), 2)
+		require.NoError(t, err)
+		require.Equal(t, 3, deleted)
+		require.Equal(t, 3, countChanges(t, ds, store, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE))
+	})
+}
+
+func TestReaper(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store := writeExpiredTuples(t, ds, 3)
+
+	reaper, err := NewReaper(ds, 1, time.Hour, logger.NewNoopLogger())
+	require.NoError(t, err)
+	reaper.Start()
+	t.Cleanup(reaper.Stop)
+
+	require.Eventually(t, func() bool {
+		return countChanges(t, ds, store, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("unsupported_datastore", func(t *testing.T) {
+		_, err := NewReaper(mocks.NewMockOpenFGADatastore(gomock.NewController(t)), 1, time.Hour, logger.NewNoopLogger())
+		require.ErrorIs(t, err, ErrExpiryNotSupported)
+	})
+}
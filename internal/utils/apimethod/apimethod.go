@@ -10,22 +10,31 @@ func (a APIMethod) String() string {
 
 // API methods.
 const (
-	ReadAuthorizationModel  APIMethod = "ReadAuthorizationModel"
-	ReadAuthorizationModels APIMethod = "ReadAuthorizationModels"
-	Read                    APIMethod = "Read"
-	Write                   APIMethod = "Write"
-	ListObjects             APIMethod = "ListObjects"
-	StreamedListObjects     APIMethod = "StreamedListObjects"
-	Check                   APIMethod = "Check"
-	BatchCheck              APIMethod = "BatchCheck"
-	ListUsers               APIMethod = "ListUsers"
-	WriteAssertions         APIMethod = "WriteAssertions"
-	ReadAssertions          APIMethod = "ReadAssertions"
-	WriteAuthorizationModel APIMethod = "WriteAuthorizationModel"
-	ListStores              APIMethod = "ListStores"
-	CreateStore             APIMethod = "CreateStore"
-	GetStore                APIMethod = "GetStore"
-	DeleteStore             APIMethod = "DeleteStore"
-	Expand                  APIMethod = "Expand"
-	ReadChanges             APIMethod = "ReadChanges"
+	ReadAuthorizationModel       APIMethod = "ReadAuthorizationModel"
+	ReadAuthorizationModels      APIMethod = "ReadAuthorizationModels"
+	Read                         APIMethod = "Read"
+	Write                        APIMethod = "Write"
+	ListObjects                  APIMethod = "ListObjects"
+	StreamedListObjects          APIMethod = "StreamedListObjects"
+	PaginatedListObjects         APIMethod = "PaginatedListObjects"
+	MultiTypeListObjects         APIMethod = "MultiTypeListObjects"
+	StreamedMultiTypeListObjects APIMethod = "StreamedMultiTypeListObjects"
+	Check                        APIMethod = "Check"
+	BatchCheck                   APIMethod = "BatchCheck"
+	ListRelations                APIMethod = "ListRelations"
+	ListUsers                    APIMethod = "ListUsers"
+	StreamedListUsers            APIMethod = "StreamedListUsers"
+	WriteAssertions              APIMethod = "WriteAssertions"
+	ReadAssertions               APIMethod = "ReadAssertions"
+	WriteAuthorizationModel      APIMethod = "WriteAuthorizationModel"
+	ListStores                   APIMethod = "ListStores"
+	CreateStore                  APIMethod = "CreateStore"
+	GetStore                     APIMethod = "GetStore"
+	DeleteStore                  APIMethod = "DeleteStore"
+	Expand                       APIMethod = "Expand"
+	ReadChanges                  APIMethod = "ReadChanges"
+	Watch                        APIMethod = "Watch"
+	CloneStore                   APIMethod = "CloneStore"
+	ImportTuples                 APIMethod = "ImportTuples"
+	DiffAuthorizationModels      APIMethod = "DiffAuthorizationModels"
 )
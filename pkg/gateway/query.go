@@ -0,0 +1,221 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
+)
+
+// StreamedListUsersPath is the HTTP path pattern of the handler returned by [NewStreamedListUsersHandler].
+const StreamedListUsersPath = "/stores/{store_id}/streamed-list-users"
+
+// NewStreamedListUsersHandler returns a handler serving the StreamedListUsers RPC of client like
+// the generated handler of StreamedListObjects, to be registered on mux with HandlePath for POST
+// requests to [StreamedListUsersPath].
+//
+// The fields of the request other than the store ID are read from the JSON body. The users are
+// sent as newline delimited JSON objects, each with the StreamedListUsersResponse as "result",
+// and an error occurring once the stream is open is sent as a last object with the
+// google.rpc.Status as "error".
+func NewStreamedListUsersHandler(mux *runtime.ServeMux, client queryv1.QueryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		annotated, err := runtime.AnnotateContext(ctx, mux, r, queryv1.QueryService_StreamedListUsers_FullMethodName, runtime.WithHTTPPathPattern(StreamedListUsersPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var req queryv1.StreamedListUsersRequest
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		req.StoreId = pathParams["store_id"]
+
+		var md runtime.ServerMetadata
+		stream, err := client.StreamedListUsers(annotated, &req)
+		if err == nil {
+			md.HeaderMD, err = stream.Header()
+		}
+		annotated = runtime.NewServerMetadataContext(annotated, md)
+		if err != nil {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseStream(annotated, mux, outboundMarshaler, w, r, func() (proto.Message, error) { return stream.Recv() }, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// PaginatedListObjectsPath is the HTTP path pattern of the handler returned by [NewPaginatedListObjectsHandler].
+const PaginatedListObjectsPath = "/stores/{store_id}/paginated-list-objects"
+
+// NewPaginatedListObjectsHandler returns a handler serving the PaginatedListObjects RPC of client
+// like the generated handler of ListObjects, to be registered on mux with HandlePath for POST
+// requests to [PaginatedListObjectsPath].
+//
+// The fields of the request other than the store ID are read from the JSON body.
+func NewPaginatedListObjectsHandler(mux *runtime.ServeMux, client queryv1.QueryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		annotated, err := runtime.AnnotateContext(ctx, mux, r, queryv1.QueryService_PaginatedListObjects_FullMethodName, runtime.WithHTTPPathPattern(PaginatedListObjectsPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var req queryv1.PaginatedListObjectsRequest
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		req.StoreId = pathParams["store_id"]
+
+		var md runtime.ServerMetadata
+		resp, err := client.PaginatedListObjects(annotated, &req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		annotated = runtime.NewServerMetadataContext(annotated, md)
+		if err != nil {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(annotated, mux, outboundMarshaler, w, r, resp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// ListRelationsPath is the HTTP path pattern of the handler returned by [NewListRelationsHandler].
+const ListRelationsPath = "/stores/{store_id}/list-relations"
+
+// NewListRelationsHandler returns a handler serving the ListRelations RPC of client like the
+// generated handler of Check, to be registered on mux with HandlePath for POST requests to
+// [ListRelationsPath].
+//
+// The fields of the request other than the store ID are read from the JSON body.
+func NewListRelationsHandler(mux *runtime.ServeMux, client queryv1.QueryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		annotated, err := runtime.AnnotateContext(ctx, mux, r, queryv1.QueryService_ListRelations_FullMethodName, runtime.WithHTTPPathPattern(ListRelationsPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var req queryv1.ListRelationsRequest
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		req.StoreId = pathParams["store_id"]
+
+		var md runtime.ServerMetadata
+		resp, err := client.ListRelations(annotated, &req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		annotated = runtime.NewServerMetadataContext(annotated, md)
+		if err != nil {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(annotated, mux, outboundMarshaler, w, r, resp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// MultiTypeListObjectsPath is the HTTP path pattern of the handler returned by [NewMultiTypeListObjectsHandler].
+const MultiTypeListObjectsPath = "/stores/{store_id}/multi-type-list-objects"
+
+// NewMultiTypeListObjectsHandler returns a handler serving the MultiTypeListObjects RPC of client
+// like the generated handler of ListObjects, to be registered on mux with HandlePath for POST
+// requests to [MultiTypeListObjectsPath].
+//
+// The fields of the request other than the store ID are read from the JSON body.
+func NewMultiTypeListObjectsHandler(mux *runtime.ServeMux, client queryv1.QueryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		annotated, err := runtime.AnnotateContext(ctx, mux, r, queryv1.QueryService_MultiTypeListObjects_FullMethodName, runtime.WithHTTPPathPattern(MultiTypeListObjectsPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var req queryv1.MultiTypeListObjectsRequest
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		req.StoreId = pathParams["store_id"]
+
+		var md runtime.ServerMetadata
+		resp, err := client.MultiTypeListObjects(annotated, &req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		annotated = runtime.NewServerMetadataContext(annotated, md)
+		if err != nil {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(annotated, mux, outboundMarshaler, w, r, resp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// StreamedMultiTypeListObjectsPath is the HTTP path pattern of the handler returned by
+// [NewStreamedMultiTypeListObjectsHandler].
+const StreamedMultiTypeListObjectsPath = "/stores/{store_id}/streamed-multi-type-list-objects"
+
+// NewStreamedMultiTypeListObjectsHandler returns a handler serving the StreamedMultiTypeListObjects
+// RPC of client like the generated handler of StreamedListObjects, to be registered on mux with
+// HandlePath for POST requests to [StreamedMultiTypeListObjectsPath].
+//
+// The request and the stream are handled like those of [NewStreamedListUsersHandler].
+func NewStreamedMultiTypeListObjectsHandler(mux *runtime.ServeMux, client queryv1.QueryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		annotated, err := runtime.AnnotateContext(ctx, mux, r, queryv1.QueryService_StreamedMultiTypeListObjects_FullMethodName, runtime.WithHTTPPathPattern(StreamedMultiTypeListObjectsPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var req queryv1.MultiTypeListObjectsRequest
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		req.StoreId = pathParams["store_id"]
+
+		var md runtime.ServerMetadata
+		stream, err := client.StreamedMultiTypeListObjects(annotated, &req)
+		if err == nil {
+			md.HeaderMD, err = stream.Header()
+		}
+		annotated = runtime.NewServerMetadataContext(annotated, md)
+		if err != nil {
+			runtime.HTTPError(annotated, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseStream(annotated, mux, outboundMarshaler, w, r, func() (proto.Message, error) { return stream.Recv() }, mux.GetForwardResponseOptions()...)
+	}
+}
@@ -0,0 +1,294 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
+)
+
+type queryServer struct {
+	queryv1.UnimplementedQueryServiceServer
+
+	requests                 chan *queryv1.StreamedListUsersRequest
+	paginatedListObjectsReqs chan *queryv1.PaginatedListObjectsRequest
+	listRelationsReqs        chan *queryv1.ListRelationsRequest
+	multiTypeListObjectsReqs chan *queryv1.MultiTypeListObjectsRequest
+}
+
+func (s *queryServer) StreamedListUsers(req *queryv1.StreamedListUsersRequest, srv grpc.ServerStreamingServer[queryv1.StreamedListUsersResponse]) error {
+	s.requests <- req
+	if req.GetStoreId() == "invalid" {
+		return status.Error(codes.InvalidArgument, "invalid store")
+	}
+
+	for _, id := range []string{"jon", "maria"} {
+		err := srv.Send(&queryv1.StreamedListUsersResponse{
+			User: &openfgav1.User{User: &openfgav1.User_Object{Object: &openfgav1.Object{Type: "user", Id: id}}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *queryServer) PaginatedListObjects(_ context.Context, req *queryv1.PaginatedListObjectsRequest) (*queryv1.PaginatedListObjectsResponse, error) {
+	s.paginatedListObjectsReqs <- req
+	if req.GetStoreId() == "invalid" {
+		return nil, status.Error(codes.InvalidArgument, "invalid store")
+	}
+
+	return &queryv1.PaginatedListObjectsResponse{
+		Objects:           []string{"document:1", "document:2"},
+		ContinuationToken: "next",
+	}, nil
+}
+
+func (s *queryServer) ListRelations(_ context.Context, req *queryv1.ListRelationsRequest) (*queryv1.ListRelationsResponse, error) {
+	s.listRelationsReqs <- req
+	return &queryv1.ListRelationsResponse{Relations: []string{"editor", "viewer"}}, nil
+}
+
+func (s *queryServer) MultiTypeListObjects(_ context.Context, req *queryv1.MultiTypeListObjectsRequest) (*queryv1.MultiTypeListObjectsResponse, error) {
+	s.multiTypeListObjectsReqs <- req
+	return &queryv1.MultiTypeListObjectsResponse{Results: []*queryv1.TypedObjects{
+		{Type: "document", Relation: "viewer", Objects: []string{"document:1"}},
+		{Type: "folder", Relation: "viewer", Objects: []string{"folder:1", "folder:2"}},
+	}}, nil
+}
+
+func (s *queryServer) StreamedMultiTypeListObjects(req *queryv1.MultiTypeListObjectsRequest, srv grpc.ServerStreamingServer[queryv1.StreamedMultiTypeListObjectsResponse]) error {
+	s.multiTypeListObjectsReqs <- req
+	for _, object := range []string{"folder:1", "document:1"} {
+		err := srv.Send(&queryv1.StreamedMultiTypeListObjectsResponse{
+			Type:     strings.Split(object, ":")[0],
+			Relation: "viewer",
+			Object:   object,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newQueryClient serves queryServer and returns a client connected to it.
+func newQueryClient(t *testing.T, queryServer *queryServer) queryv1.QueryServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	queryv1.RegisterQueryServiceServer(grpcServer, queryServer)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return queryv1.NewQueryServiceClient(conn)
+}
+
+func TestStreamedListUsersHandler(t *testing.T) {
+	queryServer := &queryServer{requests: make(chan *queryv1.StreamedListUsersRequest, 1)}
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, mux.HandlePath(http.MethodPost, StreamedListUsersPath, NewStreamedListUsersHandler(mux, newQueryClient(t, queryServer))))
+
+	t.Run("streams_users", func(t *testing.T) {
+		body := `{"object": {"type": "document", "id": "1"}, "relation": "viewer", "user_filters": [{"type": "user"}]}`
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/store/streamed-list-users", strings.NewReader(body)))
+
+		req := <-queryServer.requests
+		require.Equal(t, "store", req.GetStoreId())
+		require.Equal(t, "document", req.GetObject().GetType())
+		require.Equal(t, "viewer", req.GetRelation())
+		require.Equal(t, "user", req.GetUserFilters()[0].GetType())
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var users []string
+		scanner := bufio.NewScanner(rec.Body)
+		for scanner.Scan() {
+			var line struct {
+				Result struct {
+					User struct {
+						Object struct {
+							ID string `json:"id"`
+						} `json:"object"`
+					} `json:"user"`
+				} `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			users = append(users, line.Result.User.Object.ID)
+		}
+		require.Equal(t, []string{"jon", "maria"}, users)
+	})
+
+	t.Run("rejected_request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/invalid/streamed-list-users", strings.NewReader(`{}`)))
+
+		<-queryServer.requests
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/store/streamed-list-users", strings.NewReader(`{"relation": 1}`)))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPaginatedListObjectsHandler(t *testing.T) {
+	queryServer := &queryServer{paginatedListObjectsReqs: make(chan *queryv1.PaginatedListObjectsRequest, 1)}
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, mux.HandlePath(http.MethodPost, PaginatedListObjectsPath, NewPaginatedListObjectsHandler(mux, newQueryClient(t, queryServer))))
+
+	t.Run("returns_page", func(t *testing.T) {
+		body := `{"type": "document", "relation": "viewer", "user": "user:jon", "page_size": 2, "continuation_token": "previous"}`
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/store/paginated-list-objects", strings.NewReader(body)))
+
+		req := <-queryServer.paginatedListObjectsReqs
+		require.Equal(t, "store", req.GetStoreId())
+		require.Equal(t, "document", req.GetType())
+		require.Equal(t, "user:jon", req.GetUser())
+		require.Equal(t, uint32(2), req.GetPageSize())
+		require.Equal(t, "previous", req.GetContinuationToken())
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Objects           []string `json:"objects"`
+			ContinuationToken string   `json:"continuation_token"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Equal(t, []string{"document:1", "document:2"}, resp.Objects)
+		require.Equal(t, "next", resp.ContinuationToken)
+	})
+
+	t.Run("rejected_request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/invalid/paginated-list-objects", strings.NewReader(`{}`)))
+
+		<-queryServer.paginatedListObjectsReqs
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestListRelationsHandler(t *testing.T) {
+	queryServer := &queryServer{listRelationsReqs: make(chan *queryv1.ListRelationsRequest, 1)}
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, mux.HandlePath(http.MethodPost, ListRelationsPath, NewListRelationsHandler(mux, newQueryClient(t, queryServer))))
+
+	body := `{"object": "document:1", "user": "user:jon", "relations": ["editor", "owner", "viewer"]}`
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/store/list-relations", strings.NewReader(body)))
+
+	req := <-queryServer.listRelationsReqs
+	require.Equal(t, "store", req.GetStoreId())
+	require.Equal(t, "document:1", req.GetObject())
+	require.Equal(t, "user:jon", req.GetUser())
+	require.Equal(t, []string{"editor", "owner", "viewer"}, req.GetRelations())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Relations []string `json:"relations"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, []string{"editor", "viewer"}, resp.Relations)
+}
+
+func TestMultiTypeListObjectsHandlers(t *testing.T) {
+	queryServer := &queryServer{multiTypeListObjectsReqs: make(chan *queryv1.MultiTypeListObjectsRequest, 1)}
+	client := newQueryClient(t, queryServer)
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, mux.HandlePath(http.MethodPost, MultiTypeListObjectsPath, NewMultiTypeListObjectsHandler(mux, client)))
+	require.NoError(t, mux.HandlePath(http.MethodPost, StreamedMultiTypeListObjectsPath, NewStreamedMultiTypeListObjectsHandler(mux, client)))
+
+	body := `{"targets": [{"type": "document"}, {"type": "folder", "max_results": 5}], "relation": "viewer", "user": "user:jon"}`
+
+	requireRequest := func(t *testing.T) {
+		t.Helper()
+		req := <-queryServer.multiTypeListObjectsReqs
+		require.Equal(t, "store", req.GetStoreId())
+		require.Equal(t, "viewer", req.GetRelation())
+		require.Equal(t, "user:jon", req.GetUser())
+		require.Len(t, req.GetTargets(), 2)
+		require.Equal(t, "folder", req.GetTargets()[1].GetType())
+		require.Equal(t, uint32(5), req.GetTargets()[1].GetMaxResults())
+	}
+
+	t.Run("returns_objects_by_target", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/store/multi-type-list-objects", strings.NewReader(body)))
+
+		requireRequest(t)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Results []struct {
+				Type     string   `json:"type"`
+				Relation string   `json:"relation"`
+				Objects  []string `json:"objects"`
+			} `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Results, 2)
+		require.Equal(t, "document", resp.Results[0].Type)
+		require.Equal(t, []string{"document:1"}, resp.Results[0].Objects)
+		require.Equal(t, "folder", resp.Results[1].Type)
+		require.Equal(t, []string{"folder:1", "folder:2"}, resp.Results[1].Objects)
+	})
+
+	t.Run("streams_tagged_objects", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/stores/store/streamed-multi-type-list-objects", strings.NewReader(body)))
+
+		requireRequest(t)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var objects []string
+		scanner := bufio.NewScanner(rec.Body)
+		for scanner.Scan() {
+			var line struct {
+				Result struct {
+					Type     string `json:"type"`
+					Relation string `json:"relation"`
+					Object   string `json:"object"`
+				} `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			objects = append(objects, line.Result.Type+"#"+line.Result.Relation+"@"+line.Result.Object)
+		}
+		require.Equal(t, []string{"folder#viewer@folder:1", "document#viewer@document:1"}, objects)
+	})
+}
@@ -0,0 +1,137 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
+)
+
+// WatchPath is the HTTP path pattern of the handler returned by [NewWatchHandler].
+const WatchPath = "/stores/{store_id}/watch"
+
+// NewWatchHandler returns a handler serving the Watch RPC of client as server-sent events, to be
+// registered on mux with HandlePath for GET requests to [WatchPath].
+//
+// The fields of the request other than the store ID are read from the query parameters, with
+// start_time in RFC 3339 format. The Last-Event-ID header, which browsers send when reconnecting,
+// takes precedence over the continuation_token parameter. Every batch of changes is sent as a
+// "changes" event identified by its continuation token, with the JSON encoded WatchResponse as
+// data. An error occurring once the stream is open is sent as an "error" event with the JSON
+// encoded google.rpc.Status as data, before the response ends.
+func NewWatchHandler(mux *runtime.ServeMux, client watchv1.WatchServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		req, err := watchRequestFromHTTP(r, pathParams)
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		// Forward the headers like the generated handlers do, but without their default timeout
+		// as the stream is meant to stay open.
+		annotated, err := runtime.AnnotateContext(r.Context(), mux, r, watchv1.WatchService_Watch_FullMethodName, runtime.WithHTTPPathPattern(WatchPath))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+			return
+		}
+		md, _ := metadata.FromOutgoingContext(annotated)
+		ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(r.Context(), md))
+		defer cancel()
+
+		stream, err := client.Watch(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		// The server sends the headers once it accepted the request. Without them, the stream
+		// already ended and its error can still be reported with the right HTTP status.
+		if header, _ := stream.Header(); header == nil {
+			if _, err := stream.Recv(); err != nil && !errors.Is(err, io.EOF) {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		rc := http.NewResponseController(w)
+		_ = rc.Flush()
+
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					_ = writeEvent(w, marshaler, "error", "", status.Convert(err).Proto())
+					_ = rc.Flush()
+				}
+				return
+			}
+
+			if err := writeEvent(w, marshaler, "changes", resp.GetContinuationToken(), resp); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// watchRequestFromHTTP builds the request of a call to the handler returned by [NewWatchHandler].
+func watchRequestFromHTTP(r *http.Request, pathParams map[string]string) (*watchv1.WatchRequest, error) {
+	query := r.URL.Query()
+	req := &watchv1.WatchRequest{
+		StoreId:           pathParams["store_id"],
+		Type:              query.Get("type"),
+		Relation:          query.Get("relation"),
+		ContinuationToken: query.Get("continuation_token"),
+	}
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		req.ContinuationToken = lastEventID
+	}
+	if startTime := query.Get("start_time"); startTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_time: %w", err)
+		}
+		req.StartTime = timestamppb.New(t)
+	}
+	return req, nil
+}
+
+// writeEvent writes msg as a server-sent event of type event, identified by id if not empty.
+func writeEvent(w io.Writer, marshaler runtime.Marshaler, event, id string, msg proto.Message) error {
+	data, err := marshaler.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "event: %s\n", event)
+	if id != "" {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
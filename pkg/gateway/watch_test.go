@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
+)
+
+type watchServer struct {
+	watchv1.UnimplementedWatchServiceServer
+
+	requests chan *watchv1.WatchRequest
+}
+
+func (s *watchServer) Watch(req *watchv1.WatchRequest, srv grpc.ServerStreamingServer[watchv1.WatchResponse]) error {
+	s.requests <- req
+	if req.GetStoreId() == "invalid" {
+		return status.Error(codes.InvalidArgument, "invalid store")
+	}
+
+	if err := srv.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	err := srv.Send(&watchv1.WatchResponse{
+		Changes: []*openfgav1.TupleChange{{
+			TupleKey:  &openfgav1.TupleKey{Object: "document:1", Relation: "viewer", User: "user:jon"},
+			Operation: openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
+		}},
+		ContinuationToken: "token",
+	})
+	if err != nil {
+		return err
+	}
+	return status.Error(codes.Unavailable, "closing")
+}
+
+func TestWatchHandler(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	watchServer := &watchServer{requests: make(chan *watchv1.WatchRequest, 1)}
+	watchv1.RegisterWatchServiceServer(grpcServer, watchServer)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, mux.HandlePath(http.MethodGet, WatchPath, NewWatchHandler(mux, watchv1.NewWatchServiceClient(conn))))
+
+	t.Run("streams_events", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/stores/store/watch?type=document&relation=viewer&start_time=2024-01-02T03:04:05Z&continuation_token=ignored", nil)
+		req.Header.Set("Last-Event-ID", "last")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		watchReq := <-watchServer.requests
+		require.Equal(t, "store", watchReq.GetStoreId())
+		require.Equal(t, "document", watchReq.GetType())
+		require.Equal(t, "viewer", watchReq.GetRelation())
+		require.Equal(t, "last", watchReq.GetContinuationToken())
+		require.Equal(t, int64(1704164645), watchReq.GetStartTime().GetSeconds())
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+		events := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n\n"), "\n\n")
+		require.Len(t, events, 2)
+
+		lines := strings.Split(events[0], "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, "event: changes", lines[0])
+		require.Equal(t, "id: token", lines[1])
+		var resp watchv1.WatchResponse
+		require.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &resp))
+		require.Equal(t, "token", resp.GetContinuationToken())
+		require.Len(t, resp.GetChanges(), 1)
+		require.Equal(t, "document:1", resp.GetChanges()[0].GetTupleKey().GetObject())
+
+		lines = strings.Split(events[1], "\n")
+		require.Len(t, lines, 2)
+		require.Equal(t, "event: error", lines[0])
+		var st spb.Status
+		require.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data: ")), &st))
+		require.Equal(t, int32(codes.Unavailable), st.GetCode())
+		require.Equal(t, "closing", st.GetMessage())
+	})
+
+	t.Run("rejected_request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/stores/invalid/watch", nil))
+
+		<-watchServer.requests
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	})
+
+	t.Run("invalid_start_time", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/stores/store/watch?start_time=yesterday", nil))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/openfga/openfga/internal/graph"
+	serverconfig "github.com/openfga/openfga/pkg/server/config"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/storagewrappers"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// timeFromHeader returns the RFC 3339 timestamp set by the header of the request, or the zero
+// time if the header is absent.
+func timeFromHeader(ctx context.Context, header string) (time.Time, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return time.Time{}, nil
+	}
+	values := md.Get(header)
+	if len(values) == 0 {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, values[0])
+	if err != nil {
+		return time.Time{}, serverErrors.ValidationError(fmt.Errorf("invalid %s header: %w", header, err))
+	}
+	return t, nil
+}
+
+// asOfFromContext returns the point in time set by the [AsOfHeader] of the request, or the zero
+// time if the header is absent. The point in time must not be after now.
+func asOfFromContext(ctx context.Context, now time.Time) (time.Time, error) {
+	asOf, err := timeFromHeader(ctx, AsOfHeader)
+	if err != nil || asOf.IsZero() {
+		return time.Time{}, err
+	}
+	if asOf.After(now) {
+		return time.Time{}, serverErrors.ValidationError(fmt.Errorf("invalid %s header: %s is in the future", AsOfHeader, asOf.Format(time.RFC3339Nano)))
+	}
+	return asOf, nil
+}
+
+// tupleReaderAsOf returns the tuple reader and the cache settings of a request evaluated as of
+// asOf. If asOf is zero, they are the datastore and the cache settings of the server. Otherwise,
+// the reader returns the tuples of the store as they were at asOf, and every cache is disabled
+// since the cached results reflect the current state of the store.
+func (s *Server) tupleReaderAsOf(ctx context.Context, storeID string, asOf time.Time) (storage.RelationshipTupleReader, serverconfig.CacheSettings, error) {
+	if asOf.IsZero() {
+		return s.datastore, s.cacheSettings, nil
+	}
+
+	reader, err := storagewrappers.NewHistoricalTupleReader(ctx, s.datastore, s.datastore, storeID, asOf)
+	if err != nil {
+		return nil, serverconfig.CacheSettings{}, serverErrors.HandleError("", err)
+	}
+	return reader, serverconfig.CacheSettings{}, nil
+}
+
+// checkResolverOptsAsOf returns the extra options of the check resolvers of a request evaluated as
+// of asOf, which disable the cache of the check queries if asOf is set.
+func checkResolverOptsAsOf(asOf time.Time) []graph.CheckResolverOrderedBuilderOpt {
+	if asOf.IsZero() {
+		return nil
+	}
+	return []graph.CheckResolverOrderedBuilderOpt{graph.WithCachedCheckResolverOpts(false)}
+}
+
+// resolveTypesystemAsOf resolves the TypeSystem of modelID like resolveTypesystem. If modelID is
+// empty and asOf is set, it resolves the latest model of the store written at or before asOf.
+func (s *Server) resolveTypesystemAsOf(ctx context.Context, storeID, modelID string, asOf time.Time) (*typesystem.TypeSystem, error) {
+	if modelID != "" || asOf.IsZero() {
+		return s.resolveTypesystem(ctx, storeID, modelID)
+	}
+
+	continuationToken := ""
+	for {
+		models, token, err := s.datastore.ReadAuthorizationModels(ctx, storeID, storage.ReadAuthorizationModelsOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, continuationToken),
+		})
+		if err != nil {
+			return nil, serverErrors.HandleError("", err)
+		}
+
+		// The models are sorted from newest to oldest.
+		for _, model := range models {
+			id, err := ulid.Parse(model.GetId())
+			if err != nil {
+				continue
+			}
+			if !ulid.Time(id.Time()).After(asOf) {
+				return s.resolveTypesystem(ctx, storeID, model.GetId())
+			}
+		}
+
+		if token == "" || len(models) == 0 {
+			return nil, serverErrors.LatestAuthorizationModelNotFound(storeID)
+		}
+		continuationToken = token
+	}
+}
@@ -47,6 +47,16 @@ func (s *Server) BatchCheck(ctx context.Context, req *openfgav1.BatchCheckReques
 		return nil, err
 	}
 
+	explain, err := explainFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if explain {
+		if err := s.checkExplainAuthz(ctx, storeID); err != nil {
+			return nil, err
+		}
+	}
+
 	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
 	if err != nil {
 		return nil, err
@@ -80,6 +90,7 @@ func (s *Server) BatchCheck(ctx context.Context, req *openfgav1.BatchCheckReques
 		Checks:               req.GetChecks(),
 		Consistency:          req.GetConsistency(),
 		StoreID:              storeID,
+		Explain:              explain,
 	})
 
 	if err != nil {
@@ -138,6 +149,27 @@ func (s *Server) BatchCheck(ctx context.Context, req *openfgav1.BatchCheckReques
 		s.emitCheckDurationMetric(outcome.CheckResponse.GetResolutionMetadata(), methodName)
 	}
 
+	if explain {
+		explanations := make(map[string]*graph.CheckExplanation, len(result))
+		for correlationID, outcome := range result {
+			if outcome.Err == nil {
+				explanations[string(correlationID)] = outcome.CheckResponse.GetExplanation()
+			}
+		}
+		if err := s.setCheckExplanationHeader(ctx, func(depth int) any {
+			if depth < 0 {
+				return explanations
+			}
+			truncated := make(map[string]*graph.CheckExplanation, len(explanations))
+			for correlationID, explanation := range explanations {
+				truncated[correlationID] = explanation.Truncate(depth)
+			}
+			return truncated
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	grpc_ctxtags.Extract(ctx).Set(datastoreQueryCountHistogramName, metadata.DatastoreQueryCount)
 	grpc_ctxtags.Extract(ctx).Set(datastoreItemCountHistogramName, metadata.DatastoreItemCount)
 
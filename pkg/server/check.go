@@ -28,15 +28,25 @@ import (
 func (s *Server) Check(ctx context.Context, req *openfgav1.CheckRequest) (*openfgav1.CheckResponse, error) {
 	const methodName = "check"
 
-	builder := s.getCheckResolverBuilder(req.GetStoreId())
+	startTime := time.Now()
+
+	asOf, err := asOfFromContext(ctx, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	explain, err := explainFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := s.getCheckResolverBuilder(req.GetStoreId(), checkResolverOptsAsOf(asOf)...)
 	checkResolver, checkResolverCloser, err := builder.Build()
 	if err != nil {
 		return nil, err
 	}
 	defer checkResolverCloser()
 
-	startTime := time.Now()
-
 	tk := req.GetTupleKey()
 	ctx, span := tracer.Start(ctx, apimethod.Check.String(), trace.WithAttributes(
 		attribute.KeyValue{Key: "store_id", Value: attribute.StringValue(req.GetStoreId())},
@@ -63,21 +73,32 @@ func (s *Server) Check(ctx context.Context, req *openfgav1.CheckRequest) (*openf
 		return nil, err
 	}
 
+	if explain {
+		if err := s.checkExplainAuthz(ctx, req.GetStoreId()); err != nil {
+			return nil, err
+		}
+	}
+
 	storeID := req.GetStoreId()
 
-	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, req.GetAuthorizationModelId(), asOf)
 	if err != nil {
 		return nil, err
 	}
 	req.AuthorizationModelId = typesys.GetAuthorizationModelID() // the resolved model id
 
+	datastore, cacheSettings, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
 	checkQuery := commands.NewCheckCommand(
-		s.datastore,
+		datastore,
 		checkResolver,
 		typesys,
 		commands.WithCheckCommandLogger(s.logger),
 		commands.WithCheckCommandMaxConcurrentReads(s.maxConcurrentReadsForCheck),
-		commands.WithCheckCommandCache(s.sharedDatastoreResources, s.cacheSettings),
+		commands.WithCheckCommandCache(s.sharedDatastoreResources, cacheSettings),
 		commands.WithCheckDatastoreThrottler(
 			s.featureFlagClient.Boolean(serverconfig.ExperimentalDatastoreThrottling, storeID),
 			s.checkDatastoreThrottleThreshold,
@@ -91,6 +112,7 @@ func (s *Server) Check(ctx context.Context, req *openfgav1.CheckRequest) (*openf
 		ContextualTuples: req.GetContextualTuples(),
 		Context:          req.GetContext(),
 		Consistency:      req.GetConsistency(),
+		Explain:          explain,
 	})
 
 	endTime := time.Since(startTime).Milliseconds()
@@ -183,6 +205,18 @@ func (s *Server) Check(ctx context.Context, req *openfgav1.CheckRequest) (*openf
 		attribute.Bool("cycle_detected", resp.GetCycleDetected()),
 		attribute.Bool("allowed", resp.GetAllowed()))
 
+	if explain {
+		explanation := resp.GetExplanation()
+		if err := s.setCheckExplanationHeader(ctx, func(depth int) any {
+			if depth < 0 {
+				return explanation
+			}
+			return explanation.Truncate(depth)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	res := &openfgav1.CheckResponse{
 		Allowed: resp.Allowed,
 	}
@@ -190,10 +224,12 @@ func (s *Server) Check(ctx context.Context, req *openfgav1.CheckRequest) (*openf
 	return res, nil
 }
 
-func (s *Server) getCheckResolverBuilder(storeID string) *graph.CheckResolverOrderedBuilder {
+// getCheckResolverBuilder returns the builder of the check resolvers of storeID. The extra options
+// are applied after the ones derived from the server configuration.
+func (s *Server) getCheckResolverBuilder(storeID string, extraOpts ...graph.CheckResolverOrderedBuilderOpt) *graph.CheckResolverOrderedBuilder {
 	checkCacheOptions, checkDispatchThrottlingOptions := s.getCheckResolverOptions()
 
-	return graph.NewOrderedCheckResolvers([]graph.CheckResolverOrderedBuilderOpt{
+	return graph.NewOrderedCheckResolvers(append([]graph.CheckResolverOrderedBuilderOpt{
 		graph.WithLocalCheckerOpts([]graph.LocalCheckerOption{
 			graph.WithResolveNodeBreadthLimit(s.resolveNodeBreadthLimit),
 			graph.WithOptimizations(s.featureFlagClient.Boolean(serverconfig.ExperimentalCheckOptimizations, storeID)),
@@ -215,5 +251,5 @@ func (s *Server) getCheckResolverBuilder(storeID string) *graph.CheckResolverOrd
 		}...),
 		graph.WithCachedCheckResolverOpts(s.cacheSettings.ShouldCacheCheckQueries(), checkCacheOptions...),
 		graph.WithDispatchThrottlingCheckResolverOpts(s.checkDispatchThrottlingEnabled, checkDispatchThrottlingOptions...),
-	}...)
+	}, extraOpts...)...)
 }
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	"github.com/openfga/openfga/pkg/server/commands"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	"github.com/openfga/openfga/pkg/telemetry"
+)
+
+var _ adminv1.AdminServiceServer = (*Server)(nil)
+
+// CloneStore copies a store into a new store, see [adminv1.AdminServiceServer]. The caller must be
+// allowed to create stores and to read the tuples, the authorization models and the assertions of
+// the source store.
+func (s *Server) CloneStore(req *adminv1.CloneStoreRequest, srv grpc.ServerStreamingServer[adminv1.CloneStoreResponse]) error {
+	ctx, span := tracer.Start(srv.Context(), apimethod.CloneStore.String(), trace.WithAttributes(
+		attribute.String("store_id", req.GetSourceStoreId()),
+	))
+	defer span.End()
+
+	// The request has no generated validation, reuse the rules of the store RPCs for the fields they share.
+	if err := (&openfgav1.GetStoreRequest{StoreId: req.GetSourceStoreId()}).Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetName() != "" {
+		if err := (&openfgav1.CreateStoreRequest{Name: req.GetName()}).Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  apimethod.CloneStore.String(),
+	})
+
+	if err := s.checkCreateStoreAuthz(ctx); err != nil {
+		return err
+	}
+	if err := s.checkCloneStoreAuthz(ctx, req.GetSourceStoreId()); err != nil {
+		return err
+	}
+
+	if s.storeCloner == nil {
+		return status.Error(codes.Unimplemented, "the datastore does not support cloning stores")
+	}
+
+	// Stop the copy as soon as a progress response cannot be sent.
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	c := commands.NewCloneStoreCommand(s.storeCloner, s.datastore, commands.WithCloneStoreCmdLogger(s.logger))
+	store, err := c.Execute(ctx, req, func(copiedTuples int) {
+		if err := srv.Send(&adminv1.CloneStoreResponse{CopiedTuples: int64(copiedTuples)}); err != nil {
+			cancel(err)
+		}
+	})
+	if err != nil {
+		if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.Canceled) {
+			return cause
+		}
+		return err
+	}
+
+	return srv.Send(&adminv1.CloneStoreResponse{Store: store})
+}
+
+// checkCloneStoreAuthz checks that the caller of CloneStore may read everything that it copies from
+// the store source: its tuples, its authorization models and its assertions.
+func (s *Server) checkCloneStoreAuthz(ctx context.Context, source string) error {
+	for _, method := range []apimethod.APIMethod{apimethod.CloneStore, apimethod.ReadAuthorizationModels, apimethod.ReadAssertions} {
+		if err := s.checkAuthz(ctx, source, method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
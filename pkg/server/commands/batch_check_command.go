@@ -41,6 +41,8 @@ type BatchCheckCommandParams struct {
 	Checks               []*openfgav1.BatchCheckItem
 	Consistency          openfgav1.ConsistencyPreference
 	StoreID              string
+	// Explain records the proof tree of every check in its response, see [graph.CheckExplanation].
+	Explain bool
 }
 
 type BatchCheckOutcome struct {
@@ -204,6 +206,7 @@ func (bq *BatchCheckQuery) Execute(ctx context.Context, params *BatchCheckComman
 				ContextualTuples: check.GetContextualTuples(),
 				Context:          check.GetContext(),
 				Consistency:      params.Consistency,
+				Explain:          params.Explain,
 			}
 
 			response, metadata, err := checkQuery.Execute(ctx, checkParams)
@@ -47,6 +47,8 @@ type CheckCommandParams struct {
 	ContextualTuples *openfgav1.ContextualTupleKeys
 	Context          *structpb.Struct
 	Consistency      openfgav1.ConsistencyPreference
+	// Explain records the proof tree of the check in the response, see [graph.CheckExplanation].
+	Explain bool
 }
 
 type CheckQueryOption func(*CheckQuery)
@@ -120,6 +122,7 @@ func (c *CheckQuery) Execute(ctx context.Context, params *CheckCommandParams) (*
 			Consistency:               params.Consistency,
 			LastCacheInvalidationTime: cacheInvalidationTime,
 			AuthorizationModelID:      c.typesys.GetAuthorizationModelID(),
+			Explain:                   params.Explain,
 		},
 	)
 
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oklog/ulid/v2"
+	"go.uber.org/zap"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/logger"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// CloneStoreCommand copies a store into a new store, see [storage.StoreCloner].
+type CloneStoreCommand struct {
+	cloner        storage.StoreCloner
+	storesBackend storage.StoresBackend
+	logger        logger.Logger
+	batchSize     int
+}
+
+type CloneStoreCmdOption func(*CloneStoreCommand)
+
+func WithCloneStoreCmdLogger(l logger.Logger) CloneStoreCmdOption {
+	return func(c *CloneStoreCommand) {
+		c.logger = l
+	}
+}
+
+// WithCloneStoreCmdBatchSize sets the maximum number of rows copied at once.
+func WithCloneStoreCmdBatchSize(batchSize int) CloneStoreCmdOption {
+	return func(c *CloneStoreCommand) {
+		c.batchSize = batchSize
+	}
+}
+
+// NewCloneStoreCommand creates a CloneStoreCommand copying stores with cloner. The name of the
+// source store is read from storesBackend when the request does not name the created store.
+func NewCloneStoreCommand(
+	cloner storage.StoreCloner,
+	storesBackend storage.StoresBackend,
+	opts ...CloneStoreCmdOption,
+) *CloneStoreCommand {
+	cmd := &CloneStoreCommand{
+		cloner:        cloner,
+		storesBackend: storesBackend,
+		logger:        logger.NewNoopLogger(),
+		batchSize:     storage.DefaultCloneStoreBatchSize,
+	}
+
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
+}
+
+// Execute creates a store with a new ID and copies the source store of req into it, calling
+// onProgress, if set, with the number of tuples copied so far after every batch. If the copy
+// fails, the created store is deleted, so that no partial copy is left behind.
+func (c *CloneStoreCommand) Execute(ctx context.Context, req *adminv1.CloneStoreRequest, onProgress func(copiedTuples int)) (*openfgav1.Store, error) {
+	name := req.GetName()
+	if name == "" {
+		source, err := c.storesBackend.GetStore(ctx, req.GetSourceStoreId())
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, serverErrors.ErrStoreIDNotFound
+			}
+			return nil, serverErrors.HandleError("", err)
+		}
+		name = source.GetName()
+	}
+
+	targetID := ulid.Make().String()
+	store, err := c.cloner.CloneStore(ctx, req.GetSourceStoreId(), &openfgav1.Store{
+		Id:   targetID,
+		Name: name,
+	}, storage.CloneStoreOptions{
+		BatchSize:  c.batchSize,
+		OnProgress: onProgress,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, serverErrors.ErrStoreIDNotFound
+		}
+		if !errors.Is(err, storage.ErrCollision) {
+			c.deleteTarget(ctx, targetID)
+		}
+		return nil, serverErrors.HandleError("", err)
+	}
+	return store, nil
+}
+
+// deleteTarget deletes the store created by a failed copy, even if the copy failed because ctx
+// was canceled.
+func (c *CloneStoreCommand) deleteTarget(ctx context.Context, targetID string) {
+	if err := c.storesBackend.DeleteStore(context.WithoutCancel(ctx), targetID); err != nil {
+		c.logger.ErrorWithContext(ctx, "failed to delete the store of a failed clone",
+			zap.String("store_id", targetID),
+			zap.Error(err),
+		)
+	}
+}
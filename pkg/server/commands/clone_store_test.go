@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+func TestCloneStoreCommand(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	ctx := context.Background()
+
+	source, err := ds.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "production"})
+	require.NoError(t, err)
+	require.NoError(t, ds.Write(ctx, source.GetId(), nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:1", "viewer", "user:anne"),
+		tuple.NewTupleKey("document:2", "viewer", "user:bob"),
+	}))
+
+	cmd := NewCloneStoreCommand(ds.(storage.StoreCloner), ds, WithCloneStoreCmdBatchSize(1))
+
+	t.Run("defaults_to_the_name_of_the_source", func(t *testing.T) {
+		var progress []int
+		store, err := cmd.Execute(ctx, &adminv1.CloneStoreRequest{SourceStoreId: source.GetId()}, func(copiedTuples int) {
+			progress = append(progress, copiedTuples)
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, source.GetId(), store.GetId())
+		require.Equal(t, "production", store.GetName())
+		require.NotEmpty(t, progress)
+		require.Equal(t, 2, progress[len(progress)-1])
+
+		tuples, _, err := ds.ReadPage(ctx, store.GetId(), storage.ReadFilter{}, storage.ReadPageOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, ""),
+		})
+		require.NoError(t, err)
+		require.Len(t, tuples, 2)
+	})
+
+	t.Run("with_a_name", func(t *testing.T) {
+		store, err := cmd.Execute(ctx, &adminv1.CloneStoreRequest{SourceStoreId: source.GetId(), Name: "staging"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "staging", store.GetName())
+	})
+
+	t.Run("deletes_the_target_of_a_failed_copy", func(t *testing.T) {
+		var target string
+		cmd := NewCloneStoreCommand(&failingCloner{OpenFGADatastore: ds, created: &target}, ds)
+		_, err := cmd.Execute(ctx, &adminv1.CloneStoreRequest{SourceStoreId: source.GetId()}, nil)
+		require.Error(t, err)
+		require.NotEmpty(t, target)
+
+		_, err = ds.GetStore(ctx, target)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("source_not_found", func(t *testing.T) {
+		_, err := cmd.Execute(ctx, &adminv1.CloneStoreRequest{SourceStoreId: ulid.Make().String()}, nil)
+		require.ErrorIs(t, err, serverErrors.ErrStoreIDNotFound)
+
+		_, err = cmd.Execute(ctx, &adminv1.CloneStoreRequest{SourceStoreId: ulid.Make().String(), Name: "staging"}, nil)
+		require.ErrorIs(t, err, serverErrors.ErrStoreIDNotFound)
+	})
+}
+
+// failingCloner is a [storage.StoreCloner] creating the target store, then failing to copy into it.
+type failingCloner struct {
+	storage.OpenFGADatastore
+	created *string
+}
+
+func (c *failingCloner) CloneStore(ctx context.Context, _ string, target *openfgav1.Store, _ storage.CloneStoreOptions) (*openfgav1.Store, error) {
+	store, err := c.CreateStore(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	*c.created = store.GetId()
+	return nil, errors.New("copy failed")
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/openfga/openfga/internal/validation"
+	"github.com/openfga/openfga/pkg/logger"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// DefaultOrphanedTuplesLimit is the default maximum number of orphaned tuples returned by a model diff.
+const DefaultOrphanedTuplesLimit = 100
+
+// defaultDiffAuthModelsPageSize is the default number of tuples read at once by the scan of a model diff.
+const defaultDiffAuthModelsPageSize = 1000
+
+// DiffAuthorizationModelsCommand compares two authorization models of a store, and finds the tuples
+// of the store that the second model makes invalid.
+type DiffAuthorizationModelsCommand struct {
+	tupleReader         storage.RelationshipTupleReader
+	logger              logger.Logger
+	orphanedTuplesLimit int
+	skipTupleScan       bool
+	pageSize            int
+}
+
+type DiffAuthModelsOption func(*DiffAuthorizationModelsCommand)
+
+func WithDiffAuthModelsLogger(l logger.Logger) DiffAuthModelsOption {
+	return func(c *DiffAuthorizationModelsCommand) {
+		c.logger = l
+	}
+}
+
+// WithDiffAuthModelsOrphanedTuplesLimit sets the maximum number of orphaned tuples returned. Zero
+// and negative limits are ignored.
+func WithDiffAuthModelsOrphanedTuplesLimit(limit int) DiffAuthModelsOption {
+	return func(c *DiffAuthorizationModelsCommand) {
+		if limit > 0 {
+			c.orphanedTuplesLimit = limit
+		}
+	}
+}
+
+// WithDiffAuthModelsSkipTupleScan only compares the models, without scanning the tuples of the store.
+func WithDiffAuthModelsSkipTupleScan(skipTupleScan bool) DiffAuthModelsOption {
+	return func(c *DiffAuthorizationModelsCommand) {
+		c.skipTupleScan = skipTupleScan
+	}
+}
+
+// WithDiffAuthModelsPageSize sets the number of tuples read at once by the scan.
+func WithDiffAuthModelsPageSize(pageSize int) DiffAuthModelsOption {
+	return func(c *DiffAuthorizationModelsCommand) {
+		c.pageSize = pageSize
+	}
+}
+
+// NewDiffAuthorizationModelsCommand creates a DiffAuthorizationModelsCommand scanning the tuples with tupleReader.
+func NewDiffAuthorizationModelsCommand(tupleReader storage.RelationshipTupleReader, opts ...DiffAuthModelsOption) *DiffAuthorizationModelsCommand {
+	cmd := &DiffAuthorizationModelsCommand{
+		tupleReader:         tupleReader,
+		logger:              logger.NewNoopLogger(),
+		orphanedTuplesLimit: DefaultOrphanedTuplesLimit,
+		pageSize:            defaultDiffAuthModelsPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
+}
+
+// Execute compares the from and to models of storeID, see [typesystem.Diff]. Unless the scan is
+// skipped, the tuples of the store whose object type the changes may make invalid, see
+// [typesystem.ModelDiff.OrphanableTypes], are validated against the to model like a write, and the
+// invalid ones are counted as orphaned.
+func (c *DiffAuthorizationModelsCommand) Execute(ctx context.Context, storeID string, from, to *typesystem.TypeSystem) (*adminv1.DiffAuthorizationModelsResponse, error) {
+	diff := typesystem.Diff(from, to)
+	resp := &adminv1.DiffAuthorizationModelsResponse{
+		FromAuthorizationModelId: from.GetAuthorizationModelID(),
+		ToAuthorizationModelId:   to.GetAuthorizationModelID(),
+		AddedTypes:               diff.AddedTypes,
+		RemovedTypes:             diff.RemovedTypes,
+		ChangedTypes:             diff.ChangedTypes,
+		AddedRelations:           relationChanges(diff.AddedRelations),
+		RemovedRelations:         relationChanges(diff.RemovedRelations),
+		ChangedRelations:         relationChanges(diff.ChangedRelations),
+		AddedConditions:          diff.AddedConditions,
+		RemovedConditions:        diff.RemovedConditions,
+		ChangedConditions:        diff.ChangedConditions,
+	}
+
+	if c.skipTupleScan || !diff.MayOrphanTuples() {
+		return resp, nil
+	}
+
+	objectTypes := diff.OrphanableTypes(from)
+	if len(objectTypes) == 0 {
+		return resp, nil
+	}
+
+	resp.TuplesScanned = true
+	for _, objectType := range objectTypes {
+		if err := c.scanType(ctx, storeID, objectType, to, resp); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// scanType validates the tuples of storeID with objects of objectType against the to model, and
+// records the invalid ones in resp.
+func (c *DiffAuthorizationModelsCommand) scanType(ctx context.Context, storeID, objectType string, to *typesystem.TypeSystem, resp *adminv1.DiffAuthorizationModelsResponse) error {
+	filter := storage.ReadFilter{Object: objectType + ":"}
+	token := ""
+	for {
+		tuples, next, err := c.tupleReader.ReadPage(ctx, storeID, filter, storage.ReadPageOptions{
+			Pagination: storage.NewPaginationOptions(int32(c.pageSize), token),
+		})
+		if err != nil {
+			return serverErrors.HandleError("", err)
+		}
+
+		for _, t := range tuples {
+			tk := t.GetKey()
+			if err := validation.ValidateTupleForWrite(to, tk); err != nil {
+				resp.OrphanedTupleCount++
+				if len(resp.GetOrphanedTuples()) < c.orphanedTuplesLimit {
+					resp.OrphanedTuples = append(resp.OrphanedTuples, &adminv1.OrphanedTuple{
+						TupleKey: tk,
+						Error:    err.Error(),
+					})
+				}
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		token = next
+	}
+}
+
+func relationChanges(relationDiffs []typesystem.RelationDiff) []*adminv1.RelationChange {
+	changes := make([]*adminv1.RelationChange, 0, len(relationDiffs))
+	for _, relationDiff := range relationDiffs {
+		changes = append(changes, &adminv1.RelationChange{
+			Type:                            relationDiff.ObjectType,
+			Relation:                        relationDiff.Relation,
+			RewriteChanged:                  relationDiff.RewriteChanged,
+			AddedDirectlyRelatedUserTypes:   relationDiff.AddedDirectlyRelatedUserTypes,
+			RemovedDirectlyRelatedUserTypes: relationDiff.RemovedDirectlyRelatedUserTypes,
+		})
+	}
+	return changes
+}
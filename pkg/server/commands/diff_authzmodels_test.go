@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/testutils"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+func TestDiffAuthorizationModelsCommand(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	ctx := context.Background()
+
+	from, err := typesystem.NewAndValidate(ctx, testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type team
+			relations
+				define member: [user]
+		type doc
+			relations
+				define viewer: [user, user:*, team#member]
+	`))
+	require.NoError(t, err)
+
+	store := ulid.Make().String()
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("doc:1", "viewer", "user:anne"),
+		tuple.NewTupleKey("doc:1", "viewer", "user:*"),
+		tuple.NewTupleKey("doc:2", "viewer", "team:1#member"),
+		tuple.NewTupleKey("team:1", "member", "user:bob"),
+	}))
+
+	t.Run("orphaned_tuples", func(t *testing.T) {
+		to, err := typesystem.NewAndValidate(ctx, testutils.MustTransformDSLToProtoWithID(`
+			model
+				schema 1.1
+			type user
+			type doc
+				relations
+					define viewer: [user]
+		`))
+		require.NoError(t, err)
+
+		resp, err := NewDiffAuthorizationModelsCommand(ds, WithDiffAuthModelsPageSize(1), WithDiffAuthModelsOrphanedTuplesLimit(2)).
+			Execute(ctx, store, from, to)
+		require.NoError(t, err)
+		require.Equal(t, from.GetAuthorizationModelID(), resp.GetFromAuthorizationModelId())
+		require.Equal(t, to.GetAuthorizationModelID(), resp.GetToAuthorizationModelId())
+		require.Equal(t, []string{"team"}, resp.GetRemovedTypes())
+		require.Equal(t, []string{"doc"}, resp.GetChangedTypes())
+		require.Len(t, resp.GetChangedRelations(), 1)
+		require.Equal(t, []string{"team#member", "user:*"}, resp.GetChangedRelations()[0].GetRemovedDirectlyRelatedUserTypes())
+		require.True(t, resp.GetTuplesScanned())
+		require.Equal(t, int64(3), resp.GetOrphanedTupleCount())
+		require.Len(t, resp.GetOrphanedTuples(), 2)
+		require.NotEmpty(t, resp.GetOrphanedTuples()[0].GetError())
+	})
+
+	t.Run("only_orphanable_types_are_scanned", func(t *testing.T) {
+		to, err := typesystem.NewAndValidate(ctx, testutils.MustTransformDSLToProtoWithID(`
+			model
+				schema 1.1
+			type user
+			type team
+				relations
+					define member: [user, user:*]
+			type doc
+				relations
+					define viewer: [user, user:*, team#member]
+		`))
+		require.NoError(t, err)
+
+		reader := &filterRecordingReader{RelationshipTupleReader: ds}
+		resp, err := NewDiffAuthorizationModelsCommand(reader).Execute(ctx, store, from, to)
+		require.NoError(t, err)
+		require.True(t, resp.GetTuplesScanned())
+		require.Zero(t, resp.GetOrphanedTupleCount())
+		require.Equal(t, []storage.ReadFilter{{Object: "team:"}}, reader.filters)
+	})
+
+	t.Run("skip_tuple_scan", func(t *testing.T) {
+		to, err := typesystem.NewAndValidate(ctx, testutils.MustTransformDSLToProtoWithID(`
+			model
+				schema 1.1
+			type user
+			type doc
+				relations
+					define viewer: [user]
+		`))
+		require.NoError(t, err)
+
+		resp, err := NewDiffAuthorizationModelsCommand(ds, WithDiffAuthModelsSkipTupleScan(true)).Execute(ctx, store, from, to)
+		require.NoError(t, err)
+		require.False(t, resp.GetTuplesScanned())
+		require.Zero(t, resp.GetOrphanedTupleCount())
+	})
+
+	t.Run("only_additions_are_not_scanned", func(t *testing.T) {
+		to, err := typesystem.NewAndValidate(ctx, testutils.MustTransformDSLToProtoWithID(`
+			model
+				schema 1.1
+			type user
+			type team
+				relations
+					define member: [user]
+			type doc
+				relations
+					define viewer: [user, user:*, team#member]
+			type folder
+				relations
+					define viewer: [user]
+		`))
+		require.NoError(t, err)
+
+		resp, err := NewDiffAuthorizationModelsCommand(ds).Execute(ctx, store, from, to)
+		require.NoError(t, err)
+		require.Equal(t, []string{"folder"}, resp.GetAddedTypes())
+		require.False(t, resp.GetTuplesScanned())
+	})
+}
+
+// filterRecordingReader records the filters of the pages read.
+type filterRecordingReader struct {
+	storage.RelationshipTupleReader
+	filters []storage.ReadFilter
+}
+
+func (r *filterRecordingReader) ReadPage(ctx context.Context, store string, filter storage.ReadFilter, options storage.ReadPageOptions) ([]*openfgav1.Tuple, string, error) {
+	r.filters = append(r.filters, filter)
+	return r.RelationshipTupleReader.ReadPage(ctx, store, filter, options)
+}
@@ -35,7 +35,7 @@ func WithExpandQueryLogger(l logger.Logger) ExpandQueryOption {
 }
 
 // NewExpandQuery creates a new ExpandQuery using the supplied backends for retrieving data.
-func NewExpandQuery(datastore storage.OpenFGADatastore, opts ...ExpandQueryOption) *ExpandQuery {
+func NewExpandQuery(datastore storage.RelationshipTupleReader, opts ...ExpandQueryOption) *ExpandQuery {
 	eq := &ExpandQuery{
 		datastore: datastore,
 		logger:    logger.NewNoopLogger(),
@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"context"
+	"runtime"
+
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/concurrency"
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/server/config"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// ImportTuplesCommand validates batches of tuples and writes the valid ones in bulk, see [storage.BulkLoader].
+type ImportTuplesCommand struct {
+	loader                    storage.BulkLoader
+	typesys                   *typesystem.TypeSystem
+	logger                    logger.Logger
+	conditionContextByteLimit int
+	concurrency               int
+	skipChangelog             bool
+}
+
+type ImportTuplesCmdOption func(*ImportTuplesCommand)
+
+func WithImportTuplesCmdLogger(l logger.Logger) ImportTuplesCmdOption {
+	return func(c *ImportTuplesCommand) {
+		c.logger = l
+	}
+}
+
+func WithImportTuplesCmdConditionContextByteLimit(limit int) ImportTuplesCmdOption {
+	return func(c *ImportTuplesCommand) {
+		c.conditionContextByteLimit = limit
+	}
+}
+
+// WithImportTuplesCmdConcurrency sets the maximum number of tuples validated at once.
+func WithImportTuplesCmdConcurrency(concurrency int) ImportTuplesCmdOption {
+	return func(c *ImportTuplesCommand) {
+		c.concurrency = concurrency
+	}
+}
+
+// WithImportTuplesCmdSkipChangelog writes the tuples without recording them in the changelog.
+func WithImportTuplesCmdSkipChangelog(skipChangelog bool) ImportTuplesCmdOption {
+	return func(c *ImportTuplesCommand) {
+		c.skipChangelog = skipChangelog
+	}
+}
+
+// NewImportTuplesCommand creates an ImportTuplesCommand validating the tuples against typesys
+// and writing them with loader.
+func NewImportTuplesCommand(
+	loader storage.BulkLoader,
+	typesys *typesystem.TypeSystem,
+	opts ...ImportTuplesCmdOption,
+) *ImportTuplesCommand {
+	cmd := &ImportTuplesCommand{
+		loader:                    loader,
+		typesys:                   typesys,
+		logger:                    logger.NewNoopLogger(),
+		conditionContextByteLimit: config.DefaultWriteContextByteLimit,
+		concurrency:               runtime.GOMAXPROCS(0),
+	}
+
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
+}
+
+// Execute validates tuples with the same rules as Write and writes the valid ones to the store.
+// The invalid tuples are reported in the response and do not fail the call, while the tuples
+// that already exist are left as they are and counted as existing.
+func (c *ImportTuplesCommand) Execute(ctx context.Context, storeID string, tuples []*openfgav1.TupleKey) (*adminv1.ImportTuplesResponse, error) {
+	tupleErrs := make([]error, len(tuples))
+
+	pool := concurrency.NewPool(ctx, c.concurrency)
+	for i, tk := range tuples {
+		pool.Go(func(ctx context.Context) error {
+			tupleErrs[i] = validateTupleForWrite(c.typesys, tk, c.conditionContextByteLimit)
+			return nil
+		})
+	}
+	_ = pool.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp := &adminv1.ImportTuplesResponse{}
+	valid := make([]*openfgav1.TupleKey, 0, len(tuples))
+	for i, err := range tupleErrs {
+		if err != nil {
+			resp.Errors = append(resp.Errors, &adminv1.TupleError{
+				Index:    int32(i),
+				TupleKey: tuples[i],
+				Error:    status.Convert(err).Message(),
+			})
+			continue
+		}
+		valid = append(valid, tuples[i])
+	}
+
+	if len(valid) == 0 {
+		return resp, nil
+	}
+
+	written, err := c.loader.BulkLoad(ctx, storeID, valid, storage.BulkLoadOptions{
+		SkipChangelog: c.skipChangelog,
+	})
+	if err != nil {
+		return nil, serverErrors.HandleError("", err)
+	}
+
+	resp.WrittenTuples = int64(written)
+	resp.ExistingTuples = int64(len(valid) - written)
+	return resp, nil
+}
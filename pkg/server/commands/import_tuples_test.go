@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/testutils"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+func TestImportTuplesCommand(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	ctx := context.Background()
+
+	model := testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type doc
+			relations
+				define viewer: [user]
+	`)
+	ts, err := typesystem.NewAndValidate(ctx, model)
+	require.NoError(t, err)
+
+	countChanges := func(t *testing.T, store string) int {
+		t.Helper()
+		changes, _, err := ds.ReadChanges(ctx, store, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, ""),
+		})
+		if err != nil {
+			require.ErrorIs(t, err, storage.ErrNotFound)
+		}
+		return len(changes)
+	}
+
+	t.Run("reports_the_invalid_tuples", func(t *testing.T) {
+		store := ulid.Make().String()
+		cmd := NewImportTuplesCommand(ds.(storage.BulkLoader), ts, WithImportTuplesCmdConcurrency(2))
+
+		resp, err := cmd.Execute(ctx, store, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("doc:1", "viewer", "user:anne"),
+			tuple.NewTupleKey("doc:1", "editor", "user:anne"),
+			tuple.NewTupleKey("doc:2", "viewer", "user:bob"),
+			tuple.NewTupleKey("doc:1", "viewer", "doc:1#viewer"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), resp.GetWrittenTuples())
+		require.Zero(t, resp.GetExistingTuples())
+		require.Len(t, resp.GetErrors(), 2)
+		require.Equal(t, int32(1), resp.GetErrors()[0].GetIndex())
+		require.Equal(t, "editor", resp.GetErrors()[0].GetTupleKey().GetRelation())
+		require.NotEmpty(t, resp.GetErrors()[0].GetError())
+		require.Equal(t, int32(3), resp.GetErrors()[1].GetIndex())
+		require.Equal(t, 2, countChanges(t, store))
+
+		resp, err = cmd.Execute(ctx, store, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("doc:1", "viewer", "user:anne"),
+			tuple.NewTupleKey("doc:3", "viewer", "user:anne"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.GetWrittenTuples())
+		require.Equal(t, int64(1), resp.GetExistingTuples())
+		require.Empty(t, resp.GetErrors())
+	})
+
+	t.Run("skip_changelog", func(t *testing.T) {
+		store := ulid.Make().String()
+		cmd := NewImportTuplesCommand(ds.(storage.BulkLoader), ts, WithImportTuplesCmdSkipChangelog(true))
+
+		resp, err := cmd.Execute(ctx, store, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("doc:1", "viewer", "user:anne"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.GetWrittenTuples())
+		require.Zero(t, countChanges(t, store))
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/openfga/openfga/internal/throttler/threshold"
 	"github.com/openfga/openfga/internal/utils/apimethod"
 	"github.com/openfga/openfga/internal/validation"
+	"github.com/openfga/openfga/pkg/encoder"
 	"github.com/openfga/openfga/pkg/featureflags"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/server/commands/reverseexpand"
@@ -83,6 +84,8 @@ type ListObjectsQuery struct {
 	numProcs          int
 	pipeExtendAfter   time.Duration
 	pipeMaxExtensions int
+
+	encoder encoder.Encoder // encodes the continuation tokens of the pages, see ExecutePage
 }
 
 type ListObjectsResolver interface {
@@ -234,6 +237,13 @@ func WithListObjectsPipeExtension(extendAfter time.Duration, maxExtensions int)
 	}
 }
 
+// WithListObjectsEncoder sets the encoder of the continuation tokens of the pages returned by ExecutePage.
+func WithListObjectsEncoder(e encoder.Encoder) ListObjectsQueryOption {
+	return func(d *ListObjectsQuery) {
+		d.encoder = e
+	}
+}
+
 func NewListObjectsQuery(
 	ds storage.RelationshipTupleReader,
 	checkResolver graph.CheckResolver,
@@ -270,6 +280,7 @@ func NewListObjectsQuery(
 		optimizationsEnabled: false,
 		useShadowCache:       false,
 		ff:                   featureflags.NewNoopFeatureFlagClient(),
+		encoder:              encoder.NewBase64Encoder(),
 	}
 
 	for _, opt := range opts {
@@ -326,30 +337,6 @@ func (q *ListObjectsQuery) evaluate(
 	}
 
 	handler := func() {
-		userObj, userRel := tuple.SplitObjectRelation(req.GetUser())
-		userObjType, userObjID := tuple.SplitObject(userObj)
-
-		var sourceUserRef reverseexpand.IsUserRef
-		sourceUserRef = &reverseexpand.UserRefObject{
-			Object: &openfgav1.Object{
-				Type: userObjType,
-				Id:   userObjID,
-			},
-		}
-
-		if tuple.IsTypedWildcard(userObj) {
-			sourceUserRef = &reverseexpand.UserRefTypedWildcard{Type: tuple.GetType(userObj)}
-		}
-
-		if userRel != "" {
-			sourceUserRef = &reverseexpand.UserRefObjectRelation{
-				ObjectRelation: &openfgav1.ObjectRelation{
-					Object:   userObj,
-					Relation: userRel,
-				},
-			}
-		}
-
 		var bufferSize uint32
 		cappedMaxResults := uint32(math.Min(float64(maxResults), 1000)) // cap max results at 1000
 		bufferSize = uint32(math.Max(float64(cappedMaxResults/10), 10)) // 10% of max results, but make it at least 10
@@ -357,33 +344,8 @@ func (q *ListObjectsQuery) evaluate(
 		reverseExpandResultsChan := make(chan *reverseexpand.ReverseExpandResult, bufferSize)
 		objectsFound := atomic.Uint32{}
 
-		ds := storagewrappers.NewRequestStorageWrapperWithCache(
-			q.datastore,
-			req.GetContextualTuples().GetTupleKeys(),
-			&storagewrappers.Operation{
-				Method:            apimethod.ListObjects,
-				Concurrency:       q.maxConcurrentReads,
-				ThrottlingEnabled: q.datastoreThrottlingEnabled,
-				ThrottleThreshold: q.datastoreThrottleThreshold,
-				ThrottleDuration:  q.datastoreThrottleDuration,
-			},
-			storagewrappers.DataResourceConfiguration{
-				Resources:      q.sharedDatastoreResources,
-				CacheSettings:  q.cacheSettings,
-				UseShadowCache: q.useShadowCache,
-			},
-		)
-
-		reverseExpandQuery := reverseexpand.NewReverseExpandQuery(
-			ds,
-			typesys,
-			reverseexpand.WithResolveNodeLimit(q.resolveNodeLimit),
-			reverseexpand.WithDispatchThrottlerConfig(q.dispatchThrottlerConfig),
-			reverseexpand.WithResolveNodeBreadthLimit(q.resolveNodeBreadthLimit),
-			reverseexpand.WithLogger(q.logger),
-			reverseexpand.WithCheckResolver(q.checkResolver),
-			reverseexpand.WithListObjectOptimizationsEnabled(q.optimizationsEnabled),
-		)
+		ds := q.requestStorage(req)
+		reverseExpandQuery := q.newReverseExpandQuery(ds, typesys)
 
 		reverseExpandDoneWithError := make(chan struct{}, 1)
 		cancelCtx, cancel := context.WithCancel(ctx)
@@ -396,7 +358,7 @@ func (q *ListObjectsQuery) evaluate(
 				StoreID:          req.GetStoreId(),
 				ObjectType:       targetObjectType,
 				Relation:         targetRelation,
-				User:             sourceUserRef,
+				User:             reverseExpandUser(req.GetUser()),
 				ContextualTuples: req.GetContextualTuples().GetTupleKeys(),
 				Context:          req.GetContext(),
 				Consistency:      req.GetConsistency(),
@@ -447,32 +409,11 @@ func (q *ListObjectsQuery) evaluate(
 				furtherEvalRequiredCounter.Inc()
 
 				pool.Go(func(ctx context.Context) error {
-					resp, checkRequestMetadata, err := NewCheckCommand(q.datastore, q.checkResolver, typesys,
-						WithCheckCommandLogger(q.logger),
-						WithCheckCommandMaxConcurrentReads(q.maxConcurrentReads),
-						WithCheckDatastoreThrottler(
-							q.datastoreThrottlingEnabled,
-							q.datastoreThrottleThreshold,
-							q.datastoreThrottleDuration,
-						),
-					).
-						Execute(ctx, &CheckCommandParams{
-							StoreID:          req.GetStoreId(),
-							TupleKey:         tuple.NewCheckRequestTupleKey(res.Object, req.GetRelation(), req.GetUser()),
-							ContextualTuples: req.GetContextualTuples(),
-							Context:          req.GetContext(),
-							Consistency:      req.GetConsistency(),
-						})
+					allowed, err := q.checkObject(ctx, typesys, req, res.Object, resolutionMetadata)
 					if err != nil {
 						return err
 					}
-					resolutionMetadata.DatastoreQueryCount.Add(resp.GetResolutionMetadata().DatastoreQueryCount)
-					resolutionMetadata.DatastoreItemCount.Add(resp.GetResolutionMetadata().DatastoreItemCount)
-					resolutionMetadata.DispatchCounter.Add(checkRequestMetadata.DispatchCounter.Load())
-					if !resolutionMetadata.DispatchThrottled.Load() && checkRequestMetadata.DispatchThrottled.Load() {
-						resolutionMetadata.DispatchThrottled.Store(true)
-					}
-					if resp.Allowed {
+					if allowed {
 						trySendObject(ctx, res.Object, &objectsFound, maxResults, resultsChan)
 					}
 					return nil
@@ -499,6 +440,42 @@ func (q *ListObjectsQuery) evaluate(
 	return nil
 }
 
+// checkObject resolves with Check whether the user of req has the relation of req with object.
+func (q *ListObjectsQuery) checkObject(
+	ctx context.Context,
+	typesys *typesystem.TypeSystem,
+	req listObjectsRequest,
+	object string,
+	resolutionMetadata *ListObjectsResolutionMetadata,
+) (bool, error) {
+	resp, checkRequestMetadata, err := NewCheckCommand(q.datastore, q.checkResolver, typesys,
+		WithCheckCommandLogger(q.logger),
+		WithCheckCommandMaxConcurrentReads(q.maxConcurrentReads),
+		WithCheckDatastoreThrottler(
+			q.datastoreThrottlingEnabled,
+			q.datastoreThrottleThreshold,
+			q.datastoreThrottleDuration,
+		),
+	).
+		Execute(ctx, &CheckCommandParams{
+			StoreID:          req.GetStoreId(),
+			TupleKey:         tuple.NewCheckRequestTupleKey(object, req.GetRelation(), req.GetUser()),
+			ContextualTuples: req.GetContextualTuples(),
+			Context:          req.GetContext(),
+			Consistency:      req.GetConsistency(),
+		})
+	if err != nil {
+		return false, err
+	}
+	resolutionMetadata.DatastoreQueryCount.Add(resp.GetResolutionMetadata().DatastoreQueryCount)
+	resolutionMetadata.DatastoreItemCount.Add(resp.GetResolutionMetadata().DatastoreItemCount)
+	resolutionMetadata.DispatchCounter.Add(checkRequestMetadata.DispatchCounter.Load())
+	if !resolutionMetadata.DispatchThrottled.Load() && checkRequestMetadata.DispatchThrottled.Load() {
+		resolutionMetadata.DispatchThrottled.Store(true)
+	}
+	return resp.Allowed, nil
+}
+
 func trySendObject(ctx context.Context, object string, objectsFound *atomic.Uint32, maxResults uint32, resultsChan chan<- ListObjectsResult) {
 	if maxResults != 0 {
 		if objectsFound.Add(1) > maxResults {
@@ -571,53 +548,9 @@ func (q *ListObjectsQuery) Execute(
 	wgraph := typesys.GetWeightedGraph()
 
 	if wgraph != nil && q.pipelineEnabled {
-		ds := storagewrappers.NewRequestStorageWrapperWithCache(
-			q.datastore,
-			req.GetContextualTuples().GetTupleKeys(),
-			&storagewrappers.Operation{
-				Method:            apimethod.ListObjects,
-				Concurrency:       q.maxConcurrentReads,
-				ThrottlingEnabled: q.datastoreThrottlingEnabled,
-				ThrottleThreshold: q.datastoreThrottleThreshold,
-				ThrottleDuration:  q.datastoreThrottleDuration,
-			},
-			storagewrappers.DataResourceConfiguration{
-				Resources:      q.sharedDatastoreResources,
-				CacheSettings:  q.cacheSettings,
-				UseShadowCache: q.useShadowCache,
-			},
-		)
-
-		backend := &pipeline.Backend{
-			Datastore:  ds,
-			StoreID:    req.GetStoreId(),
-			TypeSystem: typesys,
-			Context:    req.GetContext(),
-			Graph:      wgraph,
-			Preference: req.GetConsistency(),
-		}
-
-		var options []pipeline.Option
-
-		if q.chunkSize > 0 {
-			options = append(options, pipeline.WithChunkSize(q.chunkSize))
-		}
-
-		if q.bufferSize > 0 {
-			options = append(options, pipeline.WithBufferSize(q.bufferSize))
-		}
-
-		if q.numProcs > 0 {
-			options = append(options, pipeline.WithNumProcs(q.numProcs))
-		}
-
-		if q.pipeExtendAfter > 0 {
-			options = append(options, pipeline.WithPipeExtension(q.pipeExtendAfter, q.pipeMaxExtensions))
-		}
-
-		pl, err := pipeline.New(backend, options...)
+		pl, ds, err := q.newPipeline(req, typesys)
 		if err != nil {
-			return nil, serverErrors.ValidationError(err)
+			return nil, err
 		}
 
 		var source pipeline.Source
@@ -627,18 +560,8 @@ func (q *ListObjectsQuery) Execute(
 			return nil, serverErrors.ValidationError(fmt.Errorf("object: %s relation: %s not in graph", targetObjectType, targetRelation))
 		}
 
-		userParts := strings.Split(req.GetUser(), "#")
-
-		objectParts := strings.Split(userParts[0], ":")
-		objectType := objectParts[0]
-		objectID := objectParts[1]
-
-		if len(userParts) > 1 {
-			objectType += "#" + userParts[1]
-		}
-
-		if target, ok = pl.Target(objectType, objectID); !ok {
-			return nil, serverErrors.ValidationError(fmt.Errorf("user: %s relation: %s not in graph", objectType, objectID))
+		if target, err = pipelineTarget(pl, req.GetUser()); err != nil {
+			return nil, err
 		}
 
 		seq := pl.Build(timeoutCtx, source, target)
@@ -764,53 +687,9 @@ func (q *ListObjectsQuery) ExecuteStreamed(ctx context.Context, req *openfgav1.S
 	wgraph := typesys.GetWeightedGraph()
 
 	if wgraph != nil && q.pipelineEnabled {
-		ds := storagewrappers.NewRequestStorageWrapperWithCache(
-			q.datastore,
-			req.GetContextualTuples().GetTupleKeys(),
-			&storagewrappers.Operation{
-				Method:            apimethod.ListObjects,
-				Concurrency:       q.maxConcurrentReads,
-				ThrottlingEnabled: q.datastoreThrottlingEnabled,
-				ThrottleThreshold: q.datastoreThrottleThreshold,
-				ThrottleDuration:  q.datastoreThrottleDuration,
-			},
-			storagewrappers.DataResourceConfiguration{
-				Resources:      q.sharedDatastoreResources,
-				CacheSettings:  q.cacheSettings,
-				UseShadowCache: q.useShadowCache,
-			},
-		)
-
-		backend := &pipeline.Backend{
-			Datastore:  ds,
-			StoreID:    req.GetStoreId(),
-			TypeSystem: typesys,
-			Context:    req.GetContext(),
-			Graph:      wgraph,
-			Preference: req.GetConsistency(),
-		}
-
-		var options []pipeline.Option
-
-		if q.chunkSize > 0 {
-			options = append(options, pipeline.WithChunkSize(q.chunkSize))
-		}
-
-		if q.bufferSize > 0 {
-			options = append(options, pipeline.WithBufferSize(q.bufferSize))
-		}
-
-		if q.numProcs > 0 {
-			options = append(options, pipeline.WithNumProcs(q.numProcs))
-		}
-
-		if q.pipeExtendAfter > 0 {
-			options = append(options, pipeline.WithPipeExtension(q.pipeExtendAfter, q.pipeMaxExtensions))
-		}
-
-		pl, err := pipeline.New(backend, options...)
+		pl, ds, err := q.newPipeline(req, typesys)
 		if err != nil {
-			return nil, serverErrors.ValidationError(err)
+			return nil, err
 		}
 
 		var source pipeline.Source
@@ -820,18 +699,8 @@ func (q *ListObjectsQuery) ExecuteStreamed(ctx context.Context, req *openfgav1.S
 			return nil, serverErrors.ValidationError(fmt.Errorf("object: %s relation: %s not in graph", targetObjectType, targetRelation))
 		}
 
-		userParts := strings.Split(req.GetUser(), "#")
-
-		objectParts := strings.Split(userParts[0], ":")
-		objectType := objectParts[0]
-		objectID := objectParts[1]
-
-		if len(userParts) > 1 {
-			objectType += "#" + userParts[1]
-		}
-
-		if target, ok = pl.Target(objectType, objectID); !ok {
-			return nil, serverErrors.ValidationError(fmt.Errorf("user: %s relation: %s not in graph", objectType, objectID))
+		if target, err = pipelineTarget(pl, req.GetUser()); err != nil {
+			return nil, err
 		}
 
 		seq := pl.Build(timeoutCtx, source, target)
@@ -902,3 +771,120 @@ func (q *ListObjectsQuery) ExecuteStreamed(ctx context.Context, req *openfgav1.S
 
 	return &resolutionMetadata, nil
 }
+
+// newPipeline returns the pipeline resolving req, and the request storage that the pipeline reads from.
+func (q *ListObjectsQuery) newPipeline(req listObjectsRequest, typesys *typesystem.TypeSystem) (*pipeline.Pipeline, *storagewrappers.RequestStorageWrapper, error) {
+	ds := q.requestStorage(req)
+
+	backend := &pipeline.Backend{
+		Datastore:  ds,
+		StoreID:    req.GetStoreId(),
+		TypeSystem: typesys,
+		Context:    req.GetContext(),
+		Graph:      typesys.GetWeightedGraph(),
+		Preference: req.GetConsistency(),
+	}
+
+	var options []pipeline.Option
+
+	if q.chunkSize > 0 {
+		options = append(options, pipeline.WithChunkSize(q.chunkSize))
+	}
+
+	if q.bufferSize > 0 {
+		options = append(options, pipeline.WithBufferSize(q.bufferSize))
+	}
+
+	if q.numProcs > 0 {
+		options = append(options, pipeline.WithNumProcs(q.numProcs))
+	}
+
+	if q.pipeExtendAfter > 0 {
+		options = append(options, pipeline.WithPipeExtension(q.pipeExtendAfter, q.pipeMaxExtensions))
+	}
+
+	pl, err := pipeline.New(backend, options...)
+	if err != nil {
+		return nil, nil, serverErrors.ValidationError(err)
+	}
+	return pl, ds, nil
+}
+
+// requestStorage returns the storage that the objects of req are read from.
+func (q *ListObjectsQuery) requestStorage(req listObjectsRequest) *storagewrappers.RequestStorageWrapper {
+	return storagewrappers.NewRequestStorageWrapperWithCache(
+		q.datastore,
+		req.GetContextualTuples().GetTupleKeys(),
+		&storagewrappers.Operation{
+			Method:            apimethod.ListObjects,
+			Concurrency:       q.maxConcurrentReads,
+			ThrottlingEnabled: q.datastoreThrottlingEnabled,
+			ThrottleThreshold: q.datastoreThrottleThreshold,
+			ThrottleDuration:  q.datastoreThrottleDuration,
+		},
+		storagewrappers.DataResourceConfiguration{
+			Resources:      q.sharedDatastoreResources,
+			CacheSettings:  q.cacheSettings,
+			UseShadowCache: q.useShadowCache,
+		},
+	)
+}
+
+// newReverseExpandQuery returns the reverse expansion reading the objects from ds.
+func (q *ListObjectsQuery) newReverseExpandQuery(ds storage.RelationshipTupleReader, typesys *typesystem.TypeSystem) *reverseexpand.ReverseExpandQuery {
+	return reverseexpand.NewReverseExpandQuery(
+		ds,
+		typesys,
+		reverseexpand.WithResolveNodeLimit(q.resolveNodeLimit),
+		reverseexpand.WithDispatchThrottlerConfig(q.dispatchThrottlerConfig),
+		reverseexpand.WithResolveNodeBreadthLimit(q.resolveNodeBreadthLimit),
+		reverseexpand.WithLogger(q.logger),
+		reverseexpand.WithCheckResolver(q.checkResolver),
+		reverseexpand.WithListObjectOptimizationsEnabled(q.optimizationsEnabled),
+	)
+}
+
+// reverseExpandUser returns the user of a ListObjects request as the user of a reverse expansion.
+func reverseExpandUser(user string) reverseexpand.IsUserRef {
+	userObj, userRel := tuple.SplitObjectRelation(user)
+	userObjType, userObjID := tuple.SplitObject(userObj)
+
+	if userRel != "" {
+		return &reverseexpand.UserRefObjectRelation{
+			ObjectRelation: &openfgav1.ObjectRelation{
+				Object:   userObj,
+				Relation: userRel,
+			},
+		}
+	}
+
+	if tuple.IsTypedWildcard(userObj) {
+		return &reverseexpand.UserRefTypedWildcard{Type: tuple.GetType(userObj)}
+	}
+
+	return &reverseexpand.UserRefObject{
+		Object: &openfgav1.Object{
+			Type: userObjType,
+			Id:   userObjID,
+		},
+	}
+}
+
+// pipelineTarget returns the target of pl for the user of a ListObjects request.
+func pipelineTarget(pl *pipeline.Pipeline, user string) (pipeline.Target, error) {
+	userParts := strings.Split(user, "#")
+
+	objectParts := strings.Split(userParts[0], ":")
+	objectType := objectParts[0]
+	objectID := objectParts[1]
+
+	if len(userParts) > 1 {
+		objectType += "#" + userParts[1]
+	}
+
+	target, ok := pl.Target(objectType, objectID)
+	if !ok {
+		return pipeline.Target{}, serverErrors.ValidationError(fmt.Errorf("user: %s relation: %s not in graph", objectType, objectID))
+	}
+	return target, nil
+}
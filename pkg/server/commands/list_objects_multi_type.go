@@ -0,0 +1,355 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/condition"
+	openfgaErrors "github.com/openfga/openfga/internal/errors"
+	"github.com/openfga/openfga/internal/graph"
+	"github.com/openfga/openfga/internal/validation"
+	"github.com/openfga/openfga/pkg/server/commands/reverseexpand/pipeline"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// ListObjectsTarget is an object type, and the relation with its objects, that a multi-type
+// ListObjects query lists the objects of.
+type ListObjectsTarget struct {
+	Type     string
+	Relation string
+
+	// MaxResults is the maximum number of objects listed for the target, 0 for no maximum.
+	MaxResults uint32
+}
+
+type MultiTypeListObjectsParams struct {
+	StoreID          string
+	Targets          []ListObjectsTarget
+	User             string
+	ContextualTuples *openfgav1.ContextualTupleKeys
+	Context          *structpb.Struct
+	Consistency      openfgav1.ConsistencyPreference
+
+	// JoinEvaluationErrors makes the condition evaluation errors of a target fail the query only
+	// if the target has fewer objects than its MaxResults once resolved, as they fail ListObjects,
+	// rather than failing it at once.
+	JoinEvaluationErrors bool
+}
+
+// ExecuteMultiType executes the ListObjectsQuery for several targets at once, sending every object
+// found with the index of its target in params.Targets. An object is sent at most once per target,
+// and the objects of different targets are interleaved in no particular order. It ignores the value
+// of q.listObjectsMaxResults in favor of the MaxResults of each target, and returns the objects found
+// until q.listObjectsDeadline is hit.
+//
+// A condition evaluation error fails the query at once, unless params.JoinEvaluationErrors is set.
+//
+// With the pipeline, the targets are resolved by a single pipeline, whose workers are shared by the
+// targets for the nodes of the weighted graph that they have in common. Otherwise, every target is
+// resolved by its own reverse expansion, and the reverse expansions run concurrently.
+func (q *ListObjectsQuery) ExecuteMultiType(
+	ctx context.Context,
+	params *MultiTypeListObjectsParams,
+	send func(target int, object string) error,
+) (*ListObjectsResolutionMetadata, error) {
+	timeoutCtx := ctx
+	if q.listObjectsDeadline != 0 {
+		var cancel context.CancelFunc
+		timeoutCtx, cancel = context.WithTimeout(ctx, q.listObjectsDeadline)
+		defer cancel()
+	}
+
+	typesys, ok := typesystem.TypesystemFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: typesystem missing in context", openfgaErrors.ErrUnknown)
+	}
+
+	if !typesystem.IsSchemaVersionSupported(typesys.GetSchemaVersion()) {
+		return nil, serverErrors.ValidationError(typesystem.ErrInvalidSchemaVersion)
+	}
+
+	for _, ctxTuple := range params.ContextualTuples.GetTupleKeys() {
+		if err := validation.ValidateTupleForWrite(typesys, ctxTuple); err != nil {
+			return nil, serverErrors.HandleTupleValidateError(err)
+		}
+	}
+
+	reqs := make([]*openfgav1.ListObjectsRequest, len(params.Targets))
+	for i, target := range params.Targets {
+		_, err := typesys.GetRelation(target.Type, target.Relation)
+		if err != nil {
+			if errors.Is(err, typesystem.ErrObjectTypeUndefined) {
+				return nil, serverErrors.TypeNotFound(target.Type)
+			}
+
+			if errors.Is(err, typesystem.ErrRelationUndefined) {
+				return nil, serverErrors.RelationNotFound(target.Relation, target.Type, nil)
+			}
+
+			return nil, serverErrors.HandleError("", err)
+		}
+
+		reqs[i] = &openfgav1.ListObjectsRequest{
+			StoreId:              params.StoreID,
+			AuthorizationModelId: typesys.GetAuthorizationModelID(),
+			Type:                 target.Type,
+			Relation:             target.Relation,
+			User:                 params.User,
+			ContextualTuples:     params.ContextualTuples,
+			Context:              params.Context,
+			Consistency:          params.Consistency,
+		}
+	}
+
+	if err := validation.ValidateUser(typesys, params.User); err != nil {
+		return nil, serverErrors.ValidationError(fmt.Errorf("invalid 'user' value: %w", err))
+	}
+
+	if len(reqs) == 0 {
+		return &ListObjectsResolutionMetadata{}, nil
+	}
+
+	objects := newMultiTypeObjects(params.Targets)
+
+	if typesys.GetWeightedGraph() != nil && q.pipelineEnabled {
+		// The targets only differ by type and relation, so the request of any of them
+		// configures the pipeline for all of them.
+		pl, ds, err := q.newPipeline(reqs[0], typesys)
+		if err != nil {
+			return nil, err
+		}
+
+		sources := make([]pipeline.Source, len(params.Targets))
+		for i, target := range params.Targets {
+			if sources[i], ok = pl.Source(target.Type, target.Relation); !ok {
+				return nil, serverErrors.ValidationError(fmt.Errorf("object: %s relation: %s not in graph", target.Type, target.Relation))
+			}
+		}
+
+		target, err := pipelineTarget(pl, params.User)
+		if err != nil {
+			return nil, err
+		}
+
+		var resolutionMetadata ListObjectsResolutionMetadata
+
+		for i, obj := range pl.BuildAll(timeoutCtx, sources, target) {
+			if timeoutCtx.Err() != nil {
+				break
+			}
+
+			// If the error is from a context cancelation, the current
+			// behavior for ListObjects is to not report it.
+			if obj.Err != nil {
+				if errors.Is(obj.Err, context.Canceled) || errors.Is(obj.Err, context.DeadlineExceeded) {
+					continue
+				}
+				if errors.Is(obj.Err, condition.ErrEvaluationFailed) {
+					if params.JoinEvaluationErrors {
+						objects.addError(i, obj.Err)
+						continue
+					}
+					return nil, serverErrors.ValidationError(obj.Err)
+				}
+				return nil, serverErrors.HandleError("", obj.Err)
+			}
+
+			if !objects.add(i, obj.Value) {
+				continue
+			}
+
+			if err := send(i, obj.Value); err != nil {
+				return nil, serverErrors.HandleError("", err)
+			}
+
+			if objects.done() {
+				break
+			}
+		}
+
+		if err := objects.err(); err != nil {
+			return nil, serverErrors.ValidationError(err)
+		}
+
+		dsMeta := ds.GetMetadata()
+		resolutionMetadata.DatastoreQueryCount.Add(dsMeta.DatastoreQueryCount)
+		resolutionMetadata.DatastoreItemCount.Add(dsMeta.DatastoreItemCount)
+		return &resolutionMetadata, nil
+	}
+
+	evaluateCtx, cancel := context.WithCancel(timeoutCtx)
+	defer cancel()
+
+	type targetResult struct {
+		target int
+		ListObjectsResult
+	}
+
+	results := make(chan targetResult, streamedBufferSize)
+	targetsMetadata := make([]ListObjectsResolutionMetadata, len(reqs))
+
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		resultsChan := make(chan ListObjectsResult, streamedBufferSize)
+
+		err := q.evaluate(evaluateCtx, req, resultsChan, params.Targets[i].MaxResults, &targetsMetadata[i])
+		if err != nil {
+			return nil, err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// The results channel is drained until evaluate closes it, even once
+			// the evaluation is canceled, so that evaluate is never blocked.
+			for result := range resultsChan {
+				select {
+				case results <- targetResult{target: i, ListObjectsResult: result}:
+				case <-evaluateCtx.Done():
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		if result.Err != nil {
+			if errors.Is(result.Err, graph.ErrResolutionDepthExceeded) {
+				return nil, serverErrors.ErrAuthorizationModelResolutionTooComplex
+			}
+
+			if errors.Is(result.Err, condition.ErrEvaluationFailed) {
+				if params.JoinEvaluationErrors {
+					objects.addError(result.target, result.Err)
+					continue
+				}
+				return nil, serverErrors.ValidationError(result.Err)
+			}
+
+			return nil, serverErrors.HandleError("", result.Err)
+		}
+
+		if !objects.add(result.target, result.ObjectID) {
+			continue
+		}
+
+		if err := send(result.target, result.ObjectID); err != nil {
+			return nil, serverErrors.HandleError("", err)
+		}
+
+		if objects.done() {
+			cancel()
+		}
+	}
+
+	if err := objects.err(); err != nil {
+		return nil, serverErrors.ValidationError(err)
+	}
+
+	var resolutionMetadata ListObjectsResolutionMetadata
+	for i := range targetsMetadata {
+		resolutionMetadata.add(&targetsMetadata[i])
+	}
+	return &resolutionMetadata, nil
+}
+
+// add adds the counters of other to m.
+func (m *ListObjectsResolutionMetadata) add(other *ListObjectsResolutionMetadata) {
+	m.DatastoreQueryCount.Add(other.DatastoreQueryCount.Load())
+	m.DatastoreItemCount.Add(other.DatastoreItemCount.Load())
+	m.DispatchCounter.Add(other.DispatchCounter.Load())
+	m.CheckCounter.Add(other.CheckCounter.Load())
+	if other.DispatchThrottled.Load() {
+		m.DispatchThrottled.Store(true)
+	}
+	if other.DatastoreThrottled.Load() {
+		m.DatastoreThrottled.Store(true)
+	}
+	if other.WasWeightedGraphUsed.Load() {
+		m.WasWeightedGraphUsed.Store(true)
+	}
+}
+
+// multiTypeObjects tracks the objects sent for every target of a multi-type query.
+type multiTypeObjects struct {
+	targets []ListObjectsTarget
+	seen    []map[string]struct{}
+
+	// errs are the condition evaluation errors of every target.
+	errs []error
+
+	// unbounded is the number of targets without a maximum number of objects.
+	unbounded int
+
+	// full is the number of targets that have their maximum number of objects.
+	full int
+}
+
+func newMultiTypeObjects(targets []ListObjectsTarget) *multiTypeObjects {
+	objects := &multiTypeObjects{
+		targets: targets,
+		seen:    make([]map[string]struct{}, len(targets)),
+		errs:    make([]error, len(targets)),
+	}
+	for i, target := range targets {
+		objects.seen[i] = make(map[string]struct{})
+		if target.MaxResults == 0 {
+			objects.unbounded++
+		}
+	}
+	return objects
+}
+
+// add records object for the target at index target, and returns whether it is to be sent, which
+// is not the case of an object already sent for the target or of an object beyond its maximum.
+func (o *multiTypeObjects) add(target int, object string) bool {
+	seen := o.seen[target]
+	maxResults := o.targets[target].MaxResults
+
+	if _, ok := seen[object]; ok {
+		return false
+	}
+
+	if maxResults != 0 && uint32(len(seen)) >= maxResults {
+		return false
+	}
+
+	seen[object] = struct{}{}
+	if maxResults != 0 && uint32(len(seen)) == maxResults {
+		o.full++
+	}
+	return true
+}
+
+// done returns whether every target has its maximum number of objects.
+func (o *multiTypeObjects) done() bool {
+	return o.unbounded == 0 && o.full == len(o.targets)
+}
+
+// addError records the condition evaluation error err of the target at index target.
+func (o *multiTypeObjects) addError(target int, err error) {
+	o.errs[target] = errors.Join(o.errs[target], err)
+}
+
+// err returns the condition evaluation errors of the targets that have fewer objects than their
+// maximum number of objects, joined, or nil if there are none.
+func (o *multiTypeObjects) err() error {
+	var errs error
+	for i, target := range o.targets {
+		if o.errs[i] != nil && uint32(len(o.seen[i])) < target.MaxResults {
+			errs = errors.Join(errs, o.errs[i])
+		}
+	}
+	return errs
+}
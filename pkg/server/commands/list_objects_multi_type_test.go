@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/graph"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	storagetest "github.com/openfga/openfga/pkg/storage/test"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+func TestListObjectsExecuteMultiType(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	storeID, model := storagetest.BootstrapFGAStore(t, ds, `
+		model
+			schema 1.1
+
+		type user
+
+		type folder
+			relations
+				define viewer: [user]
+
+		type document
+			relations
+				define parent: [folder]
+				define editor: [user]
+				define viewer: editor or viewer from parent
+				define commenter: [user with in_office_hours] or editor
+
+		condition in_office_hours(hour: int) {
+			hour >= 9 && hour < 17
+		}
+	`, []string{
+		"folder:1#viewer@user:jon",
+		"folder:2#viewer@user:jon",
+		"document:1#parent@folder:1",
+		"document:2#parent@folder:2",
+		"document:3#editor@user:jon",
+		"document:4#parent@folder:3",
+	})
+	err := ds.Write(context.Background(), storeID, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKeyWithCondition("document:2", "commenter", "user:jon", "in_office_hours", nil),
+	})
+	require.NoError(t, err)
+
+	ts, err := typesystem.NewAndValidate(context.Background(), model)
+	require.NoError(t, err)
+	ctx := typesystem.ContextWithTypesystem(context.Background(), ts)
+
+	checker, checkResolverCloser, err := graph.NewOrderedCheckResolvers().Build()
+	require.NoError(t, err)
+	t.Cleanup(checkResolverCloser)
+
+	executeJoiningEvaluationErrors := func(q *ListObjectsQuery, joinEvaluationErrors bool, targets ...ListObjectsTarget) ([][]string, error) {
+		objects := make([][]string, len(targets))
+		_, err := q.ExecuteMultiType(ctx, &MultiTypeListObjectsParams{
+			StoreID:              storeID,
+			Targets:              targets,
+			User:                 "user:jon",
+			JoinEvaluationErrors: joinEvaluationErrors,
+		}, func(target int, object string) error {
+			objects[target] = append(objects[target], object)
+			return nil
+		})
+		for _, targetObjects := range objects {
+			slices.Sort(targetObjects)
+		}
+		return objects, err
+	}
+
+	execute := func(q *ListObjectsQuery, targets ...ListObjectsTarget) ([][]string, error) {
+		return executeJoiningEvaluationErrors(q, false, targets...)
+	}
+
+	for _, pipelineEnabled := range []bool{false, true} {
+		t.Run("pipeline_"+strconv.FormatBool(pipelineEnabled), func(t *testing.T) {
+			q, err := NewListObjectsQuery(ds, checker, storeID, WithListObjectsPipelineEnabled(pipelineEnabled))
+			require.NoError(t, err)
+
+			t.Run("all_objects", func(t *testing.T) {
+				objects, err := execute(q,
+					ListObjectsTarget{Type: "document", Relation: "viewer"},
+					ListObjectsTarget{Type: "folder", Relation: "viewer"},
+					ListObjectsTarget{Type: "document", Relation: "editor"},
+				)
+				require.NoError(t, err)
+				require.Equal(t, [][]string{
+					{"document:1", "document:2", "document:3"},
+					{"folder:1", "folder:2"},
+					{"document:3"},
+				}, objects)
+			})
+
+			t.Run("max_results", func(t *testing.T) {
+				objects, err := execute(q,
+					ListObjectsTarget{Type: "document", Relation: "viewer", MaxResults: 2},
+					ListObjectsTarget{Type: "folder", Relation: "viewer", MaxResults: 1},
+				)
+				require.NoError(t, err)
+				require.Len(t, objects[0], 2)
+				require.Subset(t, []string{"document:1", "document:2", "document:3"}, objects[0])
+				require.Len(t, objects[1], 1)
+				require.Subset(t, []string{"folder:1", "folder:2"}, objects[1])
+			})
+
+			t.Run("evaluation_errors", func(t *testing.T) {
+				_, err := execute(q, ListObjectsTarget{Type: "document", Relation: "commenter", MaxResults: 2})
+				requireValidationError(t, err)
+
+				_, err = executeJoiningEvaluationErrors(q, true,
+					ListObjectsTarget{Type: "folder", Relation: "viewer"},
+					ListObjectsTarget{Type: "document", Relation: "commenter", MaxResults: 2},
+				)
+				requireValidationError(t, err)
+
+				// Like ListObjects, a target without a maximum never fails on evaluation errors.
+				objects, err := executeJoiningEvaluationErrors(q, true,
+					ListObjectsTarget{Type: "folder", Relation: "viewer"},
+					ListObjectsTarget{Type: "document", Relation: "commenter"},
+				)
+				require.NoError(t, err)
+				require.Equal(t, []string{"folder:1", "folder:2"}, objects[0])
+				require.Subset(t, []string{"document:3"}, objects[1])
+			})
+
+			t.Run("unknown_type", func(t *testing.T) {
+				_, err := execute(q,
+					ListObjectsTarget{Type: "document", Relation: "viewer"},
+					ListObjectsTarget{Type: "repo", Relation: "viewer"},
+				)
+				require.ErrorIs(t, err, serverErrors.TypeNotFound("repo"))
+			})
+		})
+	}
+}
+
+func TestMultiTypeObjects(t *testing.T) {
+	objects := newMultiTypeObjects([]ListObjectsTarget{{MaxResults: 2}, {}})
+	require.True(t, objects.add(0, "a"))
+	require.False(t, objects.add(0, "a"))
+	require.True(t, objects.add(1, "a"))
+	require.True(t, objects.add(0, "b"))
+	require.False(t, objects.add(0, "c"))
+	require.False(t, objects.done())
+
+	objects = newMultiTypeObjects([]ListObjectsTarget{{MaxResults: 1}, {MaxResults: 1}})
+	require.True(t, objects.add(0, "a"))
+	require.False(t, objects.done())
+	require.True(t, objects.add(1, "a"))
+	require.True(t, objects.done())
+
+	evaluationErr := errors.New("evaluation failed")
+	objects = newMultiTypeObjects([]ListObjectsTarget{{MaxResults: 1}, {MaxResults: 2}, {}})
+	require.NoError(t, objects.err())
+	objects.addError(0, evaluationErr)
+	objects.addError(2, evaluationErr)
+	require.ErrorIs(t, objects.err(), evaluationErr)
+	require.True(t, objects.add(0, "a"))
+	require.NoError(t, objects.err())
+	objects.addError(1, evaluationErr)
+	require.True(t, objects.add(1, "a"))
+	require.ErrorIs(t, objects.err(), evaluationErr)
+}
+
+func requireValidationError(t *testing.T, err error) {
+	t.Helper()
+	require.Error(t, err)
+	require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+}
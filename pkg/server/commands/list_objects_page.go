@@ -0,0 +1,339 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/concurrency"
+	"github.com/openfga/openfga/internal/condition"
+	openfgaErrors "github.com/openfga/openfga/internal/errors"
+	"github.com/openfga/openfga/internal/graph"
+	"github.com/openfga/openfga/internal/validation"
+	"github.com/openfga/openfga/pkg/encoder"
+	"github.com/openfga/openfga/pkg/server/commands/reverseexpand"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// ListObjectsPage is a page of the objects of a ListObjects query, sorted by object.
+type ListObjectsPage struct {
+	Objects []string
+
+	// ContinuationToken resumes the query after the last object of the page. It is empty
+	// on the last page.
+	ContinuationToken string
+
+	ResolutionMetadata *ListObjectsResolutionMetadata
+}
+
+// listObjectsPageToken is the content of the continuation token of a page.
+type listObjectsPageToken struct {
+	// ModelID is the ID of the authorization model the page was evaluated with. The following
+	// pages are evaluated with the same model so that they are consistent with each other.
+	ModelID string `json:"model_id"`
+
+	// After is the last object of the previous page.
+	After string `json:"after"`
+}
+
+func decodeListObjectsPageToken(e encoder.Encoder, continuationToken string) (*listObjectsPageToken, error) {
+	decoded, err := e.Decode(continuationToken)
+	if err != nil {
+		return nil, serverErrors.ErrInvalidContinuationToken
+	}
+
+	var token listObjectsPageToken
+	if err := json.Unmarshal(decoded, &token); err != nil || token.ModelID == "" || token.After == "" {
+		return nil, serverErrors.ErrInvalidContinuationToken
+	}
+	return &token, nil
+}
+
+// objectsOf returns whether the objects of the token are of objectType.
+func (t *listObjectsPageToken) objectsOf(objectType string) bool {
+	return strings.HasPrefix(t.After, objectType+":")
+}
+
+// ListObjectsPageTokenModelID returns the ID of the authorization model the continuation token
+// of a page returned by ExecutePage was issued for, which the following pages must be evaluated with.
+func ListObjectsPageTokenModelID(e encoder.Encoder, continuationToken string) (string, error) {
+	token, err := decodeListObjectsPageToken(e, continuationToken)
+	if err != nil {
+		return "", err
+	}
+	return token.ModelID, nil
+}
+
+// ExecutePage executes the ListObjectsQuery, returning the pageSize first objects, in object order,
+// that follow the continuation token of the previous page. If pageSize is 0 all the objects are returned.
+//
+// The candidate objects are yielded in order by [reverseexpand.ReverseExpandQuery.ExecuteSorted], from
+// the last object of the previous page, and checked when needed, until the page has one more object
+// than pageSize, which tells that another page follows. A page thus only resolves the objects up to
+// its last one, rather than the whole query. The pipeline, which does not yield the objects in order,
+// is not used, and the value of q.listObjectsMaxResults is ignored.
+//
+// If q.listObjectsDeadline is hit first, the page has the objects found until then, and its continuation
+// token resumes the query after the last candidate object resolved, so that a query which cannot be
+// resolved within the deadline is still walked through, page after page. The page only fails with
+// ErrRequestDeadlineExceeded if it did not resolve any candidate object.
+func (q *ListObjectsQuery) ExecutePage(
+	ctx context.Context,
+	req *openfgav1.ListObjectsRequest,
+	pageSize uint32,
+	continuationToken string,
+) (*ListObjectsPage, error) {
+	typesys, ok := typesystem.TypesystemFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: typesystem missing in context", openfgaErrors.ErrUnknown)
+	}
+
+	if !typesystem.IsSchemaVersionSupported(typesys.GetSchemaVersion()) {
+		return nil, serverErrors.ValidationError(typesystem.ErrInvalidSchemaVersion)
+	}
+
+	for _, ctxTuple := range req.GetContextualTuples().GetTupleKeys() {
+		if err := validation.ValidateTupleForWrite(typesys, ctxTuple); err != nil {
+			return nil, serverErrors.HandleTupleValidateError(err)
+		}
+	}
+
+	token := &listObjectsPageToken{ModelID: typesys.GetAuthorizationModelID()}
+	if continuationToken != "" {
+		decoded, err := decodeListObjectsPageToken(q.encoder, continuationToken)
+		if err != nil {
+			return nil, err
+		}
+
+		token = decoded
+		if token.ModelID != typesys.GetAuthorizationModelID() || !token.objectsOf(req.GetType()) {
+			return nil, serverErrors.ErrInvalidContinuationToken
+		}
+	}
+
+	_, err := typesys.GetRelation(req.GetType(), req.GetRelation())
+	if err != nil {
+		if errors.Is(err, typesystem.ErrObjectTypeUndefined) {
+			return nil, serverErrors.TypeNotFound(req.GetType())
+		}
+
+		if errors.Is(err, typesystem.ErrRelationUndefined) {
+			return nil, serverErrors.RelationNotFound(req.GetRelation(), req.GetType(), nil)
+		}
+
+		return nil, serverErrors.HandleError("", err)
+	}
+
+	if err := validation.ValidateUser(typesys, req.GetUser()); err != nil {
+		return nil, serverErrors.ValidationError(fmt.Errorf("invalid 'user' value: %w", err))
+	}
+
+	pageCtx := ctx
+	if q.listObjectsDeadline != 0 {
+		var cancel context.CancelFunc
+		pageCtx, cancel = context.WithTimeout(ctx, q.listObjectsDeadline)
+		defer cancel()
+	}
+
+	var resolutionMetadata ListObjectsResolutionMetadata
+	reverseExpandResolutionMetadata := reverseexpand.NewResolutionMetadata()
+
+	ds := q.requestStorage(req)
+	candidates := q.newReverseExpandQuery(ds, typesys).ExecuteSorted(pageCtx, &reverseexpand.ReverseExpandRequest{
+		StoreID:          req.GetStoreId(),
+		ObjectType:       req.GetType(),
+		Relation:         req.GetRelation(),
+		User:             reverseExpandUser(req.GetUser()),
+		ContextualTuples: req.GetContextualTuples().GetTupleKeys(),
+		Context:          req.GetContext(),
+		Consistency:      req.GetConsistency(),
+	}, token.After, reverseExpandResolutionMetadata)
+
+	var limit int
+	if pageSize > 0 {
+		limit = int(pageSize) + 1 // one more object tells whether another page follows
+	}
+
+	var objects []string
+	full := func() bool { return limit > 0 && len(objects) == limit }
+	last := token.After // the last candidate object resolved
+	resolved := false   // whether every candidate object was resolved
+
+	var batch []*reverseexpand.ReverseExpandResult
+	resolveBatch := func() error {
+		allowed, err := q.checkCandidates(pageCtx, typesys, req, batch, &resolutionMetadata)
+		if err != nil {
+			return err
+		}
+
+		for i, ok := range allowed {
+			last = batch[i].Object
+			if ok {
+				objects = append(objects, batch[i].Object)
+				if full() {
+					break
+				}
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	var evaluationErr error
+	for candidate, err := range candidates {
+		if err != nil {
+			evaluationErr = err
+			break
+		}
+
+		batch = append(batch, candidate)
+
+		// The batches are checked concurrently, but not beyond the objects that the page needs.
+		batchSize := max(int(q.resolveNodeBreadthLimit), 1)
+		if limit > 0 {
+			batchSize = min(batchSize, limit-len(objects))
+		}
+		if len(batch) < batchSize {
+			continue
+		}
+
+		if err := resolveBatch(); err != nil {
+			return nil, err
+		}
+		if full() || pageCtx.Err() != nil {
+			break
+		}
+	}
+
+	if evaluationErr == nil && !full() && pageCtx.Err() == nil {
+		if err := resolveBatch(); err != nil {
+			return nil, err
+		}
+		resolved = pageCtx.Err() == nil
+	}
+
+	resolutionMetadata.DispatchCounter.Add(reverseExpandResolutionMetadata.DispatchCounter.Load())
+	if reverseExpandResolutionMetadata.DispatchThrottled.Load() {
+		resolutionMetadata.DispatchThrottled.Store(true)
+	}
+	resolutionMetadata.WasWeightedGraphUsed.Store(reverseExpandResolutionMetadata.WasWeightedGraphUsed.Load())
+	dsMeta := ds.GetMetadata()
+	resolutionMetadata.DatastoreQueryCount.Add(dsMeta.DatastoreQueryCount)
+	resolutionMetadata.DatastoreItemCount.Add(dsMeta.DatastoreItemCount)
+	resolutionMetadata.DatastoreThrottled.Store(dsMeta.WasThrottled)
+
+	if ctx.Err() != nil {
+		return nil, serverErrors.ErrRequestCancelled
+	}
+
+	if evaluationErr != nil && pageCtx.Err() == nil {
+		switch {
+		case errors.Is(evaluationErr, graph.ErrResolutionDepthExceeded):
+			return nil, serverErrors.ErrAuthorizationModelResolutionTooComplex
+		case errors.Is(evaluationErr, condition.ErrEvaluationFailed):
+			return nil, serverErrors.ValidationError(evaluationErr)
+		default:
+			return nil, serverErrors.HandleError("", evaluationErr)
+		}
+	}
+
+	page := &ListObjectsPage{
+		Objects:            objects,
+		ResolutionMetadata: &resolutionMetadata,
+	}
+
+	var next *listObjectsPageToken
+	switch {
+	case full():
+		page.Objects = objects[:pageSize]
+		next = &listObjectsPageToken{
+			ModelID: token.ModelID,
+			After:   page.Objects[pageSize-1],
+		}
+	case !resolved:
+		// The deadline was hit: the following page resumes after the last object resolved.
+		if last == token.After {
+			return nil, serverErrors.ErrRequestDeadlineExceeded
+		}
+		next = &listObjectsPageToken{
+			ModelID: token.ModelID,
+			After:   last,
+		}
+	default:
+		return page, nil
+	}
+
+	b, err := json.Marshal(next)
+	if err != nil {
+		return nil, serverErrors.HandleError("", err)
+	}
+
+	page.ContinuationToken, err = q.encoder.Encode(b)
+	if err != nil {
+		return nil, serverErrors.HandleError("", err)
+	}
+
+	return page, nil
+}
+
+// checkCandidates returns whether the user of req has the relation of req with each of the candidate
+// objects, checking concurrently the candidates that require it. It returns fewer results than
+// candidates if ctx is done first: the results of the first candidates, up to the first candidate
+// whose check did not complete.
+func (q *ListObjectsQuery) checkCandidates(
+	ctx context.Context,
+	typesys *typesystem.TypeSystem,
+	req listObjectsRequest,
+	candidates []*reverseexpand.ReverseExpandResult,
+	resolutionMetadata *ListObjectsResolutionMetadata,
+) ([]bool, error) {
+	allowed := make([]bool, len(candidates))
+	checked := make([]bool, len(candidates))
+
+	pool := concurrency.NewPool(ctx, int(q.resolveNodeBreadthLimit))
+	for i, candidate := range candidates {
+		if candidate.ResultStatus == reverseexpand.NoFurtherEvalStatus {
+			noFurtherEvalRequiredCounter.Inc()
+			allowed[i], checked[i] = true, true
+			continue
+		}
+
+		furtherEvalRequiredCounter.Inc()
+
+		pool.Go(func(ctx context.Context) error {
+			ok, err := q.checkObject(ctx, typesys, req, candidate.Object, resolutionMetadata)
+			if err != nil {
+				if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+					return nil
+				}
+				return err
+			}
+			allowed[i], checked[i] = ok, true
+			return nil
+		})
+	}
+
+	if err := pool.Wait(); err != nil {
+		if errors.Is(err, graph.ErrResolutionDepthExceeded) {
+			return nil, serverErrors.ErrAuthorizationModelResolutionTooComplex
+		}
+
+		if errors.Is(err, condition.ErrEvaluationFailed) {
+			return nil, serverErrors.ValidationError(err)
+		}
+
+		return nil, serverErrors.HandleError("", err)
+	}
+
+	for i := range candidates {
+		if !checked[i] {
+			return allowed[:i], nil
+		}
+	}
+	return allowed, nil
+}
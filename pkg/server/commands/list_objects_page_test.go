@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/graph"
+	"github.com/openfga/openfga/pkg/encoder"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	storagetest "github.com/openfga/openfga/pkg/storage/test"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+func TestListObjectsExecutePage(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	var tuples []string
+	var expected []string
+	for i := 1; i <= 7; i++ {
+		object := "document:" + strconv.Itoa(i)
+		tuples = append(tuples, object+"#viewer@user:jon")
+		if i != 4 {
+			tuples = append(tuples, object+"#allowed@user:jon")
+			expected = append(expected, object)
+		}
+	}
+
+	storeID, model := storagetest.BootstrapFGAStore(t, ds, `
+		model
+			schema 1.1
+
+		type user
+
+		type document
+			relations
+				define allowed: [user]
+				define viewer: [user] and allowed
+	`, tuples)
+	ts, err := typesystem.NewAndValidate(context.Background(), model)
+	require.NoError(t, err)
+	ctx := typesystem.ContextWithTypesystem(context.Background(), ts)
+
+	checker, checkResolverCloser, err := graph.NewOrderedCheckResolvers().Build()
+	require.NoError(t, err)
+	t.Cleanup(checkResolverCloser)
+
+	req := &openfgav1.ListObjectsRequest{
+		StoreId:  storeID,
+		Type:     "document",
+		Relation: "viewer",
+		User:     "user:jon",
+	}
+
+	for _, pipelineEnabled := range []bool{false, true} {
+		t.Run("pipeline_"+strconv.FormatBool(pipelineEnabled), func(t *testing.T) {
+			q, err := NewListObjectsQuery(ds, checker, storeID, WithListObjectsPipelineEnabled(pipelineEnabled))
+			require.NoError(t, err)
+
+			var objects []string
+			var pages int
+			var token string
+			for {
+				page, err := q.ExecutePage(ctx, req, 4, token)
+				require.NoError(t, err)
+				objects = append(objects, page.Objects...)
+				pages++
+
+				if page.ContinuationToken == "" {
+					break
+				}
+				require.Len(t, page.Objects, 4)
+
+				modelID, err := ListObjectsPageTokenModelID(encoder.NewBase64Encoder(), page.ContinuationToken)
+				require.NoError(t, err)
+				require.Equal(t, ts.GetAuthorizationModelID(), modelID)
+				token = page.ContinuationToken
+			}
+			require.Equal(t, expected, objects)
+			require.Equal(t, 2, pages)
+
+			page, err := q.ExecutePage(ctx, req, 0, "")
+			require.NoError(t, err)
+			require.Equal(t, expected, page.Objects)
+			require.Empty(t, page.ContinuationToken)
+		})
+	}
+
+	t.Run("invalid_token", func(t *testing.T) {
+		q, err := NewListObjectsQuery(ds, checker, storeID)
+		require.NoError(t, err)
+
+		_, err = q.ExecutePage(ctx, req, 2, "invalid")
+		require.ErrorIs(t, err, serverErrors.ErrInvalidContinuationToken)
+
+		page, err := q.ExecutePage(ctx, req, 2, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, page.ContinuationToken)
+
+		_, err = q.ExecutePage(ctx, &openfgav1.ListObjectsRequest{
+			StoreId:  storeID,
+			Type:     "user",
+			Relation: "viewer",
+			User:     "user:jon",
+		}, 2, page.ContinuationToken)
+		require.ErrorIs(t, err, serverErrors.ErrInvalidContinuationToken)
+	})
+}
+
+func TestListObjectsExecuteIncompletePage(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	var tuples []string
+	for i := 1; i <= 7; i++ {
+		object := "document:" + strconv.Itoa(i)
+		tuples = append(tuples, object+"#viewer@user:jon", object+"#allowed@user:jon")
+	}
+
+	storeID, model := storagetest.BootstrapFGAStore(t, ds, `
+		model
+			schema 1.1
+
+		type user
+
+		type document
+			relations
+				define allowed: [user]
+				define viewer: [user] and allowed
+	`, tuples)
+	ts, err := typesystem.NewAndValidate(context.Background(), model)
+	require.NoError(t, err)
+	ctx := typesystem.ContextWithTypesystem(context.Background(), ts)
+
+	checker, checkResolverCloser, err := graph.NewOrderedCheckResolvers().Build()
+	require.NoError(t, err)
+	t.Cleanup(checkResolverCloser)
+
+	// The check of document:6 does not complete before the deadline while it is blocked.
+	blocking := &blockingCheckResolver{CheckResolver: checker, object: "document:6"}
+	blocking.blocked.Store(true)
+
+	q, err := NewListObjectsQuery(ds, blocking, storeID, WithListObjectsDeadline(200*time.Millisecond))
+	require.NoError(t, err)
+
+	req := &openfgav1.ListObjectsRequest{
+		StoreId:  storeID,
+		Type:     "document",
+		Relation: "viewer",
+		User:     "user:jon",
+	}
+
+	// The first page stops before the blocked check.
+	page, err := q.ExecutePage(ctx, req, 4, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"document:1", "document:2", "document:3", "document:4"}, page.Objects)
+	require.NotEmpty(t, page.ContinuationToken)
+
+	// The second page has the objects resolved before the deadline, and resumes after them.
+	page, err = q.ExecutePage(ctx, req, 4, page.ContinuationToken)
+	require.NoError(t, err)
+	require.Equal(t, []string{"document:5"}, page.Objects)
+	require.NotEmpty(t, page.ContinuationToken)
+
+	// A page that resolves no object before the deadline fails.
+	_, err = q.ExecutePage(ctx, req, 4, page.ContinuationToken)
+	require.ErrorIs(t, err, serverErrors.ErrRequestDeadlineExceeded)
+
+	blocking.blocked.Store(false)
+	page, err = q.ExecutePage(ctx, req, 4, page.ContinuationToken)
+	require.NoError(t, err)
+	require.Equal(t, []string{"document:6", "document:7"}, page.Objects)
+	require.Empty(t, page.ContinuationToken)
+}
+
+// blockingCheckResolver blocks the checks of object until their context is done while blocked is set.
+type blockingCheckResolver struct {
+	graph.CheckResolver
+	object  string
+	blocked atomic.Bool
+}
+
+func (r *blockingCheckResolver) ResolveCheck(ctx context.Context, req *graph.ResolveCheckRequest) (*graph.ResolveCheckResponse, error) {
+	if r.blocked.Load() && req.GetTupleKey().GetObject() == r.object {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return r.CheckResolver.ResolveCheck(ctx, req)
+}
@@ -0,0 +1,279 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/cachecontroller"
+	"github.com/openfga/openfga/internal/concurrency"
+	"github.com/openfga/openfga/internal/graph"
+	"github.com/openfga/openfga/internal/shared"
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	"github.com/openfga/openfga/internal/validation"
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/server/config"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/storagewrappers"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// ListRelationsQuery resolves which relations of an object's type a user has with the object.
+//
+// Unlike a BatchCheck with one check per relation, the relations are resolved against a single
+// request storage, so that the reads of the sub-problems that the relations have in common go
+// through the same iterator cache and contextual tuples, and with a single check resolver, whose
+// RequestScopedCheckResolver shares the resolved sub-problems across relations.
+type ListRelationsQuery struct {
+	logger                     logger.Logger
+	checkResolver              graph.CheckResolver
+	typesys                    *typesystem.TypeSystem
+	datastore                  storage.RelationshipTupleReader
+	sharedCheckResources       *shared.SharedDatastoreResources
+	cacheSettings              config.CacheSettings
+	maxConcurrentReads         uint32
+	maxConcurrentChecks        uint32
+	datastoreThrottlingEnabled bool
+	datastoreThrottleThreshold int
+	datastoreThrottleDuration  time.Duration
+}
+
+type ListRelationsCommandParams struct {
+	StoreID string
+	Object  string
+	User    string
+	// Relations are the relations to resolve, all the relations of the object's type if empty.
+	Relations        []string
+	ContextualTuples *openfgav1.ContextualTupleKeys
+	Context          *structpb.Struct
+	Consistency      openfgav1.ConsistencyPreference
+}
+
+type ListRelationsResponse struct {
+	// Relations are the relations the user has with the object, in the order they were requested.
+	Relations []string
+
+	// Errors are the errors of the relations that could not be resolved, by relation. Like the
+	// errors of a BatchCheck, they do not fail the request.
+	Errors map[string]error
+
+	DatastoreQueryCount uint32
+	DatastoreItemCount  uint64
+	DispatchCount       uint32
+	DispatchThrottled   bool
+	DatastoreThrottled  bool
+}
+
+type ListRelationsQueryOption func(*ListRelationsQuery)
+
+func WithListRelationsCommandLogger(l logger.Logger) ListRelationsQueryOption {
+	return func(q *ListRelationsQuery) {
+		q.logger = l
+	}
+}
+
+func WithListRelationsCommandCache(sharedCheckResources *shared.SharedDatastoreResources, cacheSettings config.CacheSettings) ListRelationsQueryOption {
+	return func(q *ListRelationsQuery) {
+		q.sharedCheckResources = sharedCheckResources
+		q.cacheSettings = cacheSettings
+	}
+}
+
+func WithListRelationsMaxConcurrentReads(m uint32) ListRelationsQueryOption {
+	return func(q *ListRelationsQuery) {
+		q.maxConcurrentReads = m
+	}
+}
+
+// WithListRelationsMaxConcurrentChecks sets the maximum number of relations resolved concurrently.
+func WithListRelationsMaxConcurrentChecks(m uint32) ListRelationsQueryOption {
+	return func(q *ListRelationsQuery) {
+		q.maxConcurrentChecks = m
+	}
+}
+
+func WithListRelationsDatastoreThrottler(enabled bool, threshold int, duration time.Duration) ListRelationsQueryOption {
+	return func(q *ListRelationsQuery) {
+		q.datastoreThrottlingEnabled = enabled
+		q.datastoreThrottleThreshold = threshold
+		q.datastoreThrottleDuration = duration
+	}
+}
+
+func NewListRelationsCommand(datastore storage.RelationshipTupleReader, checkResolver graph.CheckResolver, typesys *typesystem.TypeSystem, opts ...ListRelationsQueryOption) *ListRelationsQuery {
+	cmd := &ListRelationsQuery{
+		logger:              logger.NewNoopLogger(),
+		datastore:           datastore,
+		checkResolver:       checkResolver,
+		typesys:             typesys,
+		maxConcurrentReads:  defaultMaxConcurrentReadsForCheck,
+		maxConcurrentChecks: config.DefaultMaxConcurrentChecksPerBatchCheck,
+		cacheSettings:       config.NewDefaultCacheSettings(),
+		sharedCheckResources: &shared.SharedDatastoreResources{
+			CacheController: cachecontroller.NewNoopCacheController(),
+		},
+	}
+
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
+}
+
+// ObjectTypeRelations returns the names of the relations of objectType, sorted.
+func ObjectTypeRelations(typesys *typesystem.TypeSystem, objectType string) ([]string, error) {
+	relations, err := typesys.GetRelations(objectType)
+	if err != nil {
+		return nil, &InvalidRelationError{Cause: err}
+	}
+
+	names := make([]string, 0, len(relations))
+	for name := range relations {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (q *ListRelationsQuery) Execute(ctx context.Context, params *ListRelationsCommandParams) (*ListRelationsResponse, error) {
+	relations := params.Relations
+	if len(relations) == 0 {
+		var err error
+		relations, err = ObjectTypeRelations(q.typesys, tuple.GetType(params.Object))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		relations = deduplicateRelations(relations)
+	}
+
+	for _, relation := range relations {
+		tk := tuple.NewTupleKey(params.Object, relation, params.User)
+		if err := validation.ValidateUserObjectRelation(q.typesys, tk); err != nil {
+			return nil, &InvalidRelationError{Cause: err}
+		}
+	}
+
+	for _, ctxTuple := range params.ContextualTuples.GetTupleKeys() {
+		if err := validation.ValidateTupleForWrite(q.typesys, ctxTuple); err != nil {
+			return nil, &InvalidTupleError{Cause: err}
+		}
+	}
+
+	cacheInvalidationTime := time.Time{}
+
+	if params.Consistency != openfgav1.ConsistencyPreference_HIGHER_CONSISTENCY {
+		cacheInvalidationTime = q.sharedCheckResources.CacheController.DetermineInvalidationTime(ctx, params.StoreID)
+	}
+
+	// The same request storage serves every relation, like it serves every sub-problem of a Check.
+	datastoreWithTupleCache := storagewrappers.NewRequestStorageWrapperWithCache(
+		q.datastore,
+		params.ContextualTuples.GetTupleKeys(),
+		&storagewrappers.Operation{
+			Method:            apimethod.Check,
+			Concurrency:       q.maxConcurrentReads,
+			ThrottlingEnabled: q.datastoreThrottlingEnabled,
+			ThrottleThreshold: q.datastoreThrottleThreshold,
+			ThrottleDuration:  q.datastoreThrottleDuration,
+		},
+		storagewrappers.DataResourceConfiguration{
+			Resources:      q.sharedCheckResources,
+			CacheSettings:  q.cacheSettings,
+			UseShadowCache: false,
+		},
+	)
+
+	ctx = typesystem.ContextWithTypesystem(ctx, q.typesys)
+	ctx = storage.ContextWithRelationshipTupleReader(ctx, datastoreWithTupleCache)
+
+	allowed := make([]bool, len(relations))
+	errs := make([]error, len(relations))
+	var dispatchCount atomic.Uint32
+	var dispatchThrottled atomic.Bool
+
+	pool := concurrency.NewPool(ctx, int(max(q.maxConcurrentChecks, 1)))
+	for i, relation := range relations {
+		pool.Go(func(ctx context.Context) error {
+			req, err := graph.NewResolveCheckRequest(graph.ResolveCheckRequestParams{
+				StoreID:                   params.StoreID,
+				TupleKey:                  tuple.NewTupleKey(params.Object, relation, params.User),
+				Context:                   params.Context,
+				ContextualTuples:          params.ContextualTuples.GetTupleKeys(),
+				Consistency:               params.Consistency,
+				LastCacheInvalidationTime: cacheInvalidationTime,
+				AuthorizationModelID:      q.typesys.GetAuthorizationModelID(),
+			})
+			if err != nil {
+				errs[i] = err
+				return nil
+			}
+
+			resp, err := q.checkResolver.ResolveCheck(ctx, req)
+			dispatchCount.Add(req.GetRequestMetadata().DispatchCounter.Load())
+			if req.GetRequestMetadata().DispatchThrottled.Load() {
+				dispatchThrottled.Store(true)
+			}
+			if err != nil {
+				errs[i] = err
+				return nil
+			}
+
+			allowed[i] = resp.GetAllowed()
+			return nil
+		})
+	}
+	_ = pool.Wait()
+
+	dsMeta := datastoreWithTupleCache.GetMetadata()
+	resp := &ListRelationsResponse{
+		DatastoreQueryCount: dsMeta.DatastoreQueryCount,
+		DatastoreItemCount:  dsMeta.DatastoreItemCount,
+		DispatchCount:       dispatchCount.Load(),
+		DispatchThrottled:   dispatchThrottled.Load(),
+		DatastoreThrottled:  dsMeta.WasThrottled,
+	}
+
+	// There are currently two possible throttling mechanisms, we need to know if either was triggered here.
+	wasThrottled := resp.DatastoreThrottled || resp.DispatchThrottled
+
+	for i, relation := range relations {
+		if err := errs[i]; err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && wasThrottled {
+				err = &ThrottledError{Cause: err}
+			}
+			if resp.Errors == nil {
+				resp.Errors = make(map[string]error)
+			}
+			resp.Errors[relation] = err
+			continue
+		}
+
+		if allowed[i] {
+			resp.Relations = append(resp.Relations, relation)
+		}
+	}
+
+	return resp, nil
+}
+
+// deduplicateRelations returns relations without the repeated relations, in order.
+func deduplicateRelations(relations []string) []string {
+	seen := make(map[string]struct{}, len(relations))
+	deduplicated := make([]string, 0, len(relations))
+	for _, relation := range relations {
+		if _, ok := seen[relation]; ok {
+			continue
+		}
+		seen[relation] = struct{}{}
+		deduplicated = append(deduplicated, relation)
+	}
+	return deduplicated
+}
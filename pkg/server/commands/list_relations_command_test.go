@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/condition"
+	"github.com/openfga/openfga/internal/graph"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	storagetest "github.com/openfga/openfga/pkg/storage/test"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+func TestListRelationsCommand(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	storeID, model := storagetest.BootstrapFGAStore(t, ds, `
+		model
+			schema 1.1
+
+		type user
+
+		type folder
+			relations
+				define viewer: [user]
+
+		type document
+			relations
+				define parent: [folder]
+				define owner: [user]
+				define editor: [user] or owner
+				define viewer: editor or viewer from parent
+				define commenter: [user with in_office_hours]
+
+		condition in_office_hours(hour: int) {
+			hour >= 9 && hour < 17
+		}
+	`, []string{
+		"document:1#parent@folder:x",
+		"document:1#owner@user:anne",
+		"folder:x#viewer@user:bob",
+	})
+	require.NoError(t, ds.Write(context.Background(), storeID, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKeyWithCondition("document:1", "commenter", "user:bob", "in_office_hours", nil),
+	}))
+	ts, err := typesystem.NewAndValidate(context.Background(), model)
+	require.NoError(t, err)
+
+	checker, checkResolverCloser, err := graph.NewOrderedCheckResolvers(graph.WithRequestScopedCheckResolverEnabled(true)).Build()
+	require.NoError(t, err)
+	t.Cleanup(checkResolverCloser)
+
+	cmd := NewListRelationsCommand(ds, checker, ts)
+
+	t.Run("all_relations", func(t *testing.T) {
+		resp, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID: storeID,
+			Object:  "document:1",
+			User:    "user:anne",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"editor", "owner", "viewer"}, resp.Relations)
+		require.NotZero(t, resp.DatastoreQueryCount)
+	})
+
+	t.Run("requested_relations_in_order", func(t *testing.T) {
+		resp, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID:   storeID,
+			Object:    "document:1",
+			User:      "user:anne",
+			Relations: []string{"viewer", "parent", "owner", "viewer"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"viewer", "owner"}, resp.Relations)
+	})
+
+	t.Run("condition_context", func(t *testing.T) {
+		resp, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID: storeID,
+			Object:  "document:1",
+			User:    "user:bob",
+			Context: &structpb.Struct{Fields: map[string]*structpb.Value{"hour": structpb.NewNumberValue(10)}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"commenter", "viewer"}, resp.Relations)
+	})
+
+	t.Run("missing_condition_context", func(t *testing.T) {
+		resp, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID:   storeID,
+			Object:    "document:1",
+			User:      "user:bob",
+			Relations: []string{"commenter", "viewer"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"viewer"}, resp.Relations)
+		require.Len(t, resp.Errors, 1)
+		require.ErrorIs(t, resp.Errors["commenter"], condition.ErrEvaluationFailed)
+	})
+
+	t.Run("contextual_tuples", func(t *testing.T) {
+		resp, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID: storeID,
+			Object:  "document:1",
+			User:    "user:carl",
+			ContextualTuples: &openfgav1.ContextualTupleKeys{TupleKeys: []*openfgav1.TupleKey{
+				tuple.NewTupleKey("document:1", "editor", "user:carl"),
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"editor", "viewer"}, resp.Relations)
+	})
+
+	t.Run("unknown_relation", func(t *testing.T) {
+		_, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID:   storeID,
+			Object:    "document:1",
+			User:      "user:anne",
+			Relations: []string{"admin"},
+		})
+		var invalidRelationError *InvalidRelationError
+		require.ErrorAs(t, err, &invalidRelationError)
+	})
+
+	t.Run("unknown_type", func(t *testing.T) {
+		_, err := cmd.Execute(context.Background(), &ListRelationsCommandParams{
+			StoreID: storeID,
+			Object:  "repo:1",
+			User:    "user:anne",
+		})
+		var invalidRelationError *InvalidRelationError
+		require.ErrorAs(t, err, &invalidRelationError)
+	})
+}
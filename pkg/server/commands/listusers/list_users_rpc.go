@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/openfga/openfga/internal/validation"
 	"github.com/openfga/openfga/pkg/logger"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/storagewrappers"
 	"github.com/openfga/openfga/pkg/telemetry"
@@ -204,6 +206,93 @@ func (l *listUsersQuery) ListUsers(
 	))
 	defer span.End()
 
+	foundUsersUnique := make(map[tuple.UserString]foundUser, 1000)
+	metadata, err := l.expandUsers(ctx, req, l.buildResultsChannel(), func(foundUser foundUser) bool {
+		foundUsersUnique[tuple.UserProtoToString(foundUser.user)] = foundUser
+
+		if l.maxResults > 0 {
+			if uint32(len(foundUsersUnique)) >= l.maxResults {
+				span.SetAttributes(attribute.Bool("max_results_found", true))
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundUsers := make([]*openfgav1.User, 0, len(foundUsersUnique))
+	for foundUserKey, foundUser := range foundUsersUnique {
+		if foundUser.relationshipStatus == NoRelationship {
+			continue
+		}
+
+		foundUsers = append(foundUsers, tuple.StringToUserProto(foundUserKey))
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(foundUsers)))
+
+	return &listUsersResponse{
+		Users:    foundUsers,
+		Metadata: metadata,
+	}, nil
+}
+
+// StreamedListUsers sends the users of req to srv one by one, as soon as they are found. It ignores
+// the value of l.maxResults and sends all the users until l.deadline is hit. Like ListUsers, it
+// assumes that the typesystem is in the context and that the request is valid.
+func (l *listUsersQuery) StreamedListUsers(
+	ctx context.Context,
+	req *openfgav1.ListUsersRequest,
+	srv grpc.ServerStreamingServer[queryv1.StreamedListUsersResponse],
+) (*listUsersResponseMetadata, error) {
+	ctx, span := tracer.Start(ctx, "StreamedListUsers", trace.WithAttributes(
+		attribute.String("store_id", req.GetStoreId()),
+	))
+	defer span.End()
+
+	var sendErr error
+	sentUsers := make(map[tuple.UserString]struct{}, 1000)
+	foundUsersCh := make(chan foundUser, serverconfig.DefaultListUsersMaxResults)
+	metadata, err := l.expandUsers(ctx, req, foundUsersCh, func(foundUser foundUser) bool {
+		if foundUser.relationshipStatus == NoRelationship {
+			return true
+		}
+
+		userKey := tuple.UserProtoToString(foundUser.user)
+		if _, sent := sentUsers[userKey]; sent {
+			return true
+		}
+		sentUsers[userKey] = struct{}{}
+
+		sendErr = srv.Send(&queryv1.StreamedListUsersResponse{User: foundUser.user})
+		return sendErr == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sendErr != nil {
+		telemetry.TraceError(span, sendErr)
+		return nil, sendErr
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(sentUsers)))
+
+	return &metadata, nil
+}
+
+// expandUsers expands the users of req, passing the ones found through foundUsersCh to onFoundUser
+// until it returns false, every user is found or l.deadline is hit. A user may be passed more than
+// once, and users that were found not to have the relation are passed with NoRelationship.
+func (l *listUsersQuery) expandUsers(
+	ctx context.Context,
+	req *openfgav1.ListUsersRequest,
+	foundUsersCh chan foundUser,
+	onFoundUser func(foundUser) bool,
+) (listUsersResponseMetadata, error) {
+	span := trace.SpanFromContext(ctx)
+
 	cancellableCtx, cancelCtx := context.WithCancel(ctx)
 	if l.deadline != 0 {
 		cancellableCtx, cancelCtx = context.WithTimeout(cancellableCtx, l.deadline)
@@ -213,7 +302,7 @@ func (l *listUsersQuery) ListUsers(
 
 	typesys, ok := typesystem.TypesystemFromContext(cancellableCtx)
 	if !ok {
-		return nil, fmt.Errorf("%w: typesystem missing in context", openfgaErrors.ErrUnknown)
+		return listUsersResponseMetadata{}, fmt.Errorf("%w: typesystem missing in context", openfgaErrors.ErrUnknown)
 	}
 
 	userFilter := req.GetUserFilters()[0]
@@ -222,38 +311,27 @@ func (l *listUsersQuery) ListUsers(
 	if !tuple.UsersetMatchTypeAndRelation(userset, userFilter.GetRelation(), userFilter.GetType()) {
 		hasPossibleEdges, err := doesHavePossibleEdges(typesys, req)
 		if err != nil {
-			return nil, err
+			return listUsersResponseMetadata{}, err
 		}
 		if !hasPossibleEdges {
 			span.SetAttributes(attribute.Bool("no_possible_edges", true))
-			return &listUsersResponse{
-				Users: []*openfgav1.User{},
-				Metadata: listUsersResponseMetadata{
-					DispatchCounter:       new(atomic.Uint32),
-					WasDispatchThrottled:  new(atomic.Bool),
-					WasDatastoreThrottled: new(atomic.Bool),
-				},
+			return listUsersResponseMetadata{
+				DispatchCounter:       new(atomic.Uint32),
+				WasDispatchThrottled:  new(atomic.Bool),
+				WasDatastoreThrottled: new(atomic.Bool),
 			}, nil
 		}
 	}
 
 	dispatchCount := atomic.Uint32{}
 
-	foundUsersCh := l.buildResultsChannel()
 	expandErrCh := make(chan error, 1)
 
-	foundUsersUnique := make(map[tuple.UserString]foundUser, 1000)
-
 	doneWithFoundUsersCh := make(chan struct{}, 1)
 	go func() {
 		for foundUser := range foundUsersCh {
-			foundUsersUnique[tuple.UserProtoToString(foundUser.user)] = foundUser
-
-			if l.maxResults > 0 {
-				if uint32(len(foundUsersUnique)) >= l.maxResults {
-					span.SetAttributes(attribute.Bool("max_results_found", true))
-					break
-				}
+			if !onFoundUser(foundUser) {
+				break
 			}
 		}
 
@@ -276,7 +354,7 @@ func (l *listUsersQuery) ListUsers(
 		break
 	case <-cancellableCtx.Done():
 		deadlineExceeded = true
-		// to avoid a race on the 'foundUsersUnique' map below, wait for the range over the channel to close
+		// to avoid a race on the state of onFoundUser, wait for the range over the channel to close
 		<-doneWithFoundUsersCh
 		break
 	}
@@ -288,35 +366,21 @@ func (l *listUsersQuery) ListUsers(
 			break
 		}
 		telemetry.TraceError(span, err)
-		return nil, err
+		return listUsersResponseMetadata{}, err
 	default:
 		break
 	}
 
 	cancelCtx()
 
-	foundUsers := make([]*openfgav1.User, 0, len(foundUsersUnique))
-	for foundUserKey, foundUser := range foundUsersUnique {
-		if foundUser.relationshipStatus == NoRelationship {
-			continue
-		}
-
-		foundUsers = append(foundUsers, tuple.StringToUserProto(foundUserKey))
-	}
-
-	span.SetAttributes(attribute.Int("result_count", len(foundUsers)))
-
 	dsMeta := l.datastore.GetMetadata()
 	l.wasDatastoreThrottled.Store(dsMeta.WasThrottled)
-	return &listUsersResponse{
-		Users: foundUsers,
-		Metadata: listUsersResponseMetadata{
-			DatastoreQueryCount:   dsMeta.DatastoreQueryCount,
-			DatastoreItemCount:    dsMeta.DatastoreItemCount,
-			DispatchCounter:       &dispatchCount,
-			WasDispatchThrottled:  l.wasDispatchThrottled,
-			WasDatastoreThrottled: l.wasDatastoreThrottled,
-		},
+	return listUsersResponseMetadata{
+		DatastoreQueryCount:   dsMeta.DatastoreQueryCount,
+		DatastoreItemCount:    dsMeta.DatastoreItemCount,
+		DispatchCounter:       &dispatchCount,
+		WasDispatchThrottled:  l.wasDispatchThrottled,
+		WasDatastoreThrottled: l.wasDatastoreThrottled,
 	}, nil
 }
 
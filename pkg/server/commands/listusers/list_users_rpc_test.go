@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 	parser "github.com/openfga/language/pkg/go/transformer"
@@ -22,6 +23,7 @@ import (
 	"github.com/openfga/openfga/pkg/dispatch"
 	"github.com/openfga/openfga/pkg/logger"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/memory"
 	"github.com/openfga/openfga/pkg/storage/storagewrappers"
@@ -4349,3 +4351,78 @@ func TestListUsersDatastoreThrottler(t *testing.T) {
 		require.False(t, resp.GetMetadata().WasDatastoreThrottled.Load(), "Should not be throttled when threshold is zero")
 	})
 }
+
+type streamedListUsersServer struct {
+	grpc.ServerStream
+
+	users   []string
+	sendErr error
+}
+
+func (s *streamedListUsersServer) Send(resp *queryv1.StreamedListUsersResponse) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.users = append(s.users, tuple.UserProtoToString(resp.GetUser()))
+	return nil
+}
+
+func TestStreamedListUsers(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	model := testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type team
+			relations
+				define member: [user]
+		type repo
+			relations
+				define blocked: [user]
+				define admin: [user, team#member]
+				define reader: ([user] or admin) but not blocked`)
+	typesys, err := typesystem.NewAndValidate(context.Background(), model)
+	require.NoError(t, err)
+	ctx := typesystem.ContextWithTypesystem(context.Background(), typesys)
+
+	storeID := ulid.Make().String()
+	require.NoError(t, ds.Write(ctx, storeID, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("repo:target", "reader", "user:1"),
+		tuple.NewTupleKey("repo:target", "admin", "user:1"),
+		tuple.NewTupleKey("repo:target", "admin", "team:core#member"),
+		tuple.NewTupleKey("team:core", "member", "user:2"),
+		tuple.NewTupleKey("team:core", "member", "user:3"),
+		tuple.NewTupleKey("repo:target", "blocked", "user:3"),
+	}))
+
+	req := &openfgav1.ListUsersRequest{
+		StoreId:              storeID,
+		AuthorizationModelId: model.GetId(),
+		Object:               &openfgav1.Object{Type: "repo", Id: "target"},
+		Relation:             "reader",
+		UserFilters:          []*openfgav1.UserTypeFilter{{Type: "user"}},
+	}
+
+	t.Run("streams_every_user_once", func(t *testing.T) {
+		srv := &streamedListUsersServer{}
+		metadata, err := NewListUsersQuery(ds, nil,
+			WithListUsersMaxResults(1),
+			WithListUsersDeadline(10*time.Second),
+		).StreamedListUsers(ctx, req, srv)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"user:1", "user:2"}, srv.users)
+		require.NotZero(t, metadata.DatastoreQueryCount)
+	})
+
+	t.Run("send_error", func(t *testing.T) {
+		srv := &streamedListUsersServer{sendErr: fmt.Errorf("client gone")}
+		_, err := NewListUsersQuery(ds, nil).StreamedListUsers(ctx, req, srv)
+		require.ErrorIs(t, err, srv.sendErr)
+	})
+}
@@ -22,6 +22,7 @@ type ReadChangesQuery struct {
 	encoder         encoder.Encoder
 	tokenSerializer encoder.ContinuationTokenSerializer
 	horizonOffset   time.Duration
+	checkHorizon    bool
 }
 
 type ReadChangesQueryOption func(*ReadChangesQuery)
@@ -45,6 +46,14 @@ func WithReadChangeQueryHorizonOffset(horizonOffset int) ReadChangesQueryOption
 	}
 }
 
+// WithReadChangesQueryCheckHorizon specifies whether reading changes from a continuation token
+// before the retention horizon of the store fails, which is only needed when the changelog is pruned.
+func WithReadChangesQueryCheckHorizon(checkHorizon bool) ReadChangesQueryOption {
+	return func(rq *ReadChangesQuery) {
+		rq.checkHorizon = checkHorizon
+	}
+}
+
 // WithContinuationTokenSerializer specifies the token serializer to be used.
 func WithContinuationTokenSerializer(tokenSerializer encoder.ContinuationTokenSerializer) ReadChangesQueryOption {
 	return func(rq *ReadChangesQuery) {
@@ -104,6 +113,8 @@ func (q *ReadChangesQuery) Execute(ctx context.Context, req *openfgav1.ReadChang
 			req.GetPageSize().GetValue(),
 			fromUlid,
 		),
+		// Reading from a start time before the retention horizon reads every change left.
+		CheckHorizon: q.checkHorizon && token != "",
 	}
 	filter := storage.ReadChangesFilter{
 		ObjectType:    req.GetType(),
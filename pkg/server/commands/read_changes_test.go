@@ -151,6 +151,45 @@ func TestReadChangesQuery(t *testing.T) {
 		require.Empty(t, resp.GetContinuationToken())
 	})
 
+	t.Run("start_time_does_not_check_changelog_horizon", func(t *testing.T) {
+		mockController := gomock.NewController(t)
+		defer mockController.Finish()
+
+		storeID := ulid.Make().String()
+		startTime, _ := time.Parse(time.RFC3339, "2021-01-01T00:00:00Z")
+		startUlid := ulid.MustNew(ulid.Timestamp(startTime), nil).String()
+		changes := []*openfgav1.TupleChange{{TupleKey: &openfgav1.TupleKey{Object: "document:1", Relation: "viewer", User: "user:jon"}}}
+
+		mockDatastore := mocks.NewMockOpenFGADatastore(mockController)
+		mockDatastore.EXPECT().ReadChanges(gomock.Any(), storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination: storage.PaginationOptions{PageSize: storage.DefaultPageSize, From: startUlid},
+		}).Return(changes, ulid.Make().String(), nil)
+
+		resp, err := NewReadChangesQuery(mockDatastore, WithReadChangesQueryCheckHorizon(true)).Execute(context.Background(), &openfgav1.ReadChangesRequest{
+			StoreId:   storeID,
+			StartTime: timestamppb.New(startTime),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetChanges(), 1)
+	})
+
+	t.Run("continuation_token_before_changelog_horizon", func(t *testing.T) {
+		mockController := gomock.NewController(t)
+		defer mockController.Finish()
+
+		mockDatastore := mocks.NewMockOpenFGADatastore(mockController)
+		mockDatastore.EXPECT().ReadChanges(gomock.Any(), gomock.Any(), gomock.Any(), storage.ReadChangesOptions{
+			Pagination:   storage.PaginationOptions{PageSize: storage.DefaultPageSize, From: "01GZBPKG92F6HTY29MBE46DWX1"},
+			CheckHorizon: true,
+		}).Return(nil, "", storage.ErrChangelogTruncated).Times(1)
+
+		_, err := NewReadChangesQuery(mockDatastore, WithReadChangesQueryCheckHorizon(true)).Execute(context.Background(), &openfgav1.ReadChangesRequest{
+			StoreId:           ulid.Make().String(),
+			ContinuationToken: "MDFHWkJQS0c5MkY2SFRZMjlNQkU0NkRXWDF8",
+		})
+		require.ErrorIs(t, err, serverErrors.ErrChangelogTruncated)
+	})
+
 	t.Run("start_time_is_invalid", func(t *testing.T) {
 		mockController := gomock.NewController(t)
 		defer mockController.Finish()
@@ -1168,6 +1168,130 @@ func (pl *Pipeline) Build(ctx context.Context, source Source, target Target) ite
 	}
 }
 
+// BuildAll is like Build for several sources that share the same target. The paths from the
+// sources share the workers of the nodes that they have in common, so that the parts of the graph
+// that the sources have in common are traversed once for all of them. Each item is yielded with
+// the index of its source in sources. The sources must be drained concurrently because they share
+// workers, so the items of different sources are interleaved in no particular order.
+func (pl *Pipeline) BuildAll(ctx context.Context, sources []Source, target Target) iter.Seq2[int, Item] {
+	ctx, span := pipelineTracer.Start(ctx, "pipeline.build_all")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	workers := make(workerPool)
+
+	for _, source := range sources {
+		pl.resolve(path{
+			source: (*Node)(source),
+			target: target,
+		}, workers)
+	}
+
+	results := make([]Sender[*Edge, *Message], 0, len(sources))
+	for _, source := range sources {
+		sourceWorker, ok := workers[(*Node)(source)]
+		if !ok {
+			panic("no such source worker")
+		}
+		results = append(results, sourceWorker.Subscribe(nil))
+	}
+
+	return func(yield func(int, Item) bool) {
+		ctx, span := pipelineTracer.Start(ctx, "pipeline.iterate")
+		defer span.End()
+
+		if ctx.Err() != nil {
+			// Exit early if the context was already canceled.
+			// No goroutines have been created up to this point
+			// so no cleanup is necessary.
+			return
+		}
+
+		var wg sync.WaitGroup
+
+		defer wg.Wait()
+		defer cancel()
+
+		for _, w := range workers {
+			w.Start(ctx)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ctx.Done()
+			// Wait for all workers to finish.
+			for _, w := range workers {
+				w.Wait()
+			}
+		}()
+
+		type sourceItem struct {
+			index int
+			item  Item
+		}
+
+		// Every source is drained by its own goroutine, so that a source whose items are
+		// not consumed yet does not block the workers it shares with the other sources.
+		items := make(chan sourceItem)
+
+		var wgResults sync.WaitGroup
+		for i, res := range results {
+			wgResults.Add(1)
+			go func() {
+				defer wgResults.Done()
+				for msg := range res.Seq() {
+					for _, item := range msg.Value {
+						if ctx.Err() != nil {
+							break
+						}
+						select {
+						case items <- sourceItem{index: i, item: item}:
+						case <-ctx.Done():
+						}
+					}
+					msg.Done()
+				}
+			}()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wgResults.Wait()
+			close(items)
+		}()
+
+		var abandoned bool
+
+		for si := range items {
+			if abandoned {
+				continue
+			}
+			if !yield(si.index, si.item) {
+				// The caller has ended sequence iteration early.
+				// Cancel the context so that the pipeline begins
+				// its shutdown process.
+				abandoned = true
+				cancel()
+			}
+		}
+
+		if !abandoned && ctx.Err() != nil {
+			// Context was canceled so there is no guarantee that all
+			// objects have been returned. An error must be signaled
+			// here, for every source, to indicate the possibility of
+			// a partial result.
+			for i := range sources {
+				if !yield(i, Item{Err: ctx.Err()}) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func (pl *Pipeline) Source(name, relation string) (Source, bool) {
 	sourceNode, ok := pl.backend.Graph.GetNodeByID(name + "#" + relation)
 	return (Source)(sourceNode), ok
@@ -2442,3 +2442,102 @@ func TestPipeline(t *testing.T) {
 		}
 	})
 }
+
+func TestPipelineBuildAll(t *testing.T) {
+	const dsl string = `
+		model
+		  schema 1.1
+
+		type user
+
+		type folder
+		  relations
+		    define viewer: [user] or viewer from parent
+		    define parent: [folder]
+
+		type document
+		  relations
+		    define parent: [folder]
+		    define editor: [user]
+		    define viewer: editor or viewer from parent
+		`
+
+	tuples := []string{
+		"folder:1#viewer@user:1",
+		"folder:2#parent@folder:1",
+		"folder:3#viewer@user:2",
+		"document:1#parent@folder:2",
+		"document:2#editor@user:1",
+		"document:3#parent@folder:3",
+	}
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	storeID, model := storagetest.BootstrapFGAStore(t, ds, dsl, tuples)
+
+	typesys, err := typesystem.NewAndValidate(
+		context.Background(),
+		model,
+	)
+
+	require.NoError(t, err)
+
+	backend := &Backend{
+		Datastore:  ds,
+		StoreID:    storeID,
+		TypeSystem: typesys,
+		Context:    nil,
+		Graph:      typesys.GetWeightedGraph(),
+	}
+
+	pl, err := New(backend)
+	require.NoError(t, err)
+
+	target, ok := pl.Target("user", "1")
+	require.True(t, ok)
+
+	documentViewer, ok := pl.Source("document", "viewer")
+	require.True(t, ok)
+
+	folderViewer, ok := pl.Source("folder", "viewer")
+	require.True(t, ok)
+
+	documentEditor, ok := pl.Source("document", "editor")
+	require.True(t, ok)
+
+	t.Run("yields_the_items_of_every_source", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		items := make([][]string, 3)
+		for i, item := range pl.BuildAll(context.Background(), []Source{documentViewer, folderViewer, documentEditor}, target) {
+			require.NoError(t, item.Err)
+			items[i] = append(items[i], item.Value)
+		}
+		require.ElementsMatch(t, []string{"document:1", "document:2"}, items[0])
+		require.ElementsMatch(t, []string{"folder:1", "folder:2"}, items[1])
+		require.ElementsMatch(t, []string{"document:2"}, items[2])
+	})
+
+	t.Run("iterator_cancelation", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		for range pl.BuildAll(context.Background(), []Source{documentViewer, folderViewer}, target) {
+			break
+		}
+	})
+
+	t.Run("context_cancelation", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		seq := pl.BuildAll(ctx, []Source{documentViewer, folderViewer}, target)
+
+		cancel()
+
+		for range seq {
+			t.Fatalf("iteration did not stop after context cancelation")
+		}
+	})
+}
@@ -0,0 +1,492 @@
+package reverseexpand
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/condition/eval"
+	"github.com/openfga/openfga/internal/validation"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// sortedReadMaxUsers is the maximum number of users in the user filter of one of the sorted reads
+// of ExecuteSorted. The reads of larger filters are split, and their results merged.
+const sortedReadMaxUsers = 100
+
+// ExecuteSorted yields the objects of the provided objectType that the given user possibly has a
+// specific relation with, like Execute, but in ascending order, without duplicates, and only the
+// objects that sort after the object after if it is not empty. The objects whose ResultStatus is
+// RequiresFurtherEvalStatus must be confirmed with Check.
+//
+// The objects of the target type are read in order from the tuples of the target type, with
+// ReadStartingWithUser sorted by object ID, and yielded as soon as they are read, so that the
+// caller can stop after the objects it needs. Reading these tuples needs the objects of the other
+// types, and relations, that the user is related to along the way (e.g. the groups the user is a
+// member of): those are fully resolved with Execute beforehand, and do not need to be sorted.
+// Intersections and exclusions only yield the objects of their first operand, which require
+// further evaluation.
+//
+// The sequence yields an error at most once, as its last element.
+func (c *ReverseExpandQuery) ExecuteSorted(
+	ctx context.Context,
+	req *ReverseExpandRequest,
+	after string,
+	resolutionMetadata *ResolutionMetadata,
+) iter.Seq2[*ReverseExpandResult, error] {
+	return func(yield func(*ReverseExpandResult, error) bool) {
+		ctx, span := tracer.Start(ctx, "reverseExpand.ExecuteSorted")
+		defer span.End()
+
+		ctx = storage.ContextWithRelationshipTupleReader(ctx, c.datastore)
+
+		s := &sortedExpansion{
+			query:              c,
+			req:                req,
+			resolutionMetadata: resolutionMetadata,
+			expanded:           make(map[string]*expandedObjects),
+		}
+		if after != "" {
+			_, s.afterObjectID = tuple.SplitObject(after)
+		}
+
+		sources, err := s.sources(ctx, req.Relation, false, make(map[string]struct{}))
+		if err != nil {
+			stopCandidateSources(sources)
+			yield(nil, err)
+			return
+		}
+
+		for result, err := range mergeCandidateSources(ctx, sources) {
+			if !yield(result, err) {
+				return
+			}
+		}
+	}
+}
+
+// sortedExpansion resolves the sources of the candidate objects of an ExecuteSorted request.
+type sortedExpansion struct {
+	query              *ReverseExpandQuery
+	req                *ReverseExpandRequest
+	afterObjectID      string
+	resolutionMetadata *ResolutionMetadata
+
+	// expanded memoizes the objects of the other types and relations that the user is related to,
+	// keyed by type and relation.
+	expanded map[string]*expandedObjects
+}
+
+// expandedObjects are the objects of a type that the user possibly has a relation with.
+type expandedObjects struct {
+	objects []string
+
+	// requiresFurtherEval tells whether some of the objects may not be related to the user.
+	requiresFurtherEval bool
+}
+
+// sources returns the sources of the objects of the target type that the user possibly has relation
+// with. The objects of the sources require further evaluation if requiresFurtherEval is set.
+func (s *sortedExpansion) sources(
+	ctx context.Context,
+	relation string,
+	requiresFurtherEval bool,
+	visited map[string]struct{},
+) ([]candidateSource, error) {
+	if _, ok := visited[relation]; ok {
+		// A relation whose rewrite leads back to itself adds no objects to the other operands.
+		return nil, nil
+	}
+	visited[relation] = struct{}{}
+
+	var sources []candidateSource
+
+	// e.g. ExecuteSorted(type=document, rel=viewer, user=document:1#viewer) yields "document:1"
+	if userset, ok := s.req.User.(*UserRefObjectRelation); ok &&
+		tuple.UsersetMatchTypeAndRelation(userset.String(), relation, s.req.ObjectType) {
+		object := userset.ObjectRelation.GetObject()
+		if _, objectID := tuple.SplitObject(object); objectID > s.afterObjectID {
+			sources = append(sources, &staticCandidateSource{results: []*ReverseExpandResult{{
+				Object:       object,
+				ResultStatus: resultStatus(requiresFurtherEval),
+			}}})
+		}
+	}
+
+	rel, err := s.query.typesystem.GetRelation(s.req.ObjectType, relation)
+	if err != nil {
+		return sources, err
+	}
+
+	rewriteSources, err := s.rewriteSources(ctx, relation, rel.GetRewrite(), requiresFurtherEval, visited)
+	return append(sources, rewriteSources...), err
+}
+
+func (s *sortedExpansion) rewriteSources(
+	ctx context.Context,
+	relation string,
+	rewrite *openfgav1.Userset,
+	requiresFurtherEval bool,
+	visited map[string]struct{},
+) ([]candidateSource, error) {
+	switch rw := rewrite.GetUserset().(type) {
+	case nil, *openfgav1.Userset_This:
+		return s.directSources(ctx, relation, requiresFurtherEval)
+	case *openfgav1.Userset_ComputedUserset:
+		return s.sources(ctx, rw.ComputedUserset.GetRelation(), requiresFurtherEval, visited)
+	case *openfgav1.Userset_TupleToUserset:
+		return s.tupleToUsersetSources(ctx, rw.TupleToUserset, requiresFurtherEval)
+	case *openfgav1.Userset_Union:
+		var sources []candidateSource
+		for _, child := range rw.Union.GetChild() {
+			childSources, err := s.rewriteSources(ctx, relation, child, requiresFurtherEval, visited)
+			sources = append(sources, childSources...)
+			if err != nil {
+				return sources, err
+			}
+		}
+		return sources, nil
+	case *openfgav1.Userset_Intersection:
+		// The objects of the intersection are among the objects of any of its operands.
+		return s.rewriteSources(ctx, relation, rw.Intersection.GetChild()[0], true, visited)
+	case *openfgav1.Userset_Difference:
+		return s.rewriteSources(ctx, relation, rw.Difference.GetBase(), true, visited)
+	default:
+		return nil, fmt.Errorf("unsupported userset type: %T", rw)
+	}
+}
+
+// directSources returns the sources of the objects of the tuples of the target type and relation
+// whose user is the user, its typed wildcard, or one of the usersets that the user is related to.
+func (s *sortedExpansion) directSources(ctx context.Context, relation string, requiresFurtherEval bool) ([]candidateSource, error) {
+	directlyRelatedTypes, err := s.query.typesystem.GetDirectlyRelatedUserTypes(s.req.ObjectType, relation)
+	if err != nil {
+		return nil, err
+	}
+
+	var userFilter []*openfgav1.ObjectRelation
+	for _, ref := range directlyRelatedTypes {
+		switch {
+		case ref.GetRelation() != "":
+			// e.g. 'group:eng#member'
+			expanded, err := s.expand(ctx, ref.GetType(), ref.GetRelation())
+			if err != nil {
+				return nil, err
+			}
+			requiresFurtherEval = requiresFurtherEval || expanded.requiresFurtherEval
+			for _, object := range expanded.objects {
+				userFilter = append(userFilter, &openfgav1.ObjectRelation{Object: object, Relation: ref.GetRelation()})
+			}
+		case ref.GetWildcard() != nil:
+			// e.g. 'user:*'
+			if _, isUserset := s.req.User.(*UserRefObjectRelation); !isUserset && s.req.User.GetObjectType() == ref.GetType() {
+				userFilter = append(userFilter, &openfgav1.ObjectRelation{Object: tuple.TypedPublicWildcard(ref.GetType())})
+			}
+		default:
+			// e.g. 'user:bob'
+			if user, ok := s.req.User.(*UserRefObject); ok && user.GetObjectType() == ref.GetType() {
+				userFilter = append(userFilter, &openfgav1.ObjectRelation{Object: user.String()})
+			}
+		}
+	}
+
+	return s.readSources(ctx, relation, userFilter, requiresFurtherEval)
+}
+
+// tupleToUsersetSources returns the sources of the objects of the tuples of the target type and
+// tupleset relation whose user is one of the objects that the user has the computed relation with.
+func (s *sortedExpansion) tupleToUsersetSources(ctx context.Context, ttu *openfgav1.TupleToUserset, requiresFurtherEval bool) ([]candidateSource, error) {
+	tuplesetRelation := ttu.GetTupleset().GetRelation()
+	computedRelation := ttu.GetComputedUserset().GetRelation()
+
+	directlyRelatedTypes, err := s.query.typesystem.GetDirectlyRelatedUserTypes(s.req.ObjectType, tuplesetRelation)
+	if err != nil {
+		return nil, err
+	}
+
+	var userFilter []*openfgav1.ObjectRelation
+	for _, ref := range directlyRelatedTypes {
+		if _, err := s.query.typesystem.GetRelation(ref.GetType(), computedRelation); err != nil {
+			if errors.Is(err, typesystem.ErrRelationUndefined) {
+				continue
+			}
+			return nil, err
+		}
+
+		expanded, err := s.expand(ctx, ref.GetType(), computedRelation)
+		if err != nil {
+			return nil, err
+		}
+		requiresFurtherEval = requiresFurtherEval || expanded.requiresFurtherEval
+		for _, object := range expanded.objects {
+			userFilter = append(userFilter, &openfgav1.ObjectRelation{Object: object})
+		}
+	}
+
+	return s.readSources(ctx, tuplesetRelation, userFilter, requiresFurtherEval)
+}
+
+// readSources returns the sources reading, in order, the objects of the tuples of the target type and
+// relation whose user is in userFilter.
+func (s *sortedExpansion) readSources(
+	ctx context.Context,
+	relation string,
+	userFilter []*openfgav1.ObjectRelation,
+	requiresFurtherEval bool,
+) ([]candidateSource, error) {
+	var sources []candidateSource
+	for users := range slices.Chunk(userFilter, sortedReadMaxUsers) {
+		iter, err := s.query.datastore.ReadStartingWithUser(ctx, s.req.StoreID, storage.ReadStartingWithUserFilter{
+			ObjectType:    s.req.ObjectType,
+			Relation:      relation,
+			UserFilter:    users,
+			AfterObjectID: s.afterObjectID,
+		}, storage.ReadStartingWithUserOptions{
+			Consistency: storage.ConsistencyOptions{
+				Preference: s.req.Consistency,
+			},
+			WithResultsSortedAscending: true,
+		})
+		if err != nil {
+			return sources, err
+		}
+
+		sources = append(sources, &tupleCandidateSource{
+			// filter out invalid tuples yielded by the database iterator
+			iter: storage.NewFilteredTupleKeyIterator(
+				storage.NewTupleKeyIteratorFromTupleIterator(iter),
+				validation.FilterInvalidTuples(s.query.typesystem),
+			),
+			typesystem:          s.query.typesystem,
+			conditionContext:    s.req.Context,
+			afterObjectID:       s.afterObjectID,
+			requiresFurtherEval: requiresFurtherEval,
+		})
+	}
+	return sources, nil
+}
+
+// expand returns the objects of objectType that the user possibly has relation with, resolved
+// with Execute.
+func (s *sortedExpansion) expand(ctx context.Context, objectType, relation string) (*expandedObjects, error) {
+	key := tuple.ToObjectRelationString(objectType, relation)
+	if expanded, ok := s.expanded[key]; ok {
+		return expanded, nil
+	}
+
+	expanded := &expandedObjects{}
+	hasPath, err := s.query.typesystem.PathExists(s.req.User.String(), relation, objectType)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPath {
+		s.expanded[key] = expanded
+		return expanded, nil
+	}
+
+	query := s.query.shallowClone()
+	query.visitedUsersetsMap = new(sync.Map)
+	query.queryDedupeMap = new(sync.Map)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resultChan := make(chan *ReverseExpandResult, sortedReadMaxUsers)
+	done := make(chan error, 1)
+	go func() {
+		done <- query.Execute(ctx, &ReverseExpandRequest{
+			StoreID:          s.req.StoreID,
+			ObjectType:       objectType,
+			Relation:         relation,
+			User:             s.req.User,
+			ContextualTuples: s.req.ContextualTuples,
+			Context:          s.req.Context,
+			Consistency:      s.req.Consistency,
+		}, resultChan, s.resolutionMetadata)
+	}()
+
+	for {
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				s.expanded[key] = expanded
+				return expanded, nil
+			}
+			expanded.add(result)
+		case err := <-done:
+			if err != nil {
+				return nil, err
+			}
+			// Execute closed resultChan, which is drained by the other case.
+			done = nil
+		}
+	}
+}
+
+func (e *expandedObjects) add(result *ReverseExpandResult) {
+	e.objects = append(e.objects, result.Object)
+	e.requiresFurtherEval = e.requiresFurtherEval || result.ResultStatus == RequiresFurtherEvalStatus
+}
+
+func resultStatus(requiresFurtherEval bool) ConditionalResultStatus {
+	if requiresFurtherEval {
+		return RequiresFurtherEvalStatus
+	}
+	return NoFurtherEvalStatus
+}
+
+// candidateSource yields candidate objects in ascending order, possibly with duplicates.
+type candidateSource interface {
+	// next returns the next candidate, or storage.ErrIteratorDone once there are none left.
+	next(ctx context.Context) (*ReverseExpandResult, error)
+	stop()
+}
+
+type staticCandidateSource struct {
+	results []*ReverseExpandResult
+}
+
+func (s *staticCandidateSource) next(context.Context) (*ReverseExpandResult, error) {
+	if len(s.results) == 0 {
+		return nil, storage.ErrIteratorDone
+	}
+	result := s.results[0]
+	s.results = s.results[1:]
+	return result, nil
+}
+
+func (s *staticCandidateSource) stop() {}
+
+// tupleCandidateSource yields the objects of tuples read in order, whose conditions are met.
+type tupleCandidateSource struct {
+	iter                storage.TupleKeyIterator
+	typesystem          *typesystem.TypeSystem
+	conditionContext    *structpb.Struct
+	afterObjectID       string
+	requiresFurtherEval bool
+}
+
+func (s *tupleCandidateSource) next(ctx context.Context) (*ReverseExpandResult, error) {
+	for {
+		tk, err := s.iter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// datastores that do not support AfterObjectID return the previous objects as well
+		if _, objectID := tuple.SplitObject(tk.GetObject()); objectID <= s.afterObjectID {
+			continue
+		}
+
+		requiresFurtherEval := s.requiresFurtherEval
+		cond, _ := s.typesystem.GetCondition(tk.GetCondition().GetName())
+		condMet, err := eval.EvaluateTupleCondition(ctx, tk, cond, s.conditionContext)
+		if err != nil {
+			// Check reports the error of the condition, if the object needs the tuple.
+			requiresFurtherEval = true
+		} else if !condMet {
+			continue
+		}
+
+		return &ReverseExpandResult{
+			Object:       tk.GetObject(),
+			ResultStatus: resultStatus(requiresFurtherEval),
+		}, nil
+	}
+}
+
+func (s *tupleCandidateSource) stop() {
+	s.iter.Stop()
+}
+
+func stopCandidateSources(sources []candidateSource) {
+	for _, source := range sources {
+		source.stop()
+	}
+}
+
+// mergeCandidateSources merges the candidates of the sources in ascending order, yielding every
+// object once. An object requires further evaluation only if it does for all its sources.
+func mergeCandidateSources(ctx context.Context, sources []candidateSource) iter.Seq2[*ReverseExpandResult, error] {
+	return func(yield func(*ReverseExpandResult, error) bool) {
+		defer stopCandidateSources(sources)
+
+		var heads candidateHeap
+		advance := func(source candidateSource) error {
+			result, err := source.next(ctx)
+			if err != nil {
+				if errors.Is(err, storage.ErrIteratorDone) {
+					return nil
+				}
+				return err
+			}
+			heap.Push(&heads, candidateHead{result: result, source: source})
+			return nil
+		}
+
+		for _, source := range sources {
+			if err := advance(source); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+
+		for heads.Len() > 0 {
+			head := heap.Pop(&heads).(candidateHead)
+			result := head.result
+			if err := advance(head.source); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for heads.Len() > 0 && heads[0].result.Object == result.Object {
+				duplicate := heap.Pop(&heads).(candidateHead)
+				if duplicate.result.ResultStatus == NoFurtherEvalStatus {
+					result.ResultStatus = NoFurtherEvalStatus
+				}
+				if err := advance(duplicate.source); err != nil {
+					yield(nil, err)
+					return
+				}
+			}
+
+			if !yield(result, nil) {
+				return
+			}
+		}
+	}
+}
+
+type candidateHead struct {
+	result *ReverseExpandResult
+	source candidateSource
+}
+
+// candidateHeap implements heap.Interface with the smallest object at the root.
+type candidateHeap []candidateHead
+
+func (h candidateHeap) Len() int           { return len(h) }
+func (h candidateHeap) Less(i, j int) bool { return h[i].result.Object < h[j].result.Object }
+func (h candidateHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *candidateHeap) Push(x any) {
+	*h = append(*h, x.(candidateHead))
+}
+
+func (h *candidateHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
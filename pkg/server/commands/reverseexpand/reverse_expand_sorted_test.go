@@ -0,0 +1,152 @@
+package reverseexpand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage/memory"
+	storagetest "github.com/openfga/openfga/pkg/storage/test"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+func TestReverseExpandExecuteSorted(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	storeID, model := storagetest.BootstrapFGAStore(t, ds, `
+		model
+			schema 1.1
+
+		type user
+
+		type group
+			relations
+				define member: [user]
+
+		type folder
+			relations
+				define viewer: [user, user:*]
+
+		type document
+			relations
+				define parent: [folder]
+				define owner: [user, group#member]
+				define blocked: [user]
+				define viewer: owner or viewer from parent
+				define restricted: owner but not blocked
+				define shared: owner and viewer
+	`, []string{
+		"document:3#owner@user:jon",
+		"document:1#owner@group:eng#member",
+		"group:eng#member@user:jon",
+		"document:10#parent@folder:x",
+		"document:3#parent@folder:x",
+		"folder:x#viewer@user:*",
+		"document:2#owner@user:jon",
+		"document:4#parent@folder:y",
+		"folder:y#viewer@user:anne",
+		"document:2#blocked@user:jon",
+	})
+	ts, err := typesystem.NewAndValidate(context.Background(), model)
+	require.NoError(t, err)
+
+	type result struct {
+		object string
+		status ConditionalResultStatus
+	}
+
+	tests := []struct {
+		name     string
+		relation string
+		after    string
+		expected []result
+	}{
+		{
+			name:     "union",
+			relation: "viewer",
+			expected: []result{
+				{"document:1", NoFurtherEvalStatus},
+				{"document:10", NoFurtherEvalStatus},
+				{"document:2", NoFurtherEvalStatus},
+				{"document:3", NoFurtherEvalStatus},
+			},
+		},
+		{
+			name:     "after",
+			relation: "viewer",
+			after:    "document:10",
+			expected: []result{
+				{"document:2", NoFurtherEvalStatus},
+				{"document:3", NoFurtherEvalStatus},
+			},
+		},
+		{
+			name:     "exclusion",
+			relation: "restricted",
+			expected: []result{
+				{"document:1", RequiresFurtherEvalStatus},
+				{"document:2", RequiresFurtherEvalStatus},
+				{"document:3", RequiresFurtherEvalStatus},
+			},
+		},
+		{
+			name:     "intersection",
+			relation: "shared",
+			after:    "document:1",
+			expected: []result{
+				{"document:2", RequiresFurtherEvalStatus},
+				{"document:3", RequiresFurtherEvalStatus},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q := NewReverseExpandQuery(ds, ts)
+
+			var results []result
+			for r, err := range q.ExecuteSorted(context.Background(), &ReverseExpandRequest{
+				StoreID:    storeID,
+				ObjectType: "document",
+				Relation:   test.relation,
+				User: &UserRefObject{
+					Object: &openfgav1.Object{Type: "user", Id: "jon"},
+				},
+			}, test.after, NewResolutionMetadata()) {
+				require.NoError(t, err)
+				results = append(results, result{r.Object, r.ResultStatus})
+			}
+			require.Equal(t, test.expected, results)
+		})
+	}
+
+	t.Run("stops_when_the_caller_does", func(t *testing.T) {
+		q := NewReverseExpandQuery(ds, ts)
+
+		var objects []string
+		for r, err := range q.ExecuteSorted(context.Background(), &ReverseExpandRequest{
+			StoreID:    storeID,
+			ObjectType: "document",
+			Relation:   "viewer",
+			User: &UserRefObject{
+				Object: &openfgav1.Object{Type: "user", Id: "jon"},
+			},
+		}, "", NewResolutionMetadata()) {
+			require.NoError(t, err)
+			objects = append(objects, r.Object)
+			if len(objects) == 2 {
+				break
+			}
+		}
+		require.Equal(t, []string{"document:1", "document:10"}, objects)
+	})
+}
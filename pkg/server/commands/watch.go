@@ -0,0 +1,219 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/metadata"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/encoder"
+	"github.com/openfga/openfga/pkg/logger"
+	serverconfig "github.com/openfga/openfga/pkg/server/config"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// WatchQuery streams the changes of a store by following its changelog.
+type WatchQuery struct {
+	backend         storage.ChangelogBackend
+	logger          logger.Logger
+	encoder         encoder.Encoder
+	tokenSerializer encoder.ContinuationTokenSerializer
+	horizonOffset   time.Duration
+	pollInterval    time.Duration
+	checkHorizon    bool
+}
+
+type WatchQueryOption func(*WatchQuery)
+
+func WithWatchQueryLogger(l logger.Logger) WatchQueryOption {
+	return func(wq *WatchQuery) {
+		wq.logger = l
+	}
+}
+
+// WithWatchQueryEncoder specifies the encoder of the continuation tokens, which should be the one
+// of ReadChanges.
+func WithWatchQueryEncoder(e encoder.Encoder) WatchQueryOption {
+	return func(wq *WatchQuery) {
+		wq.encoder = e
+	}
+}
+
+// WithWatchQueryContinuationTokenSerializer specifies the token serializer to be used.
+func WithWatchQueryContinuationTokenSerializer(tokenSerializer encoder.ContinuationTokenSerializer) WatchQueryOption {
+	return func(wq *WatchQuery) {
+		wq.tokenSerializer = tokenSerializer
+	}
+}
+
+// WithWatchQueryHorizonOffset specifies duration in minutes.
+func WithWatchQueryHorizonOffset(horizonOffset int) WatchQueryOption {
+	return func(wq *WatchQuery) {
+		wq.horizonOffset = time.Duration(horizonOffset) * time.Minute
+	}
+}
+
+// WithWatchQueryPollInterval specifies how long to wait before reading the changelog again
+// once every change has been streamed.
+func WithWatchQueryPollInterval(interval time.Duration) WatchQueryOption {
+	return func(wq *WatchQuery) {
+		wq.pollInterval = interval
+	}
+}
+
+// WithWatchQueryCheckHorizon specifies whether streaming changes from a continuation token before
+// the retention horizon of the store fails, which is only needed when the changelog is pruned.
+func WithWatchQueryCheckHorizon(checkHorizon bool) WatchQueryOption {
+	return func(wq *WatchQuery) {
+		wq.checkHorizon = checkHorizon
+	}
+}
+
+// NewWatchQuery creates a WatchQuery with specified `ChangelogBackend`.
+func NewWatchQuery(backend storage.ChangelogBackend, opts ...WatchQueryOption) *WatchQuery {
+	wq := &WatchQuery{
+		backend:         backend,
+		logger:          logger.NewNoopLogger(),
+		encoder:         encoder.NewBase64Encoder(),
+		tokenSerializer: encoder.NewStringContinuationTokenSerializer(),
+		horizonOffset:   time.Duration(serverconfig.DefaultChangelogHorizonOffset) * time.Minute,
+		pollInterval:    serverconfig.DefaultWatchPollInterval,
+	}
+
+	for _, opt := range opts {
+		opt(wq)
+	}
+	return wq
+}
+
+// Execute sends the headers of srv once req is accepted, then every batch of changes matching req,
+// until ctx is done or an error occurs. It returns nil once ctx is done, so that the stream ends
+// gracefully.
+func (q *WatchQuery) Execute(ctx context.Context, req *watchv1.WatchRequest, srv watchv1.WatchService_WatchServer) error {
+	from, err := q.watchStart(req)
+	if err != nil {
+		return err
+	}
+	// Streaming from a start time before the retention horizon streams every change left.
+	checkHorizon := q.checkHorizon && req.GetContinuationToken() != ""
+
+	// Let the clients tell an accepted stream without changes yet from a pending one.
+	if err := srv.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	filter := storage.ReadChangesFilter{
+		ObjectType:    req.GetType(),
+		HorizonOffset: q.horizonOffset,
+	}
+	for {
+		opts := storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(storage.DefaultPageSize, from),
+			CheckHorizon: checkHorizon,
+		}
+		changes, contUlid, err := q.backend.ReadChanges(ctx, req.GetStoreId(), filter, opts)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return serverErrors.HandleError("", err)
+		}
+
+		if contUlid != "" {
+			from = contUlid
+			checkHorizon = q.checkHorizon
+		}
+		if batch := filterChangesByRelation(changes, req.GetRelation()); len(batch) > 0 {
+			token, err := q.continuationToken(from, req)
+			if err != nil {
+				return serverErrors.HandleError("", err)
+			}
+			if err := srv.Send(&watchv1.WatchResponse{Changes: batch, ContinuationToken: token}); err != nil {
+				return err
+			}
+		}
+
+		if len(changes) == storage.DefaultPageSize {
+			// There may be more changes already, read them without waiting.
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(q.pollInterval):
+		}
+	}
+}
+
+// watchStart returns the ULID after which the changes of req are streamed.
+func (q *WatchQuery) watchStart(req *watchv1.WatchRequest) (string, error) {
+	if req.GetContinuationToken() != "" {
+		decoded, err := q.encoder.Decode(req.GetContinuationToken())
+		if err != nil {
+			return "", serverErrors.ErrInvalidContinuationToken
+		}
+		from, filter, err := q.tokenSerializer.Deserialize(string(decoded))
+		if err != nil {
+			return "", serverErrors.ErrInvalidContinuationToken
+		}
+		if _, err := ulid.ParseStrict(from); err != nil {
+			return "", serverErrors.ErrInvalidContinuationToken
+		}
+		if filter != watchTokenFilter(req) {
+			return "", serverErrors.ErrMismatchObjectType
+		}
+		return from, nil
+	}
+
+	startTime := time.Now()
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	start, err := ulid.New(ulid.Timestamp(startTime), nil)
+	if err != nil {
+		return "", serverErrors.HandleError(err.Error(), storage.ErrInvalidStartTime)
+	}
+	return start.String(), nil
+}
+
+// continuationToken returns the continuation token resuming the stream of req after the ULID from.
+// Like the tokens of ReadChanges, it is only valid for the filter of req.
+func (q *WatchQuery) continuationToken(from string, req *watchv1.WatchRequest) (string, error) {
+	token, err := q.tokenSerializer.Serialize(from, watchTokenFilter(req))
+	if err != nil {
+		return "", err
+	}
+	return q.encoder.Encode(token)
+}
+
+// watchTokenFilter returns the filter of req that its continuation tokens are tied to. Without a
+// relation, it is the type, as in the tokens of ReadChanges.
+func watchTokenFilter(req *watchv1.WatchRequest) string {
+	if req.GetRelation() == "" {
+		return req.GetType()
+	}
+	return fmt.Sprintf("%s#%s", req.GetType(), req.GetRelation())
+}
+
+// filterChangesByRelation returns the changes of relation, or every change if relation is empty.
+func filterChangesByRelation(changes []*openfgav1.TupleChange, relation string) []*openfgav1.TupleChange {
+	if relation == "" {
+		return changes
+	}
+
+	filtered := make([]*openfgav1.TupleChange, 0, len(changes))
+	for _, change := range changes {
+		if change.GetTupleKey().GetRelation() == relation {
+			filtered = append(filtered, change)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,194 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+type watchStream struct {
+	grpc.ServerStream
+
+	accepted  chan struct{}
+	responses chan *watchv1.WatchResponse
+}
+
+func newWatchStream() *watchStream {
+	return &watchStream{
+		accepted:  make(chan struct{}),
+		responses: make(chan *watchv1.WatchResponse, 100),
+	}
+}
+
+func (w *watchStream) SendHeader(metadata.MD) error {
+	close(w.accepted)
+	return nil
+}
+
+// waitAccepted waits until the headers are sent, as the changes are only streamed from then.
+func (w *watchStream) waitAccepted(t *testing.T) {
+	t.Helper()
+	select {
+	case <-w.accepted:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "stream not accepted")
+	}
+}
+
+func (w *watchStream) Send(resp *watchv1.WatchResponse) error {
+	w.responses <- resp
+	return nil
+}
+
+func (w *watchStream) receive(t *testing.T) *watchv1.WatchResponse {
+	t.Helper()
+	select {
+	case resp := <-w.responses:
+		return resp
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no changes received")
+		return nil
+	}
+}
+
+func changedTuples(resp *watchv1.WatchResponse) []string {
+	res := make([]string, 0, len(resp.GetChanges()))
+	for _, change := range resp.GetChanges() {
+		res = append(res, tuple.TupleKeyToString(change.GetTupleKey()))
+	}
+	return res
+}
+
+func TestWatchQuery(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store := ulid.Make().String()
+	ctx := context.Background()
+
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:before", "viewer", "user:jon"),
+	}))
+	time.Sleep(2 * time.Millisecond)
+
+	// execute runs a WatchQuery for req until the test ends.
+	execute := func(t *testing.T, req *watchv1.WatchRequest) *watchStream {
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-done)
+		})
+
+		srv := newWatchStream()
+		go func() {
+			done <- NewWatchQuery(ds, WithWatchQueryPollInterval(10*time.Millisecond)).Execute(ctx, req, srv)
+		}()
+		srv.waitAccepted(t)
+		return srv
+	}
+
+	t.Run("streams_changes_after_the_request", func(t *testing.T) {
+		srv := execute(t, &watchv1.WatchRequest{StoreId: store})
+
+		require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+			tuple.NewTupleKey("folder:1", "viewer", "user:jon"),
+		}))
+
+		resp := srv.receive(t)
+		require.Equal(t, []string{"document:1#viewer@user:jon", "folder:1#viewer@user:jon"}, changedTuples(resp))
+		_, err := ulid.ParseStrict(resp.GetContinuationToken())
+		require.Error(t, err, "continuation token exposes the changelog ULID")
+	})
+
+	t.Run("resumes_from_the_continuation_token", func(t *testing.T) {
+		srv := execute(t, &watchv1.WatchRequest{StoreId: store, StartTime: timestamppb.New(time.Now().Add(-time.Hour))})
+		first := srv.receive(t)
+		require.Contains(t, changedTuples(first), "document:before#viewer@user:jon")
+
+		require.NoError(t, ds.Write(ctx, store, []*openfgav1.TupleKeyWithoutCondition{
+			tuple.TupleKeyToTupleKeyWithoutCondition(tuple.NewTupleKey("document:before", "viewer", "user:jon")),
+		}, nil))
+
+		resumed := execute(t, &watchv1.WatchRequest{StoreId: store, ContinuationToken: first.GetContinuationToken()})
+		resp := resumed.receive(t)
+		require.Equal(t, []string{"document:before#viewer@user:jon"}, changedTuples(resp))
+		require.Equal(t, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE, resp.GetChanges()[0].GetOperation())
+	})
+
+	t.Run("continuation_token_of_another_filter", func(t *testing.T) {
+		srv := execute(t, &watchv1.WatchRequest{StoreId: store, Type: "document", StartTime: timestamppb.New(time.Now().Add(-time.Hour))})
+		token := srv.receive(t).GetContinuationToken()
+
+		for _, req := range []*watchv1.WatchRequest{
+			{StoreId: store, ContinuationToken: token},
+			{StoreId: store, Type: "folder", ContinuationToken: token},
+			{StoreId: store, Type: "document", Relation: "viewer", ContinuationToken: token},
+		} {
+			err := NewWatchQuery(ds).Execute(ctx, req, newWatchStream())
+			require.ErrorIs(t, err, serverErrors.ErrMismatchObjectType)
+		}
+	})
+
+	t.Run("filters_by_type_and_relation", func(t *testing.T) {
+		srv := execute(t, &watchv1.WatchRequest{StoreId: store, Type: "document", Relation: "editor"})
+
+		require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:2", "viewer", "user:jon"),
+			tuple.NewTupleKey("folder:2", "editor", "user:jon"),
+			tuple.NewTupleKey("document:2", "editor", "user:jon"),
+		}))
+
+		require.Equal(t, []string{"document:2#editor@user:jon"}, changedTuples(srv.receive(t)))
+	})
+
+	t.Run("start_time_before_changelog_horizon", func(t *testing.T) {
+		prunedStore := ulid.Make().String()
+		require.NoError(t, ds.Write(ctx, prunedStore, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:pruned", "viewer", "user:jon"),
+			tuple.NewTupleKey("document:kept", "viewer", "user:jon"),
+		}))
+		_, err := ds.(storage.ChangelogPruner).PruneChanges(ctx, prunedStore, storage.PruneChangesOptions{MaxChanges: 1})
+		require.NoError(t, err)
+
+		srv := execute(t, &watchv1.WatchRequest{StoreId: prunedStore, StartTime: timestamppb.New(time.Now().Add(-time.Hour))})
+		require.Equal(t, []string{"document:kept#viewer@user:jon"}, changedTuples(srv.receive(t)))
+	})
+
+	t.Run("continuation_token_before_changelog_horizon", func(t *testing.T) {
+		prunedStore := ulid.Make().String()
+		token, err := NewWatchQuery(ds).continuationToken(ulid.Make().String(), &watchv1.WatchRequest{StoreId: prunedStore})
+		require.NoError(t, err)
+		require.NoError(t, ds.Write(ctx, prunedStore, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:pruned", "viewer", "user:jon"),
+			tuple.NewTupleKey("document:kept", "viewer", "user:jon"),
+		}))
+		_, err = ds.(storage.ChangelogPruner).PruneChanges(ctx, prunedStore, storage.PruneChangesOptions{MaxChanges: 1})
+		require.NoError(t, err)
+
+		err = NewWatchQuery(ds, WithWatchQueryCheckHorizon(true)).Execute(ctx, &watchv1.WatchRequest{StoreId: prunedStore, ContinuationToken: token}, newWatchStream())
+		require.ErrorIs(t, err, serverErrors.ErrChangelogTruncated)
+	})
+
+	t.Run("invalid_continuation_token", func(t *testing.T) {
+		srv := newWatchStream()
+		err := NewWatchQuery(ds).Execute(ctx, &watchv1.WatchRequest{StoreId: store, ContinuationToken: "invalid"}, srv)
+		require.ErrorIs(t, err, serverErrors.ErrInvalidContinuationToken)
+		require.NotPanics(t, func() { close(srv.accepted) }, "headers sent for a rejected request")
+	})
+}
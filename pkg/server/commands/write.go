@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,6 +26,7 @@ type WriteCommand struct {
 	logger                    logger.Logger
 	datastore                 storage.OpenFGADatastore
 	conditionContextByteLimit int
+	tupleExpiresAt            time.Time
 }
 
 type WriteCommandOption func(*WriteCommand)
@@ -41,6 +43,14 @@ func WithConditionContextByteLimit(limit int) WriteCommandOption {
 	}
 }
 
+// WithWriteCmdTupleExpiresAt sets the time at which the written tuples expire. The zero time, the
+// default, means they never expire.
+func WithWriteCmdTupleExpiresAt(expiresAt time.Time) WriteCommandOption {
+	return func(wc *WriteCommand) {
+		wc.tupleExpiresAt = expiresAt
+	}
+}
+
 // NewWriteCommand creates a WriteCommand with specified storage.OpenFGADatastore to use for storage.
 func NewWriteCommand(datastore storage.OpenFGADatastore, opts ...WriteCommandOption) *WriteCommand {
 	cmd := &WriteCommand{
@@ -93,13 +103,20 @@ func (c *WriteCommand) Execute(ctx context.Context, req *openfgav1.WriteRequest)
 		return nil, err
 	}
 
+	opts := []storage.TupleWriteOption{
+		storage.WithOnMissingDelete(onEmptyDelete),
+		storage.WithOnDuplicateInsert(onDuplicateInsert),
+	}
+	if !c.tupleExpiresAt.IsZero() {
+		opts = append(opts, storage.WithExpiresAt(c.tupleExpiresAt))
+	}
+
 	err = c.datastore.Write(
 		ctx,
 		req.GetStoreId(),
 		req.GetDeletes().GetTupleKeys(),
 		req.GetWrites().GetTupleKeys(),
-		storage.WithOnMissingDelete(onEmptyDelete),
-		storage.WithOnDuplicateInsert(onDuplicateInsert),
+		opts...,
 	)
 	if err != nil {
 		if errors.Is(err, storage.ErrTransactionalWriteFailed) {
@@ -108,6 +125,9 @@ func (c *WriteCommand) Execute(ctx context.Context, req *openfgav1.WriteRequest)
 		if errors.Is(err, storage.ErrInvalidWriteInput) {
 			return nil, serverErrors.WriteFailedDueToInvalidInput(err)
 		}
+		if errors.Is(err, storage.ErrTupleExpiryNotSupported) {
+			return nil, serverErrors.ValidationError(err)
+		}
 		return nil, serverErrors.HandleError("", err)
 	}
 
@@ -146,23 +166,9 @@ func (c *WriteCommand) validateWriteRequest(ctx context.Context, req *openfgav1.
 		}
 
 		for _, tk := range writes {
-			err := validation.ValidateTupleForWrite(typesys, tk)
-			if err != nil {
-				return serverErrors.ValidationError(err)
-			}
-
-			err = c.validateNotImplicit(tk)
-			if err != nil {
+			if err := validateTupleForWrite(typesys, tk, c.conditionContextByteLimit); err != nil {
 				return err
 			}
-
-			contextSize := proto.Size(tk.GetCondition().GetContext())
-			if contextSize > c.conditionContextByteLimit {
-				return serverErrors.ValidationError(&tupleUtils.InvalidTupleError{
-					Cause:    fmt.Errorf("condition context size limit exceeded: %d bytes exceeds %d bytes", contextSize, c.conditionContextByteLimit),
-					TupleKey: tk,
-				})
-			}
 		}
 	}
 
@@ -214,8 +220,31 @@ func (c *WriteCommand) validateNoDuplicatesAndCorrectSize(
 	return nil
 }
 
+// validateTupleForWrite ensures the tuple to be written is valid against typesys, is not implicit,
+// and has a condition context of at most conditionContextByteLimit bytes.
+func validateTupleForWrite(typesys *typesystem.TypeSystem, tk *openfgav1.TupleKey, conditionContextByteLimit int) error {
+	err := validation.ValidateTupleForWrite(typesys, tk)
+	if err != nil {
+		return serverErrors.ValidationError(err)
+	}
+
+	err = validateNotImplicit(tk)
+	if err != nil {
+		return err
+	}
+
+	contextSize := proto.Size(tk.GetCondition().GetContext())
+	if contextSize > conditionContextByteLimit {
+		return serverErrors.ValidationError(&tupleUtils.InvalidTupleError{
+			Cause:    fmt.Errorf("condition context size limit exceeded: %d bytes exceeds %d bytes", contextSize, conditionContextByteLimit),
+			TupleKey: tk,
+		})
+	}
+	return nil
+}
+
 // validateNotImplicit ensures the tuple to be written (not deleted) is not of the form `object:id # relation @ object:id#relation`.
-func (c *WriteCommand) validateNotImplicit(
+func validateNotImplicit(
 	tk *openfgav1.TupleKey,
 ) error {
 	userObject, userRelation := tupleUtils.SplitObjectRelation(tk.GetUser())
@@ -54,9 +54,34 @@ func NewWriteAuthorizationModelCommand(backend storage.TypeDefinitionWriteBacken
 
 // Execute the command using the supplied request.
 func (w *WriteAuthorizationModelCommand) Execute(ctx context.Context, req *openfgav1.WriteAuthorizationModelRequest) (*openfgav1.WriteAuthorizationModelResponse, error) {
+	model, _, err := w.newModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.backend.WriteAuthorizationModel(ctx, req.GetStoreId(), model)
+	if err != nil {
+		return nil, serverErrors.
+			HandleError("Error writing authorization model configuration", err)
+	}
+
+	return &openfgav1.WriteAuthorizationModelResponse{
+		AuthorizationModelId: model.GetId(),
+	}, nil
+}
+
+// DryRun validates the supplied request like Execute, and returns the TypeSystem of the model
+// Execute would write without writing it.
+func (w *WriteAuthorizationModelCommand) DryRun(ctx context.Context, req *openfgav1.WriteAuthorizationModelRequest) (*typesystem.TypeSystem, error) {
+	_, typesys, err := w.newModel(ctx, req)
+	return typesys, err
+}
+
+// newModel validates the supplied request and returns the model it defines, with a new ID.
+func (w *WriteAuthorizationModelCommand) newModel(ctx context.Context, req *openfgav1.WriteAuthorizationModelRequest) (*openfgav1.AuthorizationModel, *typesystem.TypeSystem, error) {
 	// Until this is solved: https://github.com/envoyproxy/protoc-gen-validate/issues/74
 	if len(req.GetTypeDefinitions()) > w.backend.MaxTypesPerAuthorizationModel() {
-		return nil, serverErrors.ExceededEntityLimit("type definitions in an authorization model", w.backend.MaxTypesPerAuthorizationModel())
+		return nil, nil, serverErrors.ExceededEntityLimit("type definitions in an authorization model", w.backend.MaxTypesPerAuthorizationModel())
 	}
 
 	// Fill in the schema version for old requests, which don't contain it, while we migrate to the new schema version.
@@ -75,24 +100,16 @@ func (w *WriteAuthorizationModelCommand) Execute(ctx context.Context, req *openf
 	modelSize := proto.Size(model)
 	if modelSize > w.maxAuthorizationModelSizeInBytes {
 		// Consider using serverErrors.ExceededEntityLimit.
-		return nil, status.Error(
+		return nil, nil, status.Error(
 			codes.Code(openfgav1.ErrorCode_exceeded_entity_limit),
 			fmt.Sprintf("model exceeds size limit: %d bytes vs %d bytes", modelSize, w.maxAuthorizationModelSizeInBytes),
 		)
 	}
 
-	_, err := typesystem.NewAndValidate(ctx, model)
-	if err != nil {
-		return nil, serverErrors.InvalidAuthorizationModelInput(err)
-	}
-
-	err = w.backend.WriteAuthorizationModel(ctx, req.GetStoreId(), model)
+	typesys, err := typesystem.NewAndValidate(ctx, model)
 	if err != nil {
-		return nil, serverErrors.
-			HandleError("Error writing authorization model configuration", err)
+		return nil, nil, serverErrors.InvalidAuthorizationModelInput(err)
 	}
 
-	return &openfgav1.WriteAuthorizationModelResponse{
-		AuthorizationModelId: model.GetId(),
-	}, nil
+	return model, typesys, nil
 }
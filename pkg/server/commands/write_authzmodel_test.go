@@ -1263,6 +1263,45 @@ func TestWriteAuthorizationModel(t *testing.T) {
 	}
 }
 
+func TestWriteAuthorizationModelDryRun(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockDatastore := mockstorage.NewMockOpenFGADatastore(mockController)
+	mockDatastore.EXPECT().MaxTypesPerAuthorizationModel().AnyTimes().Return(100)
+
+	cmd := NewWriteAuthorizationModelCommand(mockDatastore)
+
+	t.Run("does_not_write_the_model", func(t *testing.T) {
+		typesys, err := cmd.DryRun(context.Background(), &openfgav1.WriteAuthorizationModelRequest{
+			StoreId: ulid.Make().String(),
+			TypeDefinitions: parser.MustTransformDSLToProto(`
+				model
+					schema 1.1
+				type user
+				type doc
+					relations
+						define viewer: [user]
+			`).GetTypeDefinitions(),
+			SchemaVersion: typesystem.SchemaVersion1_1,
+		})
+		require.NoError(t, err)
+		_, err = typesys.GetRelation("doc", "viewer")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid_model", func(t *testing.T) {
+		_, err := cmd.DryRun(context.Background(), &openfgav1.WriteAuthorizationModelRequest{
+			StoreId: ulid.Make().String(),
+			TypeDefinitions: []*openfgav1.TypeDefinition{
+				{Type: "user"},
+				{Type: "user"},
+			},
+			SchemaVersion: typesystem.SchemaVersion1_1,
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_invalid_authorization_model), status.Code(err))
+	})
+}
+
 func buildModelWithManyTypes(maxTypesPerAuthorizationModel int) []*openfgav1.TypeDefinition {
 	items := make([]*openfgav1.TypeDefinition, maxTypesPerAuthorizationModel+1)
 	items[0] = &openfgav1.TypeDefinition{
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/openfga/openfga/pkg/storage/sqlcommon"
 )
 
 const (
@@ -20,6 +22,7 @@ const (
 	DefaultMaxAuthorizationModelCacheSize   = 100000
 	DefaultMaxTypesystemCacheSize           = 100000
 	DefaultChangelogHorizonOffset           = 0
+	DefaultWatchPollInterval                = time.Second
 	DefaultResolveNodeLimit                 = 25
 	DefaultResolveNodeBreadthLimit          = 10
 	DefaultListObjectsDeadline              = 3 * time.Second
@@ -53,6 +56,8 @@ const (
 	DefaultCacheControllerConfigEnabled = false
 	DefaultCacheControllerConfigTTL     = 10 * time.Second
 
+	DefaultCacheControllerConfigNotificationsEnabled = false
+
 	DefaultShadowCheckResolverTimeout = 1 * time.Second
 
 	DefaultShadowListObjectsQueryTimeout       = 1 * time.Second
@@ -90,6 +95,23 @@ const (
 	DefaultSharedIteratorMaxAdmissionTime = 10 * time.Second
 	DefaultSharedIteratorMaxIdleTime      = 1 * time.Second
 
+	DefaultDSQLOCCRetryInitialInterval = sqlcommon.DefaultOCCRetryInitialInterval
+	DefaultDSQLOCCRetryMaxInterval     = sqlcommon.DefaultOCCRetryMaxInterval
+	DefaultDSQLOCCRetryMaxElapsedTime  = sqlcommon.DefaultOCCRetryMaxElapsedTime
+	DefaultDSQLOCCRetryMaxAttempts     = sqlcommon.DefaultOCCRetryMaxAttempts
+	DefaultDSQLOCCRetryJitter          = sqlcommon.DefaultOCCRetryJitter
+	DefaultDSQLMaxRowsPerTransaction   = sqlcommon.DefaultDSQLMaxRowsPerTransaction
+
+	DefaultChangelogRetentionEnabled    = false
+	DefaultChangelogRetentionMaxAge     = 0
+	DefaultChangelogRetentionMaxChanges = 0
+	DefaultChangelogRetentionInterval   = time.Hour
+	DefaultChangelogRetentionBatchSize  = 1000
+
+	DefaultTupleExpiryReaperEnabled   = false
+	DefaultTupleExpiryReaperInterval  = time.Minute
+	DefaultTupleExpiryReaperBatchSize = 1000
+
 	DefaultPlannerEvictionThreshold = 0
 	DefaultPlannerCleanupInterval   = 0
 
@@ -111,9 +133,57 @@ type DatastoreMetricsConfig struct {
 	Enabled bool
 }
 
+// DSQLOCCRetryConfig defines how writes that fail with an Aurora DSQL optimistic
+// concurrency control (OCC) conflict are retried.
+type DSQLOCCRetryConfig struct {
+	// InitialInterval is the wait time before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the wait time between two retries.
+	MaxInterval time.Duration
+
+	// MaxElapsedTime is the total time after which retries stop.
+	MaxElapsedTime time.Duration
+
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// 0 means attempts are only bounded by MaxElapsedTime.
+	MaxAttempts int
+
+	// Jitter is the randomization factor applied to each retry interval, between 0 and 1.
+	Jitter float64
+}
+
+// DatastoreDSQLConfig defines Aurora DSQL specific datastore settings.
+type DatastoreDSQLConfig struct {
+	OCCRetry DSQLOCCRetryConfig
+
+	// MaxRowsPerTransaction is the maximum number of rows, including secondary index entries,
+	// that a single DSQL transaction may modify. It bounds the number of tuples per write.
+	MaxRowsPerTransaction int
+}
+
+// DatastoreRemoteTLSConfig defines the TLS settings of the connection to a remote datastore.
+type DatastoreRemoteTLSConfig struct {
+	Enabled bool
+
+	// CAPath is the file path of the CA certificates used to verify the remote datastore. When
+	// empty, the system certificate pool is used.
+	CAPath string `mapstructure:"ca"`
+
+	// CertPath and KeyPath are the file paths of the client certificate and key, only needed
+	// when the remote datastore requires mutual TLS.
+	CertPath string `mapstructure:"cert"`
+	KeyPath  string `mapstructure:"key"`
+}
+
+// DatastoreRemoteConfig defines settings of the 'remote' datastore engine.
+type DatastoreRemoteConfig struct {
+	TLS DatastoreRemoteTLSConfig
+}
+
 // DatastoreConfig defines OpenFGA server configurations for datastore specific settings.
 type DatastoreConfig struct {
-	// Engine is the datastore engine to use (e.g. 'memory', 'postgres', 'mysql', 'sqlite')
+	// Engine is the datastore engine to use (e.g. 'memory', 'postgres', 'mysql', 'sqlite', 'remote')
 	Engine            string
 	URI               string `json:"-"` // private field, won't be logged
 	SecondaryURI      string `json:"-"` // private field, won't be logged
@@ -151,6 +221,12 @@ type DatastoreConfig struct {
 
 	// Metrics is configuration for the Datastore metrics.
 	Metrics DatastoreMetricsConfig
+
+	// DSQL is configuration only used by the 'dsql' engine.
+	DSQL DatastoreDSQLConfig
+
+	// Remote is configuration only used by the 'remote' engine.
+	Remote DatastoreRemoteConfig
 }
 
 // GRPCConfig defines OpenFGA server configurations for grpc server specific settings.
@@ -282,6 +358,10 @@ type SharedIteratorConfig struct {
 type CacheControllerConfig struct {
 	Enabled bool
 	TTL     time.Duration
+
+	// NotificationsEnabled broadcasts an invalidation after every write of tuples, which the cache
+	// controllers apply without waiting for their next poll of the changelog.
+	NotificationsEnabled bool
 }
 
 // DispatchThrottlingConfig defines configurations for dispatch throttling.
@@ -306,6 +386,30 @@ type AccessControlConfig struct {
 	ModelID string
 }
 
+// ChangelogRetentionConfig defines how long the changes of the stores are kept in the changelog.
+// Changes older than MaxAge, and changes beyond the MaxChanges most recent ones of a store, are
+// pruned every Interval. A zero MaxAge or MaxChanges disables that limit.
+type ChangelogRetentionConfig struct {
+	Enabled    bool
+	MaxAge     time.Duration
+	MaxChanges int
+	Interval   time.Duration
+
+	// BatchSize is the maximum number of changes deleted in a single statement.
+	BatchSize int
+}
+
+// TupleExpiryReaperConfig defines how the expired tuples of the stores are deleted. Reads ignore
+// expired tuples regardless, the reaper deletes them from storage every Interval and records their
+// deletion in the changelog.
+type TupleExpiryReaperConfig struct {
+	Enabled  bool
+	Interval time.Duration
+
+	// BatchSize is the maximum number of expired tuples deleted in a single transaction.
+	BatchSize int
+}
+
 type PlannerConfig struct {
 	EvictionThreshold time.Duration
 	CleanupInterval   time.Duration
@@ -373,6 +477,10 @@ type Config struct {
 	// after this offset will not be included in the response of ReadChanges.
 	ChangelogHorizonOffset int
 
+	// WatchPollInterval is the interval at which a Watch stream reads the changelog once it
+	// has caught up with the latest changes.
+	WatchPollInterval time.Duration
+
 	// Experimentals is a list of the experimental features to enable in the OpenFGA server.
 	Experimentals []string
 
@@ -417,6 +525,8 @@ type Config struct {
 	ListObjectsIteratorCache      IteratorCacheConfig
 	SharedIterator                SharedIteratorConfig
 	Planner                       PlannerConfig
+	ChangelogRetention            ChangelogRetentionConfig
+	TupleExpiryReaper             TupleExpiryReaperConfig
 
 	RequestDurationDatastoreQueryCountBuckets []string
 	RequestDurationDispatchCountBuckets       []string
@@ -472,10 +582,24 @@ func (cfg *Config) VerifyServerSettings() error {
 		return errors.New("listObjectsDeadline must be non-negative time duration")
 	}
 
+	if cfg.WatchPollInterval <= 0 {
+		return errors.New("watchPollInterval must be a positive time duration")
+	}
+
 	if cfg.ListUsersDeadline < 0 {
 		return errors.New("listUsersDeadline must be non-negative time duration")
 	}
 
+	err = cfg.VerifyChangelogRetentionConfig()
+	if err != nil {
+		return err
+	}
+
+	err = cfg.VerifyTupleExpiryReaperConfig()
+	if err != nil {
+		return err
+	}
+
 	if cfg.MaxConditionEvaluationCost < 100 {
 		return errors.New("maxConditionsEvaluationCosts less than 100 can cause API compatibility problems with Conditions")
 	}
@@ -488,6 +612,40 @@ func (cfg *Config) VerifyServerSettings() error {
 		return errors.New("datastore MinOpenConns must not be less than datastore MinIdleConns")
 	}
 
+	if err := cfg.verifyDSQLOCCRetryConfig(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cfg *Config) verifyDSQLOCCRetryConfig() error {
+	occRetry := cfg.Datastore.DSQL.OCCRetry
+
+	if occRetry.InitialInterval <= 0 {
+		return errors.New("'datastore.dsql.occRetry.initialInterval' must be greater than zero")
+	}
+
+	if occRetry.MaxInterval < occRetry.InitialInterval {
+		return errors.New("'datastore.dsql.occRetry.maxInterval' must not be less than 'datastore.dsql.occRetry.initialInterval'")
+	}
+
+	if occRetry.MaxElapsedTime < 0 || occRetry.MaxAttempts < 0 {
+		return errors.New("'datastore.dsql.occRetry.maxElapsedTime' and 'datastore.dsql.occRetry.maxAttempts' must be non-negative")
+	}
+
+	if occRetry.MaxElapsedTime == 0 && occRetry.MaxAttempts == 0 {
+		return errors.New("at least one of 'datastore.dsql.occRetry.maxElapsedTime' or 'datastore.dsql.occRetry.maxAttempts' must be greater than zero")
+	}
+
+	if occRetry.Jitter < 0 || occRetry.Jitter > 1 {
+		return errors.New("'datastore.dsql.occRetry.jitter' must be between 0 and 1")
+	}
+
+	if cfg.Datastore.DSQL.MaxRowsPerTransaction <= 0 {
+		return errors.New("'datastore.dsql.maxRowsPerTransaction' must be greater than zero")
+	}
+
 	return nil
 }
 
@@ -679,6 +837,46 @@ func (cfg *Config) verifyCacheConfig() error {
 	if cfg.CacheController.Enabled && cfg.CacheController.TTL <= 0 {
 		return errors.New("'cacheController.ttl' must be greater than zero")
 	}
+	if cfg.CacheController.NotificationsEnabled && !cfg.CacheController.Enabled {
+		return errors.New("'cacheController.notificationsEnabled' requires 'cacheController.enabled'")
+	}
+	return nil
+}
+
+// VerifyChangelogRetentionConfig ensures ChangelogRetentionConfig is valid.
+func (cfg *Config) VerifyChangelogRetentionConfig() error {
+	if !cfg.ChangelogRetention.Enabled {
+		return nil
+	}
+	if cfg.ChangelogRetention.MaxAge < 0 {
+		return errors.New("'changelogRetention.maxAge' must be a non-negative time duration")
+	}
+	if cfg.ChangelogRetention.MaxChanges < 0 {
+		return errors.New("'changelogRetention.maxChanges' must be non-negative")
+	}
+	if cfg.ChangelogRetention.MaxAge == 0 && cfg.ChangelogRetention.MaxChanges == 0 {
+		return errors.New("'changelogRetention.maxAge' or 'changelogRetention.maxChanges' must be set when 'changelogRetention.enabled'")
+	}
+	if cfg.ChangelogRetention.Interval <= 0 {
+		return errors.New("'changelogRetention.interval' must be greater than zero")
+	}
+	if cfg.ChangelogRetention.BatchSize <= 0 {
+		return errors.New("'changelogRetention.batchSize' must be greater than zero")
+	}
+	return nil
+}
+
+// VerifyTupleExpiryReaperConfig ensures TupleExpiryReaperConfig is valid.
+func (cfg *Config) VerifyTupleExpiryReaperConfig() error {
+	if !cfg.TupleExpiryReaper.Enabled {
+		return nil
+	}
+	if cfg.TupleExpiryReaper.Interval <= 0 {
+		return errors.New("'tupleExpiryReaper.interval' must be greater than zero")
+	}
+	if cfg.TupleExpiryReaper.BatchSize <= 0 {
+		return errors.New("'tupleExpiryReaper.batchSize' must be greater than zero")
+	}
 	return nil
 }
 
@@ -700,6 +898,7 @@ func DefaultConfig() *Config {
 		MaxConcurrentReadsForListUsers:            DefaultMaxConcurrentReadsForListUsers,
 		MaxConditionEvaluationCost:                DefaultMaxConditionEvaluationCost,
 		ChangelogHorizonOffset:                    DefaultChangelogHorizonOffset,
+		WatchPollInterval:                         DefaultWatchPollInterval,
 		ResolveNodeLimit:                          DefaultResolveNodeLimit,
 		ResolveNodeBreadthLimit:                   DefaultResolveNodeBreadthLimit,
 		Experimentals:                             []string{},
@@ -718,6 +917,16 @@ func DefaultConfig() *Config {
 			MaxIdleConns:           10,
 			MinOpenConns:           0,
 			MaxOpenConns:           30,
+			DSQL: DatastoreDSQLConfig{
+				OCCRetry: DSQLOCCRetryConfig{
+					InitialInterval: DefaultDSQLOCCRetryInitialInterval,
+					MaxInterval:     DefaultDSQLOCCRetryMaxInterval,
+					MaxElapsedTime:  DefaultDSQLOCCRetryMaxElapsedTime,
+					MaxAttempts:     DefaultDSQLOCCRetryMaxAttempts,
+					Jitter:          DefaultDSQLOCCRetryJitter,
+				},
+				MaxRowsPerTransaction: DefaultDSQLMaxRowsPerTransaction,
+			},
 		},
 		GRPC: GRPCConfig{
 			Addr: "0.0.0.0:8081",
@@ -783,8 +992,9 @@ func DefaultConfig() *Config {
 			Limit:   DefaultSharedIteratorLimit,
 		},
 		CacheController: CacheControllerConfig{
-			Enabled: DefaultCacheControllerConfigEnabled,
-			TTL:     DefaultCacheControllerConfigTTL,
+			Enabled:              DefaultCacheControllerConfigEnabled,
+			TTL:                  DefaultCacheControllerConfigTTL,
+			NotificationsEnabled: DefaultCacheControllerConfigNotificationsEnabled,
 		},
 		CheckDispatchThrottling: DispatchThrottlingConfig{
 			Enabled:      DefaultCheckDispatchThrottlingEnabled,
@@ -827,6 +1037,18 @@ func DefaultConfig() *Config {
 			EvictionThreshold: DefaultPlannerEvictionThreshold,
 			CleanupInterval:   DefaultPlannerCleanupInterval,
 		},
+		ChangelogRetention: ChangelogRetentionConfig{
+			Enabled:    DefaultChangelogRetentionEnabled,
+			MaxAge:     DefaultChangelogRetentionMaxAge,
+			MaxChanges: DefaultChangelogRetentionMaxChanges,
+			Interval:   DefaultChangelogRetentionInterval,
+			BatchSize:  DefaultChangelogRetentionBatchSize,
+		},
+		TupleExpiryReaper: TupleExpiryReaperConfig{
+			Enabled:   DefaultTupleExpiryReaperEnabled,
+			Interval:  DefaultTupleExpiryReaperInterval,
+			BatchSize: DefaultTupleExpiryReaperBatchSize,
+		},
 	}
 }
 
@@ -360,6 +360,14 @@ func TestVerifyConfig(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("non_positive_watch_poll_interval", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.WatchPollInterval = 0
+
+		err := cfg.Verify()
+		require.EqualError(t, err, "watchPollInterval must be a positive time duration")
+	})
+
 	t.Run("negative_list_users_deadline", func(t *testing.T) {
 		cfg := DefaultConfig()
 		cfg.RequestTimeout = 0
@@ -543,6 +551,90 @@ func TestVerifyConfig(t *testing.T) {
 			err := cfg.Verify()
 			require.NoError(t, err)
 		})
+		t.Run("notifications_but_disabled", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.CacheController.Enabled = false
+			cfg.CacheController.NotificationsEnabled = true
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("changelog_retention", func(t *testing.T) {
+		t.Run("enable_without_limits", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Enabled = true
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("enable_with_negative_max_age", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Enabled = true
+			cfg.ChangelogRetention.MaxAge = -time.Hour
+			cfg.ChangelogRetention.MaxChanges = 10
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("enable_with_negative_max_changes", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Enabled = true
+			cfg.ChangelogRetention.MaxChanges = -1
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("enable_but_interval_zero", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Enabled = true
+			cfg.ChangelogRetention.MaxAge = time.Hour
+			cfg.ChangelogRetention.Interval = 0
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("enable_but_batch_size_zero", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Enabled = true
+			cfg.ChangelogRetention.MaxAge = time.Hour
+			cfg.ChangelogRetention.BatchSize = 0
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("enable_with_max_age", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Enabled = true
+			cfg.ChangelogRetention.MaxAge = time.Hour
+			err := cfg.Verify()
+			require.NoError(t, err)
+		})
+		t.Run("disable_without_limits", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.ChangelogRetention.Interval = 0
+			err := cfg.Verify()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("tuple_expiry_reaper", func(t *testing.T) {
+		t.Run("enable_but_interval_zero", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.TupleExpiryReaper.Enabled = true
+			cfg.TupleExpiryReaper.Interval = 0
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("enable_but_batch_size_zero", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.TupleExpiryReaper.Enabled = true
+			cfg.TupleExpiryReaper.BatchSize = 0
+			err := cfg.Verify()
+			require.Error(t, err)
+		})
+		t.Run("disable_but_interval_zero", func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.TupleExpiryReaper.Enabled = false
+			cfg.TupleExpiryReaper.Interval = 0
+			err := cfg.Verify()
+			require.NoError(t, err)
+		})
 	})
 
 	t.Run("prints_warning_when_log_level_is_none", func(t *testing.T) {
@@ -889,6 +981,65 @@ func TestVerifyServerSettings(t *testing.T) {
 			require.NoError(t, err)
 		})
 	})
+
+	t.Run("verify_dsql_occ_retry_settings", func(t *testing.T) {
+		tests := map[string]struct {
+			modify      func(*DSQLOCCRetryConfig)
+			expectedErr string
+		}{
+			"default_is_valid": {
+				modify: func(*DSQLOCCRetryConfig) {},
+			},
+			"zero_initial_interval": {
+				modify:      func(c *DSQLOCCRetryConfig) { c.InitialInterval = 0 },
+				expectedErr: "'datastore.dsql.occRetry.initialInterval' must be greater than zero",
+			},
+			"max_interval_less_than_initial_interval": {
+				modify:      func(c *DSQLOCCRetryConfig) { c.MaxInterval = c.InitialInterval / 2 },
+				expectedErr: "'datastore.dsql.occRetry.maxInterval' must not be less than 'datastore.dsql.occRetry.initialInterval'",
+			},
+			"negative_max_attempts": {
+				modify:      func(c *DSQLOCCRetryConfig) { c.MaxAttempts = -1 },
+				expectedErr: "'datastore.dsql.occRetry.maxElapsedTime' and 'datastore.dsql.occRetry.maxAttempts' must be non-negative",
+			},
+			"unbounded_retries": {
+				modify: func(c *DSQLOCCRetryConfig) {
+					c.MaxElapsedTime = 0
+					c.MaxAttempts = 0
+				},
+				expectedErr: "at least one of 'datastore.dsql.occRetry.maxElapsedTime' or 'datastore.dsql.occRetry.maxAttempts' must be greater than zero",
+			},
+			"bounded_by_attempts_only": {
+				modify: func(c *DSQLOCCRetryConfig) {
+					c.MaxElapsedTime = 0
+					c.MaxAttempts = 5
+				},
+			},
+			"jitter_out_of_range": {
+				modify:      func(c *DSQLOCCRetryConfig) { c.Jitter = 1.5 },
+				expectedErr: "'datastore.dsql.occRetry.jitter' must be between 0 and 1",
+			},
+		}
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				cfg := DefaultConfig()
+				test.modify(&cfg.Datastore.DSQL.OCCRetry)
+				err := cfg.VerifyServerSettings()
+				if test.expectedErr == "" {
+					require.NoError(t, err)
+				} else {
+					require.EqualError(t, err, test.expectedErr)
+				}
+			})
+		}
+	})
+
+	t.Run("dsql_max_rows_per_transaction_must_be_positive", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Datastore.DSQL.MaxRowsPerTransaction = 0
+		err := cfg.VerifyServerSettings()
+		require.EqualError(t, err, "'datastore.dsql.maxRowsPerTransaction' must be greater than zero")
+	})
 }
 
 func TestVerifyBinarySettings(t *testing.T) {
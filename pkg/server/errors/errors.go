@@ -33,6 +33,21 @@ var (
 
 	// ErrTransactionThrottled can apply when a limit is hit at the database level.
 	ErrTransactionThrottled = status.Error(codes.ResourceExhausted, "transaction was throttled by the datastore")
+
+	// ErrTransactionRetriesExhausted applies when the datastore gave up retrying a transaction
+	// that kept conflicting with concurrent ones. The client may retry the request.
+	ErrTransactionRetriesExhausted = status.Error(codes.Aborted, "transaction aborted after repeated conflicts with concurrent modifications, please retry")
+
+	// ErrChangelogTruncated applies when reading changes from a continuation token before the
+	// retention horizon of the changelog, whose following changes were pruned.
+	ErrChangelogTruncated = status.Error(codes.OutOfRange, "The changes following the continuation token were pruned by the changelog retention policy, read the changes again without a continuation token")
+
+	// ErrAsOfBeforeChangelogRetention applies when evaluating a request as of a point in time
+	// whose tuples cannot be reconstructed, as the changes they need were pruned from the changelog.
+	ErrAsOfBeforeChangelogRetention = status.Error(codes.OutOfRange, "The point in time precedes the changes kept by the changelog retention policy")
+
+	// ErrTransactionTooLarge applies when a write does not fit in a single datastore transaction.
+	ErrTransactionTooLarge = status.Error(codes.Code(openfgav1.ErrorCode_exceeded_entity_limit), "The number of write operations exceeds what the datastore can commit in a single transaction")
 )
 
 type InternalError struct {
@@ -123,6 +138,10 @@ func HandleError(public string, err error) error {
 	switch {
 	case errors.Is(err, storage.ErrTransactionThrottled):
 		return ErrTransactionThrottled
+	case errors.Is(err, storage.ErrTransactionRetriesExhausted):
+		return ErrTransactionRetriesExhausted
+	case errors.Is(err, storage.ErrTransactionTooLarge):
+		return ErrTransactionTooLarge
 	case errors.Is(err, context.Canceled):
 		// cancel by a client is not an "internal server error"
 		return ErrRequestCancelled
@@ -132,6 +151,10 @@ func HandleError(public string, err error) error {
 		return ErrInvalidStartTime
 	case errors.Is(err, storage.ErrInvalidContinuationToken):
 		return ErrInvalidContinuationToken
+	case errors.Is(err, storage.ErrChangelogTruncated):
+		return ErrChangelogTruncated
+	case errors.Is(err, storage.ErrHistoryPruned):
+		return ErrAsOfBeforeChangelogRetention
 	default:
 		return NewInternalError(public, err)
 	}
@@ -60,6 +60,10 @@ func TestHandleErrors(t *testing.T) {
 			storageErr:              storage.ErrInvalidContinuationToken,
 			expectedTranslatedError: ErrInvalidContinuationToken,
 		},
+		`changelog_truncated`: {
+			storageErr:              storage.ErrChangelogTruncated,
+			expectedTranslatedError: ErrChangelogTruncated,
+		},
 		`context_cancelled`: {
 			storageErr:              context.Canceled,
 			expectedTranslatedError: ErrRequestCancelled,
@@ -72,6 +76,14 @@ func TestHandleErrors(t *testing.T) {
 			storageErr:              storage.ErrTransactionThrottled,
 			expectedTranslatedError: ErrTransactionThrottled,
 		},
+		`transaction_retries_exhausted`: {
+			storageErr:              fmt.Errorf("%w: sql error", storage.ErrTransactionRetriesExhausted),
+			expectedTranslatedError: ErrTransactionRetriesExhausted,
+		},
+		`transaction_too_large`: {
+			storageErr:              fmt.Errorf("%w: 700 tuple changes", storage.ErrTransactionTooLarge),
+			expectedTranslatedError: ErrTransactionTooLarge,
+		},
 	}
 	for testName, test := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -45,13 +46,23 @@ func (s *Server) Expand(ctx context.Context, req *openfgav1.ExpandRequest) (*ope
 
 	storeID := req.GetStoreId()
 
-	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
+	asOf, err := asOfFromContext(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, req.GetAuthorizationModelId(), asOf)
 	if err != nil {
 		return nil, err
 	}
 	req.AuthorizationModelId = typesys.GetAuthorizationModelID() // the resolved model id
 
-	q := commands.NewExpandQuery(s.datastore, commands.WithExpandQueryLogger(s.logger))
+	datastore, _, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	q := commands.NewExpandQuery(datastore, commands.WithExpandQueryLogger(s.logger))
 	return q.Execute(
 		typesystem.ContextWithTypesystem(ctx, typesys),
 		&openfgav1.ExpandRequest{
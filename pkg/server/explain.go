@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+)
+
+// explainFromContext returns whether the [ExplainHeader] of the request asks for the proof trees
+// of the checks. It returns false if the header is absent.
+func explainFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(ExplainHeader)
+	if len(values) == 0 {
+		return false, nil
+	}
+
+	explain, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, serverErrors.ValidationError(fmt.Errorf("invalid %s header: %q is not a boolean", ExplainHeader, values[0]))
+	}
+	return explain, nil
+}
+
+// maxCheckExplanationHeaderSize is the maximum size of the [CheckExplanationHeader], which stays
+// below the header size limits of common proxies.
+const maxCheckExplanationHeaderSize = 8 << 10
+
+// checkExplainAuthz checks that the caller of an explained check of storeID may call Read or
+// Expand, since the explanations reveal the tuples of the store.
+func (s *Server) checkExplainAuthz(ctx context.Context, storeID string) error {
+	if err := s.checkAuthz(ctx, storeID, apimethod.Expand); err == nil {
+		return nil
+	}
+	return s.checkAuthz(ctx, storeID, apimethod.Read)
+}
+
+// setCheckExplanationHeader sets the [CheckExplanationHeader] of the response to the explanations
+// as JSON. explanations returns the explanations truncated at depth, see
+// [graph.CheckExplanation.Truncate], or whole for a negative depth. The explanations are truncated
+// at the deepest depth at which the header fits in maxCheckExplanationHeaderSize, and the header is
+// not set if they do not fit even without children.
+func (s *Server) setCheckExplanationHeader(ctx context.Context, explanations func(depth int) any) error {
+	value, err := explanationHeaderValue(explanations(-1))
+	if err != nil {
+		return err
+	}
+
+	if len(value) > maxCheckExplanationHeaderSize {
+		truncated := ""
+		for depth := 0; ; depth++ {
+			value, err := explanationHeaderValue(explanations(depth))
+			if err != nil {
+				return err
+			}
+			if len(value) > maxCheckExplanationHeaderSize {
+				break
+			}
+			truncated = value
+		}
+		if truncated == "" {
+			s.logger.WarnWithContext(ctx, "check explanation exceeds the header size limit", zap.Int("size", len(value)))
+			return nil
+		}
+		value = truncated
+	}
+
+	s.transport.SetHeader(ctx, CheckExplanationHeader, value)
+	return nil
+}
+
+// explanationHeaderValue returns explanations as ASCII JSON.
+func explanationHeaderValue(explanations any) (string, error) {
+	b, err := json.Marshal(explanations)
+	if err != nil {
+		return "", serverErrors.HandleError("", err)
+	}
+	return asciiJSON(b), nil
+}
+
+// asciiJSON returns the JSON b with its non-ASCII characters escaped, since header values must be
+// printable ASCII.
+func asciiJSON(b []byte) string {
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		switch {
+		case r < utf8.RuneSelf:
+			sb.WriteRune(r)
+		case r > 0xFFFF:
+			r1, r2 := utf16.EncodeRune(r)
+			fmt.Fprintf(&sb, `\u%04x\u%04x`, r1, r2)
+		default:
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"errors"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	"github.com/openfga/openfga/pkg/server/commands"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	"github.com/openfga/openfga/pkg/telemetry"
+)
+
+// ImportTuples writes the tuples streamed by the client in bulk, see [adminv1.AdminServiceServer].
+// The caller must be allowed to write to the store.
+func (s *Server) ImportTuples(srv grpc.BidiStreamingServer[adminv1.ImportTuplesRequest, adminv1.ImportTuplesResponse]) error {
+	req, err := srv.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "the stream ended before the first request")
+		}
+		return err
+	}
+	storeID := req.GetStoreId()
+
+	ctx, span := tracer.Start(srv.Context(), apimethod.ImportTuples.String(), trace.WithAttributes(
+		attribute.String("store_id", storeID),
+	))
+	defer span.End()
+
+	// The request has no generated validation, reuse the rules of Write for the fields they share.
+	if err := (&openfgav1.WriteRequest{StoreId: storeID, AuthorizationModelId: req.GetAuthorizationModelId()}).Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  apimethod.ImportTuples.String(),
+	})
+
+	if err := s.checkAuthz(ctx, storeID, apimethod.ImportTuples); err != nil {
+		return err
+	}
+
+	if s.bulkLoader == nil {
+		return status.Error(codes.Unimplemented, "the datastore does not support importing tuples")
+	}
+
+	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
+	if err != nil {
+		return err
+	}
+
+	c := commands.NewImportTuplesCommand(s.bulkLoader, typesys,
+		commands.WithImportTuplesCmdLogger(s.logger),
+		commands.WithImportTuplesCmdSkipChangelog(req.GetSkipChangelog()),
+	)
+	for {
+		if req.GetStoreId() != "" && req.GetStoreId() != storeID {
+			return status.Errorf(codes.InvalidArgument, "the store_id of the stream is '%s', got '%s'", storeID, req.GetStoreId())
+		}
+
+		resp, err := c.Execute(ctx, storeID, req.GetTuples())
+		if err != nil {
+			return err
+		}
+		if err := srv.Send(resp); err != nil {
+			return err
+		}
+
+		req, err = srv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
@@ -22,6 +23,7 @@ import (
 	"github.com/openfga/openfga/pkg/server/commands"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
 	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
 	"github.com/openfga/openfga/pkg/telemetry"
 	"github.com/openfga/openfga/pkg/typesystem"
 )
@@ -60,13 +62,23 @@ func (s *Server) ListObjects(ctx context.Context, req *openfgav1.ListObjectsRequ
 		return nil, err
 	}
 
-	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
+	asOf, err := asOfFromContext(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, req.GetAuthorizationModelId(), asOf)
 	if err != nil {
 		return nil, err
 	}
 	req.AuthorizationModelId = typesys.GetAuthorizationModelID() // the resolved model id
 
-	builder := s.getListObjectsCheckResolverBuilder(storeID)
+	datastore, cacheSettings, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := s.getListObjectsCheckResolverBuilder(storeID, checkResolverOptsAsOf(asOf)...)
 	checkResolver, checkResolverCloser, err := builder.Build()
 	if err != nil {
 		return nil, err
@@ -74,7 +86,7 @@ func (s *Server) ListObjects(ctx context.Context, req *openfgav1.ListObjectsRequ
 	defer checkResolverCloser()
 
 	q, err := commands.NewListObjectsQueryWithShadowConfig(
-		s.datastore,
+		datastore,
 		checkResolver,
 		commands.NewShadowListObjectsQueryConfig(
 			commands.WithShadowListObjectsQueryEnabled(s.featureFlagClient.Boolean(serverconfig.ExperimentalShadowListObjects, req.GetStoreId())),
@@ -83,30 +95,9 @@ func (s *Server) ListObjects(ctx context.Context, req *openfgav1.ListObjectsRequ
 			commands.WithShadowListObjectsQueryLogger(s.logger),
 		),
 		storeID,
-		commands.WithLogger(s.logger),
-		commands.WithListObjectsDeadline(s.listObjectsDeadline),
-		commands.WithListObjectsMaxResults(s.listObjectsMaxResults),
-		commands.WithDispatchThrottlerConfig(threshold.Config{
-			Throttler:    s.listObjectsDispatchThrottler,
-			Enabled:      s.listObjectsDispatchThrottlingEnabled,
-			Threshold:    s.listObjectsDispatchDefaultThreshold,
-			MaxThreshold: s.listObjectsDispatchThrottlingMaxThreshold,
-		}),
-		commands.WithResolveNodeLimit(s.resolveNodeLimit),
-		commands.WithResolveNodeBreadthLimit(s.resolveNodeBreadthLimit),
-		commands.WithMaxConcurrentReads(s.maxConcurrentReadsForListObjects),
-		commands.WithListObjectsCache(s.sharedDatastoreResources, s.cacheSettings),
-		commands.WithListObjectsDatastoreThrottler(
-			s.featureFlagClient.Boolean(serverconfig.ExperimentalDatastoreThrottling, storeID),
-			s.listObjectsDatastoreThrottleThreshold,
-			s.listObjectsDatastoreThrottleDuration,
-		),
-		commands.WithListObjectsPipelineEnabled(s.featureFlagClient.Boolean(serverconfig.ExperimentalPipelineListObjects, storeID)),
-		commands.WithListObjectsChunkSize(s.listObjectsChunkSize),
-		commands.WithListObjectsBufferSize(s.listObjectsBufferSize),
-		commands.WithListObjectsNumProcs(s.listObjectsNumProcs),
-		commands.WithListObjectsPipeExtension(s.listObjectsPipeExtendAfter, s.listObjectsPipeMaxExtensions),
-		commands.WithFeatureFlagClient(s.featureFlagClient),
+		append(s.listObjectsQueryOptions(storeID, cacheSettings),
+			commands.WithListObjectsMaxResults(s.listObjectsMaxResults),
+		)...,
 	)
 	if err != nil {
 		return nil, serverErrors.NewInternalError("", err)
@@ -229,13 +220,23 @@ func (s *Server) StreamedListObjects(req *openfgav1.StreamedListObjectsRequest,
 		return err
 	}
 
-	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
+	asOf, err := asOfFromContext(ctx, start)
+	if err != nil {
+		return err
+	}
+
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, req.GetAuthorizationModelId(), asOf)
 	if err != nil {
 		return err
 	}
 	req.AuthorizationModelId = typesys.GetAuthorizationModelID() // the resolved model id
 
-	builder := s.getListObjectsCheckResolverBuilder(storeID)
+	datastore, cacheSettings, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return err
+	}
+
+	builder := s.getListObjectsCheckResolverBuilder(storeID, checkResolverOptsAsOf(asOf)...)
 	checkResolver, checkResolverCloser, err := builder.Build()
 	if err != nil {
 		return err
@@ -243,7 +244,7 @@ func (s *Server) StreamedListObjects(req *openfgav1.StreamedListObjectsRequest,
 	defer checkResolverCloser()
 
 	q, err := commands.NewListObjectsQueryWithShadowConfig(
-		s.datastore,
+		datastore,
 		checkResolver,
 		commands.NewShadowListObjectsQueryConfig(
 			commands.WithShadowListObjectsQueryEnabled(s.featureFlagClient.Boolean(serverconfig.ExperimentalShadowListObjects, storeID)),
@@ -252,20 +253,9 @@ func (s *Server) StreamedListObjects(req *openfgav1.StreamedListObjectsRequest,
 			commands.WithShadowListObjectsQueryLogger(s.logger),
 		),
 		storeID,
-		commands.WithLogger(s.logger),
-		commands.WithListObjectsDeadline(s.listObjectsDeadline),
-		commands.WithDispatchThrottlerConfig(threshold.Config{
-			Throttler:    s.listObjectsDispatchThrottler,
-			Enabled:      s.listObjectsDispatchThrottlingEnabled,
-			Threshold:    s.listObjectsDispatchDefaultThreshold,
-			MaxThreshold: s.listObjectsDispatchThrottlingMaxThreshold,
-		}),
-		commands.WithListObjectsMaxResults(s.listObjectsMaxResults),
-		commands.WithResolveNodeLimit(s.resolveNodeLimit),
-		commands.WithResolveNodeBreadthLimit(s.resolveNodeBreadthLimit),
-		commands.WithMaxConcurrentReads(s.maxConcurrentReadsForListObjects),
-		commands.WithListObjectsPipelineEnabled(s.featureFlagClient.Boolean(serverconfig.ExperimentalPipelineListObjects, storeID)),
-		commands.WithFeatureFlagClient(s.featureFlagClient),
+		append(s.listObjectsQueryOptions(storeID, cacheSettings),
+			commands.WithListObjectsMaxResults(s.listObjectsMaxResults),
+		)...,
 	)
 	if err != nil {
 		return serverErrors.NewInternalError("", err)
@@ -280,6 +270,21 @@ func (s *Server) StreamedListObjects(req *openfgav1.StreamedListObjectsRequest,
 		telemetry.TraceError(span, err)
 		return err
 	}
+	s.emitListObjectsMetrics(ctx, span, methodName, req.GetConsistency(), start, resolutionMetadata)
+
+	return nil
+}
+
+// emitListObjectsMetrics records the metrics of a ListObjects request of methodName that started at
+// start and was resolved with resolutionMetadata.
+func (s *Server) emitListObjectsMetrics(
+	ctx context.Context,
+	span trace.Span,
+	methodName string,
+	consistency openfgav1.ConsistencyPreference,
+	start time.Time,
+	resolutionMetadata *commands.ListObjectsResolutionMetadata,
+) {
 	datastoreQueryCount := float64(resolutionMetadata.DatastoreQueryCount.Load())
 
 	grpc_ctxtags.Extract(ctx).Set(datastoreQueryCountHistogramName, datastoreQueryCount)
@@ -312,7 +317,7 @@ func (s *Server) StreamedListObjects(req *openfgav1.StreamedListObjectsRequest,
 		methodName,
 		utils.Bucketize(uint(datastoreQueryCount), s.requestDurationByQueryHistogramBuckets),
 		utils.Bucketize(uint(resolutionMetadata.DispatchCounter.Load()), s.requestDurationByDispatchCountHistogramBuckets),
-		req.GetConsistency().String(),
+		consistency.String(),
 	).Observe(float64(time.Since(start).Milliseconds()))
 
 	wasDispatchThrottled := resolutionMetadata.DispatchThrottled.Load()
@@ -327,14 +332,159 @@ func (s *Server) StreamedListObjects(req *openfgav1.StreamedListObjectsRequest,
 	if wasDatastoreThrottled {
 		throttledRequestCounter.WithLabelValues(s.serviceName, methodName, throttleTypeDatastore).Inc()
 	}
+}
 
-	return nil
+// PaginatedListObjects returns a page of the objects of a specific type that a user has a relation
+// with, see [queryv1.QueryServiceServer].
+func (s *Server) PaginatedListObjects(ctx context.Context, req *queryv1.PaginatedListObjectsRequest) (*queryv1.PaginatedListObjectsResponse, error) {
+	start := time.Now()
+	storeID := req.GetStoreId()
+
+	ctx, span := tracer.Start(ctx, apimethod.PaginatedListObjects.String(), trace.WithAttributes(
+		attribute.String("store_id", storeID),
+		attribute.String("object_type", req.GetType()),
+		attribute.String("relation", req.GetRelation()),
+		attribute.String("user", req.GetUser()),
+		attribute.String("consistency", req.GetConsistency().String()),
+		attribute.Int("page_size", int(req.GetPageSize())),
+	))
+	defer span.End()
+
+	// The request has no generated validation, it has the fields of ListObjectsRequest and their rules.
+	listObjectsReq := &openfgav1.ListObjectsRequest{
+		StoreId:              storeID,
+		AuthorizationModelId: req.GetAuthorizationModelId(),
+		Type:                 req.GetType(),
+		Relation:             req.GetRelation(),
+		User:                 req.GetUser(),
+		ContextualTuples:     req.GetContextualTuples(),
+		Context:              req.GetContext(),
+		Consistency:          req.GetConsistency(),
+	}
+	if err := listObjectsReq.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = s.listObjectsMaxResults
+	}
+	if s.listObjectsMaxResults > 0 && pageSize > s.listObjectsMaxResults {
+		return nil, serverErrors.ValidationError(fmt.Errorf("page_size must not exceed %d", s.listObjectsMaxResults))
+	}
+
+	const methodName = "paginatedlistobjects"
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  methodName,
+	})
+
+	err := s.checkAuthz(ctx, storeID, apimethod.PaginatedListObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf, err := asOfFromContext(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+
+	// The following pages are evaluated with the model of the first page.
+	modelID := listObjectsReq.GetAuthorizationModelId()
+	if modelID == "" && req.GetContinuationToken() != "" {
+		modelID, err = commands.ListObjectsPageTokenModelID(s.encoder, req.GetContinuationToken())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, modelID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	listObjectsReq.AuthorizationModelId = typesys.GetAuthorizationModelID() // the resolved model id
+
+	datastore, cacheSettings, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := s.getListObjectsCheckResolverBuilder(storeID, checkResolverOptsAsOf(asOf)...)
+	checkResolver, checkResolverCloser, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	defer checkResolverCloser()
+
+	q, err := commands.NewListObjectsQuery(
+		datastore,
+		checkResolver,
+		storeID,
+		append(s.listObjectsQueryOptions(storeID, cacheSettings),
+			commands.WithListObjectsEncoder(s.encoder),
+		)...,
+	)
+	if err != nil {
+		return nil, serverErrors.NewInternalError("", err)
+	}
+
+	page, err := q.ExecutePage(
+		typesystem.ContextWithTypesystem(ctx, typesys),
+		listObjectsReq,
+		pageSize,
+		req.GetContinuationToken(),
+	)
+	if err != nil {
+		telemetry.TraceError(span, err)
+		return nil, err
+	}
+
+	s.emitListObjectsMetrics(ctx, span, methodName, req.GetConsistency(), start, page.ResolutionMetadata)
+
+	return &queryv1.PaginatedListObjectsResponse{
+		Objects:           page.Objects,
+		ContinuationToken: page.ContinuationToken,
+	}, nil
+}
+
+// listObjectsQueryOptions returns the options of the ListObjects queries of storeID, which read the
+// tuples with cacheSettings.
+func (s *Server) listObjectsQueryOptions(storeID string, cacheSettings serverconfig.CacheSettings) []commands.ListObjectsQueryOption {
+	return []commands.ListObjectsQueryOption{
+		commands.WithLogger(s.logger),
+		commands.WithListObjectsDeadline(s.listObjectsDeadline),
+		commands.WithDispatchThrottlerConfig(threshold.Config{
+			Throttler:    s.listObjectsDispatchThrottler,
+			Enabled:      s.listObjectsDispatchThrottlingEnabled,
+			Threshold:    s.listObjectsDispatchDefaultThreshold,
+			MaxThreshold: s.listObjectsDispatchThrottlingMaxThreshold,
+		}),
+		commands.WithResolveNodeLimit(s.resolveNodeLimit),
+		commands.WithResolveNodeBreadthLimit(s.resolveNodeBreadthLimit),
+		commands.WithMaxConcurrentReads(s.maxConcurrentReadsForListObjects),
+		commands.WithListObjectsCache(s.sharedDatastoreResources, cacheSettings),
+		commands.WithListObjectsDatastoreThrottler(
+			s.featureFlagClient.Boolean(serverconfig.ExperimentalDatastoreThrottling, storeID),
+			s.listObjectsDatastoreThrottleThreshold,
+			s.listObjectsDatastoreThrottleDuration,
+		),
+		commands.WithListObjectsPipelineEnabled(s.featureFlagClient.Boolean(serverconfig.ExperimentalPipelineListObjects, storeID)),
+		commands.WithListObjectsChunkSize(s.listObjectsChunkSize),
+		commands.WithListObjectsBufferSize(s.listObjectsBufferSize),
+		commands.WithListObjectsNumProcs(s.listObjectsNumProcs),
+		commands.WithListObjectsPipeExtension(s.listObjectsPipeExtendAfter, s.listObjectsPipeMaxExtensions),
+		commands.WithFeatureFlagClient(s.featureFlagClient),
+	}
 }
 
-func (s *Server) getListObjectsCheckResolverBuilder(storeID string) *graph.CheckResolverOrderedBuilder {
+// getListObjectsCheckResolverBuilder returns the builder of the check resolvers of the ListObjects
+// requests of storeID. The extra options are applied after the ones derived from the server
+// configuration.
+func (s *Server) getListObjectsCheckResolverBuilder(storeID string, extraOpts ...graph.CheckResolverOrderedBuilderOpt) *graph.CheckResolverOrderedBuilder {
 	checkCacheOptions, checkDispatchThrottlingOptions := s.getCheckResolverOptions()
 
-	return graph.NewOrderedCheckResolvers([]graph.CheckResolverOrderedBuilderOpt{
+	return graph.NewOrderedCheckResolvers(append([]graph.CheckResolverOrderedBuilderOpt{
 		graph.WithLocalCheckerOpts([]graph.LocalCheckerOption{
 			graph.WithResolveNodeBreadthLimit(s.resolveNodeBreadthLimit),
 			graph.WithOptimizations(s.featureFlagClient.Boolean(serverconfig.ExperimentalCheckOptimizations, storeID)),
@@ -342,5 +492,5 @@ func (s *Server) getListObjectsCheckResolverBuilder(storeID string) *graph.Check
 		}...),
 		graph.WithCachedCheckResolverOpts(s.cacheSettings.ShouldCacheCheckQueries(), checkCacheOptions...),
 		graph.WithDispatchThrottlingCheckResolverOpts(s.checkDispatchThrottlingEnabled, checkDispatchThrottlingOptions...),
-	}...)
+	}, extraOpts...)...)
 }
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	"github.com/openfga/openfga/pkg/server/commands"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
+	"github.com/openfga/openfga/pkg/telemetry"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+// maxListObjectsTargets is the maximum number of targets of a multi-type ListObjects request.
+const maxListObjectsTargets = 20
+
+// MultiTypeListObjects returns the objects of several types that a user has a relation with, see
+// [queryv1.QueryServiceServer].
+func (s *Server) MultiTypeListObjects(ctx context.Context, req *queryv1.MultiTypeListObjectsRequest) (*queryv1.MultiTypeListObjectsResponse, error) {
+	const methodName = "multitypelistobjects"
+
+	var results []*queryv1.TypedObjects
+
+	err := s.multiTypeListObjects(ctx, req, apimethod.MultiTypeListObjects, methodName,
+		func(targets []commands.ListObjectsTarget) {
+			results = make([]*queryv1.TypedObjects, len(targets))
+			for i, target := range targets {
+				results[i] = &queryv1.TypedObjects{
+					Type:     target.Type,
+					Relation: target.Relation,
+					Objects:  []string{},
+				}
+			}
+		},
+		func(target int, object string) error {
+			results[target].Objects = append(results[target].Objects, object)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		slices.Sort(result.Objects)
+	}
+
+	return &queryv1.MultiTypeListObjectsResponse{
+		Results: results,
+	}, nil
+}
+
+// StreamedMultiTypeListObjects streams the objects of several types that a user has a relation with,
+// see [queryv1.QueryServiceServer].
+func (s *Server) StreamedMultiTypeListObjects(req *queryv1.MultiTypeListObjectsRequest, srv queryv1.QueryService_StreamedMultiTypeListObjectsServer) error {
+	const methodName = "streamedmultitypelistobjects"
+
+	var targets []commands.ListObjectsTarget
+
+	return s.multiTypeListObjects(srv.Context(), req, apimethod.StreamedMultiTypeListObjects, methodName,
+		func(resolved []commands.ListObjectsTarget) {
+			targets = resolved
+		},
+		func(target int, object string) error {
+			return srv.Send(&queryv1.StreamedMultiTypeListObjectsResponse{
+				Type:     targets[target].Type,
+				Relation: targets[target].Relation,
+				Object:   object,
+			})
+		},
+	)
+}
+
+// multiTypeListObjects validates and executes a multi-type ListObjects request of method. Once the
+// request is validated, resolved is called with its targets, before send is called with the objects
+// of every target. The targets of MultiTypeListObjects list at most the maximum number of results of
+// ListObjects, the ones of StreamedMultiTypeListObjects are unbounded unless they have max_results.
+func (s *Server) multiTypeListObjects(
+	ctx context.Context,
+	req *queryv1.MultiTypeListObjectsRequest,
+	method apimethod.APIMethod,
+	methodName string,
+	resolved func(targets []commands.ListObjectsTarget),
+	send func(target int, object string) error,
+) error {
+	start := time.Now()
+	storeID := req.GetStoreId()
+
+	targets := make([]commands.ListObjectsTarget, 0, len(req.GetTargets()))
+	targetNames := make([]string, 0, len(req.GetTargets()))
+	for _, target := range req.GetTargets() {
+		relation := target.GetRelation()
+		if relation == "" {
+			relation = req.GetRelation()
+		}
+		targets = append(targets, commands.ListObjectsTarget{
+			Type:       target.GetType(),
+			Relation:   relation,
+			MaxResults: target.GetMaxResults(),
+		})
+		targetNames = append(targetNames, target.GetType()+"#"+relation)
+	}
+
+	ctx, span := tracer.Start(ctx, method.String(), trace.WithAttributes(
+		attribute.String("store_id", storeID),
+		attribute.StringSlice("targets", targetNames),
+		attribute.String("user", req.GetUser()),
+		attribute.String("consistency", req.GetConsistency().String()),
+	))
+	defer span.End()
+
+	if len(targets) == 0 {
+		return serverErrors.ValidationError(errors.New("targets must not be empty"))
+	}
+	if len(targets) > maxListObjectsTargets {
+		return serverErrors.ValidationError(fmt.Errorf("targets must not exceed %d", maxListObjectsTargets))
+	}
+
+	// The request has no generated validation, every target has the fields of ListObjectsRequest
+	// and their rules.
+	for i, target := range targets {
+		listObjectsReq := &openfgav1.ListObjectsRequest{
+			StoreId:              storeID,
+			AuthorizationModelId: req.GetAuthorizationModelId(),
+			Type:                 target.Type,
+			Relation:             target.Relation,
+			User:                 req.GetUser(),
+			ContextualTuples:     req.GetContextualTuples(),
+			Context:              req.GetContext(),
+			Consistency:          req.GetConsistency(),
+		}
+		if err := listObjectsReq.Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if slices.Contains(targetNames[:i], targetNames[i]) {
+			return serverErrors.ValidationError(fmt.Errorf("duplicate target %s", targetNames[i]))
+		}
+
+		if method == apimethod.MultiTypeListObjects && s.listObjectsMaxResults > 0 {
+			if target.MaxResults > s.listObjectsMaxResults {
+				return serverErrors.ValidationError(fmt.Errorf("max_results must not exceed %d", s.listObjectsMaxResults))
+			}
+			if target.MaxResults == 0 {
+				targets[i].MaxResults = s.listObjectsMaxResults
+			}
+		}
+	}
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  methodName,
+	})
+
+	err := s.checkAuthz(ctx, storeID, method)
+	if err != nil {
+		return err
+	}
+
+	asOf, err := asOfFromContext(ctx, start)
+	if err != nil {
+		return err
+	}
+
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, req.GetAuthorizationModelId(), asOf)
+	if err != nil {
+		return err
+	}
+
+	datastore, cacheSettings, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return err
+	}
+
+	builder := s.getListObjectsCheckResolverBuilder(storeID, checkResolverOptsAsOf(asOf)...)
+	checkResolver, checkResolverCloser, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	defer checkResolverCloser()
+
+	q, err := commands.NewListObjectsQuery(
+		datastore,
+		checkResolver,
+		storeID,
+		s.listObjectsQueryOptions(storeID, cacheSettings)...,
+	)
+	if err != nil {
+		return serverErrors.NewInternalError("", err)
+	}
+
+	resolved(targets)
+
+	resolutionMetadata, err := q.ExecuteMultiType(
+		typesystem.ContextWithTypesystem(ctx, typesys),
+		&commands.MultiTypeListObjectsParams{
+			StoreID:          storeID,
+			Targets:          targets,
+			User:             req.GetUser(),
+			ContextualTuples: req.GetContextualTuples(),
+			Context:          req.GetContext(),
+			Consistency:      req.GetConsistency(),
+			// Like ListObjects, MultiTypeListObjects fails on the condition evaluation errors of
+			// a target only if the target has fewer objects than its maximum.
+			JoinEvaluationErrors: method == apimethod.MultiTypeListObjects,
+		},
+		send,
+	)
+	if err != nil {
+		telemetry.TraceError(span, err)
+		return err
+	}
+
+	s.emitListObjectsMetrics(ctx, span, methodName, req.GetConsistency(), start, resolutionMetadata)
+
+	return nil
+}
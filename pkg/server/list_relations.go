@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/graph"
+	"github.com/openfga/openfga/internal/utils"
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	"github.com/openfga/openfga/pkg/server/commands"
+	serverconfig "github.com/openfga/openfga/pkg/server/config"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
+	"github.com/openfga/openfga/pkg/telemetry"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+// ListRelations returns the relations that a user has with an object, see [queryv1.QueryServiceServer].
+func (s *Server) ListRelations(ctx context.Context, req *queryv1.ListRelationsRequest) (*queryv1.ListRelationsResponse, error) {
+	const methodName = "listrelations"
+
+	startTime := time.Now()
+	storeID := req.GetStoreId()
+
+	ctx, span := tracer.Start(ctx, apimethod.ListRelations.String(), trace.WithAttributes(
+		attribute.String("store_id", storeID),
+		attribute.String("object", req.GetObject()),
+		attribute.StringSlice("relations", req.GetRelations()),
+		attribute.String("user", req.GetUser()),
+		attribute.String("consistency", req.GetConsistency().String()),
+	))
+	defer span.End()
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  methodName,
+	})
+
+	err := s.checkAuthz(ctx, storeID, apimethod.ListRelations)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf, err := asOfFromContext(ctx, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	typesys, err := s.resolveTypesystemAsOf(ctx, storeID, req.GetAuthorizationModelId(), asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := req.GetRelations()
+	if len(relations) == 0 {
+		relations, err = commands.ObjectTypeRelations(typesys, tuple.GetType(req.GetObject()))
+		if err != nil {
+			return nil, commands.CheckCommandErrorToServerError(err)
+		}
+	}
+
+	// The request has no generated validation, it has the fields of CheckRequest and their rules.
+	for _, relation := range relations {
+		checkReq := &openfgav1.CheckRequest{
+			StoreId:              storeID,
+			AuthorizationModelId: req.GetAuthorizationModelId(),
+			TupleKey: &openfgav1.CheckRequestTupleKey{
+				Object:   req.GetObject(),
+				Relation: relation,
+				User:     req.GetUser(),
+			},
+			ContextualTuples: req.GetContextualTuples(),
+			Context:          req.GetContext(),
+			Consistency:      req.GetConsistency(),
+		}
+		if err := checkReq.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	datastore, cacheSettings, err := s.tupleReaderAsOf(ctx, storeID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	// The relations share the sub-problems they have in common through the RequestScopedCheckResolver.
+	resolverOpts := append(checkResolverOptsAsOf(asOf), graph.WithRequestScopedCheckResolverEnabled(true))
+	checkResolver, checkResolverCloser, err := s.getCheckResolverBuilder(storeID, resolverOpts...).Build()
+	if err != nil {
+		return nil, err
+	}
+	defer checkResolverCloser()
+
+	cmd := commands.NewListRelationsCommand(
+		datastore,
+		checkResolver,
+		typesys,
+		commands.WithListRelationsCommandLogger(s.logger),
+		commands.WithListRelationsMaxConcurrentReads(s.maxConcurrentReadsForCheck),
+		commands.WithListRelationsMaxConcurrentChecks(s.maxConcurrentChecksPerBatch),
+		commands.WithListRelationsCommandCache(s.sharedDatastoreResources, cacheSettings),
+		commands.WithListRelationsDatastoreThrottler(
+			s.featureFlagClient.Boolean(serverconfig.ExperimentalDatastoreThrottling, storeID),
+			s.checkDatastoreThrottleThreshold,
+			s.checkDatastoreThrottleDuration,
+		),
+	)
+
+	resp, err := cmd.Execute(ctx, &commands.ListRelationsCommandParams{
+		StoreID:          storeID,
+		Object:           req.GetObject(),
+		User:             req.GetUser(),
+		Relations:        relations,
+		ContextualTuples: req.GetContextualTuples(),
+		Context:          req.GetContext(),
+		Consistency:      req.GetConsistency(),
+	})
+
+	if resp != nil {
+		dispatchCount := float64(resp.DispatchCount)
+
+		grpc_ctxtags.Extract(ctx).Set(dispatchCountHistogramName, dispatchCount)
+		span.SetAttributes(attribute.Float64(dispatchCountHistogramName, dispatchCount))
+		dispatchCountHistogram.WithLabelValues(
+			s.serviceName,
+			methodName,
+		).Observe(dispatchCount)
+
+		queryCount := float64(resp.DatastoreQueryCount)
+
+		grpc_ctxtags.Extract(ctx).Set(datastoreQueryCountHistogramName, queryCount)
+		span.SetAttributes(attribute.Float64(datastoreQueryCountHistogramName, queryCount))
+		datastoreQueryCountHistogram.WithLabelValues(
+			s.serviceName,
+			methodName,
+		).Observe(queryCount)
+
+		datastoreItemCount := float64(resp.DatastoreItemCount)
+
+		grpc_ctxtags.Extract(ctx).Set(datastoreItemCountHistogramName, datastoreItemCount)
+		span.SetAttributes(attribute.Float64(datastoreItemCountHistogramName, datastoreItemCount))
+		datastoreItemCountHistogram.WithLabelValues(
+			s.serviceName,
+			methodName,
+		).Observe(datastoreItemCount)
+
+		requestDurationHistogram.WithLabelValues(
+			s.serviceName,
+			methodName,
+			utils.Bucketize(uint(queryCount), s.requestDurationByQueryHistogramBuckets),
+			utils.Bucketize(uint(resp.DispatchCount), s.requestDurationByDispatchCountHistogramBuckets),
+			req.GetConsistency().String(),
+		).Observe(float64(time.Since(startTime).Milliseconds()))
+
+		if resp.DispatchThrottled {
+			throttledRequestCounter.WithLabelValues(s.serviceName, methodName, throttleTypeDispatch).Inc()
+		}
+		grpc_ctxtags.Extract(ctx).Set("request.dispatch_throttled", resp.DispatchThrottled)
+
+		if resp.DatastoreThrottled {
+			throttledRequestCounter.WithLabelValues(s.serviceName, methodName, throttleTypeDatastore).Inc()
+		}
+		grpc_ctxtags.Extract(ctx).Set("request.datastore_throttled", resp.DatastoreThrottled)
+	}
+
+	if err != nil {
+		telemetry.TraceError(span, err)
+		return nil, commands.CheckCommandErrorToServerError(err)
+	}
+
+	relationErrors := make(map[string]*openfgav1.CheckError, len(resp.Errors))
+	for relation, err := range resp.Errors {
+		relationErrors[relation] = transformCheckCommandErrorToBatchCheckError(err)
+	}
+
+	return &queryv1.ListRelationsResponse{
+		Relations: resp.Relations,
+		Errors:    relationErrors,
+	}, nil
+}
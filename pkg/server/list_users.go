@@ -9,6 +9,7 @@ import (
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/openfga/openfga/pkg/server/commands/listusers"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
 	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
 	"github.com/openfga/openfga/pkg/telemetry"
 	"github.com/openfga/openfga/pkg/tuple"
 	"github.com/openfga/openfga/pkg/typesystem"
@@ -79,6 +81,110 @@ func (s *Server) ListUsers(
 
 	listUsersQuery := listusers.NewListUsersQuery(s.datastore,
 		req.GetContextualTuples(),
+		s.listUsersQueryOptions(storeID)...,
+	)
+
+	resp, err := listUsersQuery.ListUsers(ctx, req)
+	if err != nil {
+		telemetry.TraceError(span, err)
+		return nil, listUsersErrorToServerError(err)
+	}
+
+	metadata := resp.GetMetadata()
+	s.emitListUsersMetrics(ctx, methodName, req.GetConsistency(), start,
+		metadata.DatastoreQueryCount,
+		metadata.DatastoreItemCount,
+		metadata.DispatchCounter.Load(),
+		metadata.WasDispatchThrottled.Load(),
+		metadata.WasDatastoreThrottled.Load(),
+	)
+
+	return &openfgav1.ListUsersResponse{
+		Users: resp.GetUsers(),
+	}, nil
+}
+
+var _ queryv1.QueryServiceServer = (*Server)(nil)
+
+// StreamedListUsers streams the users that have a specific relation with some object as they are
+// found, see [queryv1.QueryServiceServer].
+func (s *Server) StreamedListUsers(req *queryv1.StreamedListUsersRequest, srv grpc.ServerStreamingServer[queryv1.StreamedListUsersResponse]) error {
+	start := time.Now()
+	storeID := req.GetStoreId()
+	ctx, span := tracer.Start(srv.Context(), apimethod.StreamedListUsers.String(), trace.WithAttributes(
+		attribute.String("store_id", storeID),
+		attribute.String("object", tuple.BuildObject(req.GetObject().GetType(), req.GetObject().GetId())),
+		attribute.String("relation", req.GetRelation()),
+		attribute.String("user_filters", userFiltersToString(req.GetUserFilters())),
+		attribute.String("consistency", req.GetConsistency().String()),
+	))
+	defer span.End()
+
+	// The request has no generated validation, it has the fields of ListUsersRequest and their rules.
+	listUsersReq := &openfgav1.ListUsersRequest{
+		StoreId:              storeID,
+		AuthorizationModelId: req.GetAuthorizationModelId(),
+		Object:               req.GetObject(),
+		Relation:             req.GetRelation(),
+		UserFilters:          req.GetUserFilters(),
+		ContextualTuples:     req.GetContextualTuples(),
+		Context:              req.GetContext(),
+		Consistency:          req.GetConsistency(),
+	}
+	if err := listUsersReq.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	const methodName = "streamedlistusers"
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  methodName,
+	})
+
+	err := s.checkAuthz(ctx, storeID, apimethod.StreamedListUsers)
+	if err != nil {
+		return err
+	}
+
+	typesys, err := s.resolveTypesystem(ctx, storeID, listUsersReq.GetAuthorizationModelId())
+	if err != nil {
+		return err
+	}
+	listUsersReq.AuthorizationModelId = typesys.GetAuthorizationModelID() // the resolved model id
+
+	err = listusers.ValidateListUsersRequest(ctx, listUsersReq, typesys)
+	if err != nil {
+		return err
+	}
+
+	ctx = typesystem.ContextWithTypesystem(ctx, typesys)
+
+	listUsersQuery := listusers.NewListUsersQuery(s.datastore,
+		listUsersReq.GetContextualTuples(),
+		s.listUsersQueryOptions(storeID)...,
+	)
+
+	metadata, err := listUsersQuery.StreamedListUsers(ctx, listUsersReq, srv)
+	if err != nil {
+		telemetry.TraceError(span, err)
+		return listUsersErrorToServerError(err)
+	}
+
+	s.emitListUsersMetrics(ctx, methodName, req.GetConsistency(), start,
+		metadata.DatastoreQueryCount,
+		metadata.DatastoreItemCount,
+		metadata.DispatchCounter.Load(),
+		metadata.WasDispatchThrottled.Load(),
+		metadata.WasDatastoreThrottled.Load(),
+	)
+
+	return nil
+}
+
+// listUsersQueryOptions returns the options of the ListUsers queries of storeID.
+func (s *Server) listUsersQueryOptions(storeID string) []listusers.ListUsersQueryOption {
+	return []listusers.ListUsersQueryOption{
 		listusers.WithResolveNodeLimit(s.resolveNodeLimit),
 		listusers.WithResolveNodeBreadthLimit(s.resolveNodeBreadthLimit),
 		listusers.WithListUsersQueryLogger(s.logger),
@@ -96,23 +202,35 @@ func (s *Server) ListUsers(
 			s.listUsersDatastoreThrottleThreshold,
 			s.listUsersDatastoreThrottleDuration,
 		),
-	)
-
-	resp, err := listUsersQuery.ListUsers(ctx, req)
-	if err != nil {
-		telemetry.TraceError(span, err)
+	}
+}
 
-		switch {
-		case errors.Is(err, graph.ErrResolutionDepthExceeded):
-			return nil, serverErrors.ErrAuthorizationModelResolutionTooComplex
-		case errors.Is(err, condition.ErrEvaluationFailed):
-			return nil, serverErrors.ValidationError(err)
-		default:
-			return nil, serverErrors.HandleError("", err)
-		}
+func listUsersErrorToServerError(err error) error {
+	switch {
+	case errors.Is(err, graph.ErrResolutionDepthExceeded):
+		return serverErrors.ErrAuthorizationModelResolutionTooComplex
+	case errors.Is(err, condition.ErrEvaluationFailed):
+		return serverErrors.ValidationError(err)
+	default:
+		return serverErrors.HandleError("", err)
 	}
+}
+
+// emitListUsersMetrics records the resolution metadata of a ListUsers request that started at start.
+func (s *Server) emitListUsersMetrics(
+	ctx context.Context,
+	methodName string,
+	consistency openfgav1.ConsistencyPreference,
+	start time.Time,
+	rawDatastoreQueryCount uint32,
+	rawDatastoreItemCount uint64,
+	rawDispatchCount uint32,
+	wasDispatchThrottled bool,
+	wasDatastoreThrottled bool,
+) {
+	span := trace.SpanFromContext(ctx)
 
-	datastoreQueryCount := float64(resp.Metadata.DatastoreQueryCount)
+	datastoreQueryCount := float64(rawDatastoreQueryCount)
 
 	grpc_ctxtags.Extract(ctx).Set(datastoreQueryCountHistogramName, datastoreQueryCount)
 	span.SetAttributes(attribute.Float64(datastoreQueryCountHistogramName, datastoreQueryCount))
@@ -121,7 +239,7 @@ func (s *Server) ListUsers(
 		methodName,
 	).Observe(datastoreQueryCount)
 
-	datastoreItemCount := float64(resp.Metadata.DatastoreItemCount)
+	datastoreItemCount := float64(rawDatastoreItemCount)
 
 	grpc_ctxtags.Extract(ctx).Set(datastoreItemCountHistogramName, datastoreItemCount)
 	span.SetAttributes(attribute.Float64(datastoreItemCountHistogramName, datastoreItemCount))
@@ -130,7 +248,7 @@ func (s *Server) ListUsers(
 		methodName,
 	).Observe(datastoreItemCount)
 
-	dispatchCount := float64(resp.Metadata.DispatchCounter.Load())
+	dispatchCount := float64(rawDispatchCount)
 	grpc_ctxtags.Extract(ctx).Set(dispatchCountHistogramName, dispatchCount)
 	span.SetAttributes(attribute.Float64(dispatchCountHistogramName, dispatchCount))
 	dispatchCountHistogram.WithLabelValues(
@@ -143,22 +261,16 @@ func (s *Server) ListUsers(
 		methodName,
 		utils.Bucketize(uint(datastoreQueryCount), s.requestDurationByQueryHistogramBuckets),
 		utils.Bucketize(uint(dispatchCount), s.requestDurationByDispatchCountHistogramBuckets),
-		req.GetConsistency().String(),
+		consistency.String(),
 	).Observe(float64(time.Since(start).Milliseconds()))
 
-	wasDispatchThrottled := resp.GetMetadata().WasDispatchThrottled.Load()
 	if wasDispatchThrottled {
 		throttledRequestCounter.WithLabelValues(s.serviceName, methodName, throttleTypeDispatch).Inc()
 	}
 
-	wasDatastoreThrottled := resp.GetMetadata().WasDatastoreThrottled.Load()
 	if wasDatastoreThrottled {
 		throttledRequestCounter.WithLabelValues(s.serviceName, methodName, throttleTypeDatastore).Inc()
 	}
-
-	return &openfgav1.ListUsersResponse{
-		Users: resp.GetUsers(),
-	}, nil
 }
 
 func userFiltersToString(filter []*openfgav1.UserTypeFilter) string {
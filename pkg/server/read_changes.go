@@ -44,6 +44,7 @@ func (s *Server) ReadChanges(ctx context.Context, req *openfgav1.ReadChangesRequ
 		commands.WithReadChangesQueryEncoder(s.encoder),
 		commands.WithContinuationTokenSerializer(s.tokenSerializer),
 		commands.WithReadChangeQueryHorizonOffset(s.changelogHorizonOffset),
+		commands.WithReadChangesQueryCheckHorizon(s.changelogRetention),
 	)
 	return q.Execute(ctx, req)
 }
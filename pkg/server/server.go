@@ -35,6 +35,9 @@ import (
 	"github.com/openfga/openfga/pkg/logger"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
 	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	adminv1 "github.com/openfga/openfga/pkg/server/proto/openfga/admin/v1"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/storagewrappers"
 	"github.com/openfga/openfga/pkg/telemetry"
@@ -43,7 +46,21 @@ import (
 
 const (
 	AuthorizationModelIDHeader = "Openfga-Authorization-Model-Id"
-	authorizationModelIDKey    = "authorization_model_id"
+	// TupleExpiresAtHeader is the request header that sets, as an RFC 3339 timestamp, the time at
+	// which the tuples written by a Write request expire.
+	TupleExpiresAtHeader = "Openfga-Tuple-Expires-At"
+	// AsOfHeader is the request header that sets, as an RFC 3339 timestamp, the point in time at
+	// which Check, Expand and ListObjects requests are evaluated.
+	AsOfHeader = "Openfga-As-Of"
+	// ExplainHeader is the request header that, when true, makes Check and BatchCheck requests
+	// return the proof tree of their checks in the CheckExplanationHeader response header. The
+	// caller must also be allowed to call Read or Expand on the store.
+	ExplainHeader = "Openfga-Explain"
+	// CheckExplanationHeader is the response header holding, as JSON, the proof tree of a Check
+	// request, or the proof trees of a BatchCheck request keyed by correlation ID. The trees are
+	// truncated to fit in 8 KiB, and the header is omitted if even their roots do not fit.
+	CheckExplanationHeader  = "Openfga-Check-Explanation"
+	authorizationModelIDKey = "authorization_model_id"
 
 	allowedLabel = "allowed"
 
@@ -162,15 +179,22 @@ var (
 // a GRPC and HTTP server.
 type Server struct {
 	openfgav1.UnimplementedOpenFGAServiceServer
+	watchv1.UnimplementedWatchServiceServer
+	queryv1.UnimplementedQueryServiceServer
+	adminv1.UnimplementedAdminServiceServer
 
 	logger                           logger.Logger
 	datastore                        storage.OpenFGADatastore
+	storeCloner                      storage.StoreCloner
+	bulkLoader                       storage.BulkLoader
 	tokenSerializer                  encoder.ContinuationTokenSerializer
 	encoder                          encoder.Encoder
 	transport                        gateway.Transport
 	resolveNodeLimit                 uint32
 	resolveNodeBreadthLimit          uint32
 	changelogHorizonOffset           int
+	changelogRetention               bool
+	watchPollInterval                time.Duration
 	listObjectsDeadline              time.Duration
 	listObjectsMaxResults            uint32
 	listUsersDeadline                time.Duration
@@ -199,7 +223,8 @@ type Server struct {
 	typesystemResolverStop func()
 
 	// cacheSettings are given by the user
-	cacheSettings serverconfig.CacheSettings
+	cacheSettings        serverconfig.CacheSettings
+	invalidationNotifier storage.InvalidationNotifier
 	// sharedDatastoreResources are created by the server
 	sharedDatastoreResources *shared.SharedDatastoreResources
 
@@ -336,6 +361,23 @@ func WithChangelogHorizonOffset(offset int) OpenFGAServiceV1Option {
 	}
 }
 
+// WithChangelogRetention indicates whether the changelog of the stores is pruned, see
+// [storage.ChangelogPruner]. If so, reading changes from a continuation token before the pruned
+// ones fails, at the cost of reading the retention horizon of the store. Defaults to false.
+func WithChangelogRetention(enabled bool) OpenFGAServiceV1Option {
+	return func(s *Server) {
+		s.changelogRetention = enabled
+	}
+}
+
+// WithWatchPollInterval sets the interval at which a Watch stream reads the changelog once it
+// has caught up with the latest changes.
+func WithWatchPollInterval(interval time.Duration) OpenFGAServiceV1Option {
+	return func(s *Server) {
+		s.watchPollInterval = interval
+	}
+}
+
 // WithListObjectsDeadline affect the ListObjects API and Streamed ListObjects API only.
 // It sets the maximum amount of time that the server will spend gathering results.
 func WithListObjectsDeadline(deadline time.Duration) OpenFGAServiceV1Option {
@@ -460,6 +502,16 @@ func WithCacheControllerEnabled(enabled bool) OpenFGAServiceV1Option {
 	}
 }
 
+// WithInvalidationNotifier makes the server broadcast an invalidation through notifier after every
+// write of tuples, and the cache controller invalidate the caches as soon as it receives one.
+// Needs WithCacheControllerEnabled set to true.
+// You must call [storage.InvalidationNotifier.Close] on it after you have stopped using it.
+func WithInvalidationNotifier(notifier storage.InvalidationNotifier) OpenFGAServiceV1Option {
+	return func(s *Server) {
+		s.invalidationNotifier = notifier
+	}
+}
+
 // WithCacheControllerTTL sets the frequency for the controller to execute.
 func WithCacheControllerTTL(ttl time.Duration) OpenFGAServiceV1Option {
 	return func(s *Server) {
@@ -830,6 +882,7 @@ func NewServerWithOpts(opts ...OpenFGAServiceV1Option) (*Server, error) {
 		encoder:                          encoder.NewBase64Encoder(),
 		transport:                        gateway.NewNoopTransport(),
 		changelogHorizonOffset:           serverconfig.DefaultChangelogHorizonOffset,
+		watchPollInterval:                serverconfig.DefaultWatchPollInterval,
 		resolveNodeLimit:                 serverconfig.DefaultResolveNodeLimit,
 		resolveNodeBreadthLimit:          serverconfig.DefaultResolveNodeBreadthLimit,
 		listObjectsDeadline:              serverconfig.DefaultListObjectsDeadline,
@@ -925,18 +978,33 @@ func NewServerWithOpts(opts ...OpenFGAServiceV1Option) (*Server, error) {
 
 	// below this point, don't throw errors or we may leak resources in tests
 
+	// Keep the datastore as given when it can clone stores or bulk load tuples, since the wrappers
+	// below cannot. A cloned store is a new store, which no cache holds, while the bulk loads are
+	// notified like writes below.
+	s.storeCloner, _ = s.datastore.(storage.StoreCloner)
+	s.bulkLoader, _ = s.datastore.(storage.BulkLoader)
+
 	if !s.contextPropagationToDatastore {
 		// Creates a new [storagewrappers.ContextTracerWrapper] that will execute datastore queries using
 		// a new background context with the current trace context.
 		s.datastore = storagewrappers.NewContextWrapper(s.datastore)
 	}
 
+	sharedDatastoreResourcesOpts := []shared.SharedDatastoreResourcesOpt{shared.WithLogger(s.logger)}
+	if s.invalidationNotifier != nil {
+		s.datastore = storagewrappers.NewInvalidationNotifyingDatastore(s.datastore, s.invalidationNotifier, s.logger)
+		if s.bulkLoader != nil {
+			s.bulkLoader = storagewrappers.NewInvalidationNotifyingBulkLoader(s.bulkLoader, s.invalidationNotifier, s.logger)
+		}
+		sharedDatastoreResourcesOpts = append(sharedDatastoreResourcesOpts, shared.WithInvalidationNotifier(s.invalidationNotifier))
+	}
+
 	s.datastore, err = storagewrappers.NewCachedOpenFGADatastore(s.datastore, s.maxAuthorizationModelCacheSize)
 	if err != nil {
 		return nil, err
 	}
 
-	s.sharedDatastoreResources, err = shared.NewSharedDatastoreResources(s.ctx, s.singleflightGroup, s.datastore, s.cacheSettings, []shared.SharedDatastoreResourcesOpt{shared.WithLogger(s.logger)}...)
+	s.sharedDatastoreResources, err = shared.NewSharedDatastoreResources(s.ctx, s.singleflightGroup, s.datastore, s.cacheSettings, sharedDatastoreResourcesOpts...)
 	if err != nil {
 		return nil, err
 	}
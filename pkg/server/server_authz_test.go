@@ -784,6 +784,66 @@ func TestCheckAuthz(t *testing.T) {
 	})
 }
 
+func TestCheckCloneStoreAuthz(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	openfga := MustNewServerWithOpts(
+		WithDatastore(ds),
+	)
+	t.Cleanup(openfga.Close)
+
+	clientID := "validclientid"
+	settings := newSetupAuthzModelAndTuples(t, openfga, clientID)
+
+	openfga.authorizer = authz.NewAuthorizer(&authz.Config{StoreID: settings.rootData.id, ModelID: settings.rootData.modelID}, openfga, openfga.logger)
+	ctx := authclaims.ContextWithAuthClaims(context.Background(), &authclaims.AuthClaims{ClientID: clientID})
+
+	// Every relation is required, since the clone copies the tuples, the models and the assertions.
+	for _, relation := range []string{authz.CanCallRead, authz.CanCallReadAuthorizationModels, authz.CanCallReadAssertions} {
+		err := openfga.checkCloneStoreAuthz(ctx, settings.testData.id)
+		require.ErrorIs(t, err, authz.ErrUnauthorizedResponse)
+
+		settings.addAuthForRelation(ctx, t, relation)
+	}
+
+	err := openfga.checkCloneStoreAuthz(ctx, settings.testData.id)
+	require.NoError(t, err)
+}
+
+func TestCheckDiffAuthorizationModelsAuthz(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	openfga := MustNewServerWithOpts(
+		WithDatastore(ds),
+	)
+	t.Cleanup(openfga.Close)
+
+	clientID := "validclientid"
+	settings := newSetupAuthzModelAndTuples(t, openfga, clientID)
+
+	openfga.authorizer = authz.NewAuthorizer(&authz.Config{StoreID: settings.rootData.id, ModelID: settings.rootData.modelID}, openfga, openfga.logger)
+	ctx := authclaims.ContextWithAuthClaims(context.Background(), &authclaims.AuthClaims{ClientID: clientID})
+
+	// Both relations are required, since the diff reveals the tuples and the models.
+	for _, relation := range []string{authz.CanCallRead, authz.CanCallReadAuthorizationModels} {
+		err := openfga.checkDiffAuthorizationModelsAuthz(ctx, settings.testData.id)
+		require.ErrorIs(t, err, authz.ErrUnauthorizedResponse)
+
+		settings.addAuthForRelation(ctx, t, relation)
+	}
+
+	err := openfga.checkDiffAuthorizationModelsAuthz(ctx, settings.testData.id)
+	require.NoError(t, err)
+}
+
 func TestGetAccessibleStores(t *testing.T) {
 	t.Cleanup(func() {
 		goleak.VerifyNone(t)
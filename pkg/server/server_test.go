@@ -2,14 +2,18 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"os"
 	"path"
 	"runtime"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -20,6 +24,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -28,13 +33,16 @@ import (
 
 	"github.com/openfga/openfga/cmd/migrate"
 	"github.com/openfga/openfga/cmd/util"
+	"github.com/openfga/openfga/internal/authz"
 	"github.com/openfga/openfga/internal/build"
 	"github.com/openfga/openfga/internal/cachecontroller"
 	"github.com/openfga/openfga/internal/graph"
 	mockstorage "github.com/openfga/openfga/internal/mocks"
+	"github.com/openfga/openfga/internal/utils/apimethod"
 	"github.com/openfga/openfga/pkg/featureflags"
 	serverconfig "github.com/openfga/openfga/pkg/server/config"
 	serverErrors "github.com/openfga/openfga/pkg/server/errors"
+	queryv1 "github.com/openfga/openfga/pkg/server/proto/openfga/query/v1"
 	"github.com/openfga/openfga/pkg/server/test"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/memory"
@@ -249,14 +257,17 @@ func TestServerPanicIfValidationsFail(t *testing.T) {
 }
 
 func TestServerNotReadyDueToDatastoreRevision(t *testing.T) {
-	// skipping sqlite here because the lowest supported schema revision is 4
-	engines := []string{"postgres", "mysql"}
+	// skipping sqlite here because its schema is initialized at revision 5 in a single migration
+	minRevisions := map[string]int64{
+		"postgres": build.MinimumSupportedPostgresSchemaRevision,
+		"mysql":    build.MinimumSupportedMySQLSchemaRevision,
+	}
 
-	for _, engine := range engines {
+	for engine, minRevision := range minRevisions {
 		t.Run(engine, func(t *testing.T) {
 			_, ds, uri := util.MustBootstrapDatastore(t, engine)
 
-			targetVersion := build.MinimumSupportedDatastoreSchemaRevision - 1
+			targetVersion := minRevision - 1
 
 			migrateCommand := migrate.NewMigrateCommand()
 
@@ -266,7 +277,7 @@ func TestServerNotReadyDueToDatastoreRevision(t *testing.T) {
 			require.NoError(t, err)
 
 			status, _ := ds.IsReady(context.Background())
-			require.Contains(t, status.Message, fmt.Sprintf("datastore requires migrations: at revision '%d', but requires '%d'.", targetVersion, build.MinimumSupportedDatastoreSchemaRevision))
+			require.Contains(t, status.Message, fmt.Sprintf("datastore requires migrations: at revision '%d', but requires '%d'.", targetVersion, minRevision))
 			require.False(t, status.IsReady)
 		})
 	}
@@ -2466,3 +2477,852 @@ func TestBatchCheckWithCachedIterator(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, batchCheckResponse.GetResult()[fakeID].GetAllowed())
 }
+
+func TestWriteWithTupleExpiresAtHeader(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+
+	// The datastore decides which tuples have expired with a clock that the test advances.
+	start := time.Now()
+	var elapsed atomic.Int64
+	ds := memory.New(memory.WithClock(func() time.Time {
+		return start.Add(time.Duration(elapsed.Load()))
+	}))
+	t.Cleanup(ds.Close)
+	s := MustNewServerWithOpts(WithDatastore(ds))
+	t.Cleanup(s.Close)
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "expiry"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	writeModelResp, err := s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+		StoreId: storeID,
+		TypeDefinitions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type repo
+				relations
+					define reader: [user]`).GetTypeDefinitions(),
+		SchemaVersion: typesystem.SchemaVersion1_1,
+	})
+	require.NoError(t, err)
+	modelID := writeModelResp.GetAuthorizationModelId()
+
+	write := func(expiresAt string, object string) error {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs(TupleExpiresAtHeader, expiresAt))
+		_, err := s.Write(ctx, &openfgav1.WriteRequest{
+			StoreId:              storeID,
+			AuthorizationModelId: modelID,
+			Writes: &openfgav1.WriteRequestWrites{
+				TupleKeys: []*openfgav1.TupleKey{tuple.NewTupleKey(object, "reader", "user:jon")},
+			},
+		})
+		return err
+	}
+	check := func(object string) bool {
+		resp, err := s.Check(ctx, &openfgav1.CheckRequest{
+			StoreId:              storeID,
+			AuthorizationModelId: modelID,
+			TupleKey:             tuple.NewCheckRequestTupleKey(object, "reader", "user:jon"),
+		})
+		require.NoError(t, err)
+		return resp.GetAllowed()
+	}
+
+	t.Run("invalid_expiry", func(t *testing.T) {
+		err := write("tomorrow", "repo:invalid")
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("expiry_in_the_past", func(t *testing.T) {
+		err := write(time.Now().Add(-time.Minute).Format(time.RFC3339), "repo:past")
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("tuple_expires", func(t *testing.T) {
+		require.NoError(t, write(start.Add(time.Hour).Format(time.RFC3339Nano), "repo:temporary"))
+		require.True(t, check("repo:temporary"))
+
+		elapsed.Store(int64(time.Hour - time.Nanosecond))
+		require.True(t, check("repo:temporary"))
+
+		elapsed.Store(int64(time.Hour))
+		require.False(t, check("repo:temporary"))
+	})
+}
+
+func TestEvaluateWithAsOfHeader(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	s := MustNewServerWithOpts(WithDatastore(ds))
+	t.Cleanup(s.Close)
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "as-of"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	beforeModel := time.Now()
+	time.Sleep(2 * time.Millisecond)
+
+	_, err = s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+		StoreId: storeID,
+		TypeDefinitions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type repo
+				relations
+					define reader: [user]`).GetTypeDefinitions(),
+		SchemaVersion: typesystem.SchemaVersion1_1,
+	})
+	require.NoError(t, err)
+
+	tk := tuple.NewTupleKey("repo:openfga", "reader", "user:jon")
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Writes:  &openfgav1.WriteRequestWrites{TupleKeys: []*openfgav1.TupleKey{tk}},
+	})
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Millisecond)
+	asOf := time.Now()
+	time.Sleep(2 * time.Millisecond)
+
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Deletes: &openfgav1.WriteRequestDeletes{TupleKeys: []*openfgav1.TupleKeyWithoutCondition{
+			tuple.TupleKeyToTupleKeyWithoutCondition(tk),
+		}},
+	})
+	require.NoError(t, err)
+
+	withAsOf := func(asOf string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(AsOfHeader, asOf))
+	}
+	check := func(ctx context.Context) (bool, error) {
+		resp, err := s.Check(ctx, &openfgav1.CheckRequest{
+			StoreId:  storeID,
+			TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:jon"),
+		})
+		return resp.GetAllowed(), err
+	}
+
+	t.Run("check", func(t *testing.T) {
+		allowed, err := check(withAsOf(asOf.Format(time.RFC3339Nano)))
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, err = check(ctx)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
+
+	t.Run("list_objects", func(t *testing.T) {
+		resp, err := s.ListObjects(withAsOf(asOf.Format(time.RFC3339Nano)), &openfgav1.ListObjectsRequest{
+			StoreId:  storeID,
+			Type:     "repo",
+			Relation: "reader",
+			User:     "user:jon",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"repo:openfga"}, resp.GetObjects())
+	})
+
+	t.Run("expand", func(t *testing.T) {
+		resp, err := s.Expand(withAsOf(asOf.Format(time.RFC3339Nano)), &openfgav1.ExpandRequest{
+			StoreId:  storeID,
+			TupleKey: tuple.NewExpandRequestTupleKey("repo:openfga", "reader"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"user:jon"}, resp.GetTree().GetRoot().GetLeaf().GetUsers().GetUsers())
+	})
+
+	t.Run("before_the_first_model", func(t *testing.T) {
+		_, err := check(withAsOf(beforeModel.Format(time.RFC3339Nano)))
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_latest_authorization_model_not_found), status.Code(err))
+	})
+
+	t.Run("invalid_as_of", func(t *testing.T) {
+		_, err := check(withAsOf("yesterday"))
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("as_of_in_the_future", func(t *testing.T) {
+		_, err := check(withAsOf(time.Now().Add(time.Hour).Format(time.RFC3339)))
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+}
+
+// headerTransport records the response headers set by the server.
+type headerTransport struct {
+	mu      sync.Mutex
+	headers map[string]string
+}
+
+func (h *headerTransport) SetHeader(_ context.Context, key, value string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.headers[key] = value
+}
+
+func (h *headerTransport) get(key string) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.headers[key]
+}
+
+func TestCheckWithExplainHeader(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	s := MustNewServerWithOpts(WithDatastore(ds))
+	t.Cleanup(s.Close)
+	transport := &headerTransport{headers: map[string]string{}}
+	s.transport = transport
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "explain"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	_, err = s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+		StoreId: storeID,
+		TypeDefinitions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type team
+				relations
+					define member: [user]
+
+			type repo
+				relations
+					define reader: [user, team#member]`).GetTypeDefinitions(),
+		SchemaVersion: typesystem.SchemaVersion1_1,
+	})
+	require.NoError(t, err)
+
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Writes: &openfgav1.WriteRequestWrites{TupleKeys: []*openfgav1.TupleKey{
+			tuple.NewTupleKey("repo:openfga", "reader", "team:core#member"),
+			tuple.NewTupleKey("team:core", "member", "user:jon"),
+		}},
+	})
+	require.NoError(t, err)
+
+	withExplain := func(explain string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(ExplainHeader, explain))
+	}
+
+	t.Run("check", func(t *testing.T) {
+		resp, err := s.Check(withExplain("true"), &openfgav1.CheckRequest{
+			StoreId:  storeID,
+			TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:jon"),
+		})
+		require.NoError(t, err)
+		require.True(t, resp.GetAllowed())
+
+		var explanation graph.CheckExplanation
+		require.NoError(t, json.Unmarshal([]byte(transport.get(CheckExplanationHeader)), &explanation))
+		require.Equal(t, graph.ExplanationKindCheck, explanation.Kind)
+		require.Equal(t, "repo:openfga#reader@user:jon", explanation.Check)
+		require.True(t, explanation.Allowed)
+		require.NotEmpty(t, explanation.Children)
+	})
+
+	t.Run("batch_check", func(t *testing.T) {
+		_, err := s.BatchCheck(withExplain("1"), &openfgav1.BatchCheckRequest{
+			StoreId: storeID,
+			Checks: []*openfgav1.BatchCheckItem{
+				{TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:jon"), CorrelationId: "jon"},
+				{TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:maria"), CorrelationId: "maria"},
+			},
+		})
+		require.NoError(t, err)
+
+		var explanations map[string]*graph.CheckExplanation
+		require.NoError(t, json.Unmarshal([]byte(transport.get(CheckExplanationHeader)), &explanations))
+		require.Len(t, explanations, 2)
+		require.True(t, explanations["jon"].Allowed)
+		require.False(t, explanations["maria"].Allowed)
+		require.Equal(t, "repo:openfga#reader@user:maria", explanations["maria"].Check)
+	})
+
+	t.Run("invalid_explain", func(t *testing.T) {
+		_, err := s.Check(withExplain("maybe"), &openfgav1.CheckRequest{
+			StoreId:  storeID,
+			TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:jon"),
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("requires_read_or_expand", func(t *testing.T) {
+		authorizer := s.authorizer
+		t.Cleanup(func() { s.authorizer = authorizer })
+
+		check := func(denied ...apimethod.APIMethod) error {
+			s.authorizer = &methodDenyingAuthorizer{denied: denied}
+			_, err := s.Check(withExplain("true"), &openfgav1.CheckRequest{
+				StoreId:  storeID,
+				TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:jon"),
+			})
+			if err != nil {
+				return err
+			}
+			_, err = s.BatchCheck(withExplain("true"), &openfgav1.BatchCheckRequest{
+				StoreId: storeID,
+				Checks: []*openfgav1.BatchCheckItem{
+					{TupleKey: tuple.NewCheckRequestTupleKey("repo:openfga", "reader", "user:jon"), CorrelationId: "jon"},
+				},
+			})
+			return err
+		}
+
+		require.NoError(t, check(apimethod.Read))
+		require.NoError(t, check(apimethod.Expand))
+		require.ErrorIs(t, check(apimethod.Read, apimethod.Expand), authz.ErrUnauthorizedResponse)
+	})
+}
+
+func TestSetCheckExplanationHeader(t *testing.T) {
+	s := MustNewServerWithOpts(WithDatastore(memory.New()))
+	t.Cleanup(func() {
+		s.Close()
+		s.datastore.Close()
+	})
+	transport := &headerTransport{headers: map[string]string{}}
+	s.transport = transport
+
+	// Each node of the explanation has a 4 KiB check, so the explanation only fits without its
+	// children.
+	root := &graph.CheckExplanation{Kind: graph.ExplanationKindCheck, Check: strings.Repeat("a", 4<<10)}
+	root.Children = []*graph.CheckExplanation{{Kind: graph.ExplanationKindDirect, Check: strings.Repeat("b", 4<<10)}}
+	explanations := func(depth int) any {
+		if depth < 0 {
+			return root
+		}
+		return root.Truncate(depth)
+	}
+
+	require.NoError(t, s.setCheckExplanationHeader(context.Background(), explanations))
+	value := transport.get(CheckExplanationHeader)
+	require.LessOrEqual(t, len(value), maxCheckExplanationHeaderSize)
+
+	var explanation graph.CheckExplanation
+	require.NoError(t, json.Unmarshal([]byte(value), &explanation))
+	require.True(t, explanation.Truncated)
+	require.Empty(t, explanation.Children)
+
+	transport.headers = map[string]string{}
+	root.Check = strings.Repeat("a", maxCheckExplanationHeaderSize)
+	require.NoError(t, s.setCheckExplanationHeader(context.Background(), explanations))
+	require.Empty(t, transport.get(CheckExplanationHeader))
+}
+
+// methodDenyingAuthorizer denies the calls of the denied methods, and authorizes the others.
+type methodDenyingAuthorizer struct {
+	authz.NoopAuthorizer
+	denied []apimethod.APIMethod
+}
+
+func (a *methodDenyingAuthorizer) Authorize(_ context.Context, _ string, apiMethod apimethod.APIMethod, _ ...string) error {
+	if slices.Contains(a.denied, apiMethod) {
+		return errors.New("denied")
+	}
+	return nil
+}
+
+func TestASCIIJSON(t *testing.T) {
+	b, err := json.Marshal("café 🚀")
+	require.NoError(t, err)
+
+	escaped := asciiJSON(b)
+	require.Equal(t, `"caf\u00e9 \ud83d\ude80"`, escaped)
+
+	var s string
+	require.NoError(t, json.Unmarshal([]byte(escaped), &s))
+	require.Equal(t, "café 🚀", s)
+}
+
+type streamedListUsersServer struct {
+	grpc.ServerStream
+
+	ctx   context.Context
+	users []string
+}
+
+func (s *streamedListUsersServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *streamedListUsersServer) Send(resp *queryv1.StreamedListUsersResponse) error {
+	s.users = append(s.users, tuple.UserProtoToString(resp.GetUser()))
+	return nil
+}
+
+func TestStreamedListUsers(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	// The maximum number of results of ListUsers does not apply to the streamed version.
+	s := MustNewServerWithOpts(WithDatastore(ds), WithListUsersMaxResults(1))
+	t.Cleanup(s.Close)
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "streamed-list-users"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	_, err = s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+		StoreId: storeID,
+		TypeDefinitions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type repo
+				relations
+					define reader: [user]`).GetTypeDefinitions(),
+		SchemaVersion: typesystem.SchemaVersion1_1,
+	})
+	require.NoError(t, err)
+
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Writes: &openfgav1.WriteRequestWrites{TupleKeys: []*openfgav1.TupleKey{
+			tuple.NewTupleKey("repo:openfga", "reader", "user:jon"),
+			tuple.NewTupleKey("repo:openfga", "reader", "user:maria"),
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Run("streams_users", func(t *testing.T) {
+		srv := &streamedListUsersServer{ctx: ctx}
+		err := s.StreamedListUsers(&queryv1.StreamedListUsersRequest{
+			StoreId:     storeID,
+			Object:      &openfgav1.Object{Type: "repo", Id: "openfga"},
+			Relation:    "reader",
+			UserFilters: []*openfgav1.UserTypeFilter{{Type: "user"}},
+		}, srv)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"user:jon", "user:maria"}, srv.users)
+	})
+
+	t.Run("invalid_request", func(t *testing.T) {
+		err := s.StreamedListUsers(&queryv1.StreamedListUsersRequest{
+			StoreId:  storeID,
+			Object:   &openfgav1.Object{Type: "repo", Id: "openfga"},
+			Relation: "reader",
+		}, &streamedListUsersServer{ctx: ctx})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown_relation", func(t *testing.T) {
+		err := s.StreamedListUsers(&queryv1.StreamedListUsersRequest{
+			StoreId:     storeID,
+			Object:      &openfgav1.Object{Type: "repo", Id: "openfga"},
+			Relation:    "writer",
+			UserFilters: []*openfgav1.UserTypeFilter{{Type: "user"}},
+		}, &streamedListUsersServer{ctx: ctx})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_relation_not_found), status.Code(err))
+	})
+}
+
+func TestPaginatedListObjects(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	s := MustNewServerWithOpts(WithDatastore(ds), WithListObjectsMaxResults(2))
+	t.Cleanup(s.Close)
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "paginated-list-objects"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	writeModel := func(t *testing.T, model string) {
+		t.Helper()
+		_, err := s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+			StoreId:         storeID,
+			TypeDefinitions: parser.MustTransformDSLToProto(model).GetTypeDefinitions(),
+			SchemaVersion:   typesystem.SchemaVersion1_1,
+		})
+		require.NoError(t, err)
+	}
+
+	writeModel(t, `
+		model
+			schema 1.1
+
+		type user
+
+		type repo
+			relations
+				define reader: [user]`)
+
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Writes: &openfgav1.WriteRequestWrites{TupleKeys: []*openfgav1.TupleKey{
+			tuple.NewTupleKey("repo:c", "reader", "user:jon"),
+			tuple.NewTupleKey("repo:a", "reader", "user:jon"),
+			tuple.NewTupleKey("repo:b", "reader", "user:jon"),
+		}},
+	})
+	require.NoError(t, err)
+
+	req := &queryv1.PaginatedListObjectsRequest{
+		StoreId:  storeID,
+		Type:     "repo",
+		Relation: "reader",
+		User:     "user:jon",
+	}
+
+	t.Run("pages_with_the_model_of_the_first_page", func(t *testing.T) {
+		first, err := s.PaginatedListObjects(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"repo:a", "repo:b"}, first.GetObjects())
+		require.NotEmpty(t, first.GetContinuationToken())
+
+		// The latest model no longer has the relation, the following page must not use it.
+		writeModel(t, `
+			model
+				schema 1.1
+
+			type user
+
+			type repo`)
+
+		second, err := s.PaginatedListObjects(ctx, &queryv1.PaginatedListObjectsRequest{
+			StoreId:           storeID,
+			Type:              "repo",
+			Relation:          "reader",
+			User:              "user:jon",
+			ContinuationToken: first.GetContinuationToken(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"repo:c"}, second.GetObjects())
+		require.Empty(t, second.GetContinuationToken())
+	})
+
+	t.Run("page_size_above_max_results", func(t *testing.T) {
+		_, err := s.PaginatedListObjects(ctx, &queryv1.PaginatedListObjectsRequest{
+			StoreId:  storeID,
+			Type:     "repo",
+			Relation: "reader",
+			User:     "user:jon",
+			PageSize: 3,
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("invalid_request", func(t *testing.T) {
+		_, err := s.PaginatedListObjects(ctx, &queryv1.PaginatedListObjectsRequest{
+			StoreId:  storeID,
+			Relation: "reader",
+			User:     "user:jon",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListRelations(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	s := MustNewServerWithOpts(WithDatastore(ds))
+	t.Cleanup(s.Close)
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "list-relations"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	model := parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type repo
+				relations
+					define admin: [user]
+					define writer: [user] or admin
+					define reader: [user] or writer
+					define triager: [user with in_office_hours]
+
+			condition in_office_hours(hour: int) {
+				hour >= 9 && hour < 17
+			}`)
+	_, err = s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+		StoreId:         storeID,
+		TypeDefinitions: model.GetTypeDefinitions(),
+		Conditions:      model.GetConditions(),
+		SchemaVersion:   typesystem.SchemaVersion1_1,
+	})
+	require.NoError(t, err)
+
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Writes: &openfgav1.WriteRequestWrites{TupleKeys: []*openfgav1.TupleKey{
+			tuple.NewTupleKey("repo:openfga", "writer", "user:jon"),
+			tuple.NewTupleKeyWithCondition("repo:openfga", "triager", "user:jon", "in_office_hours", nil),
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Run("all_relations", func(t *testing.T) {
+		resp, err := s.ListRelations(ctx, &queryv1.ListRelationsRequest{
+			StoreId: storeID,
+			Object:  "repo:openfga",
+			User:    "user:jon",
+			Context: testutils.MustNewStruct(t, map[string]any{"hour": 10}),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"reader", "triager", "writer"}, resp.GetRelations())
+		require.Empty(t, resp.GetErrors())
+	})
+
+	t.Run("relation_errors", func(t *testing.T) {
+		resp, err := s.ListRelations(ctx, &queryv1.ListRelationsRequest{
+			StoreId:   storeID,
+			Object:    "repo:openfga",
+			User:      "user:jon",
+			Relations: []string{"triager", "writer"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"writer"}, resp.GetRelations())
+		require.Len(t, resp.GetErrors(), 1)
+		require.Equal(t, openfgav1.ErrorCode_validation_error, resp.GetErrors()["triager"].GetInputError())
+	})
+
+	t.Run("contextual_tuples", func(t *testing.T) {
+		resp, err := s.ListRelations(ctx, &queryv1.ListRelationsRequest{
+			StoreId:   storeID,
+			Object:    "repo:openfga",
+			User:      "user:maria",
+			Relations: []string{"admin", "reader"},
+			ContextualTuples: &openfgav1.ContextualTupleKeys{TupleKeys: []*openfgav1.TupleKey{
+				tuple.NewTupleKey("repo:openfga", "admin", "user:maria"),
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"admin", "reader"}, resp.GetRelations())
+	})
+
+	t.Run("unknown_relation", func(t *testing.T) {
+		_, err := s.ListRelations(ctx, &queryv1.ListRelationsRequest{
+			StoreId:   storeID,
+			Object:    "repo:openfga",
+			User:      "user:jon",
+			Relations: []string{"owner"},
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("invalid_request", func(t *testing.T) {
+		_, err := s.ListRelations(ctx, &queryv1.ListRelationsRequest{
+			StoreId: storeID,
+			Object:  "repo:openfga",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+type streamedMultiTypeListObjectsServer struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	objects map[string][]string
+}
+
+func (s *streamedMultiTypeListObjectsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *streamedMultiTypeListObjectsServer) Send(resp *queryv1.StreamedMultiTypeListObjectsResponse) error {
+	target := resp.GetType() + "#" + resp.GetRelation()
+	s.objects[target] = append(s.objects[target], resp.GetObject())
+	return nil
+}
+
+func TestMultiTypeListObjects(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+	s := MustNewServerWithOpts(WithDatastore(ds), WithListObjectsMaxResults(2))
+	t.Cleanup(s.Close)
+
+	createStoreResp, err := s.CreateStore(ctx, &openfgav1.CreateStoreRequest{Name: "multi-type-list-objects"})
+	require.NoError(t, err)
+	storeID := createStoreResp.GetId()
+
+	_, err = s.WriteAuthorizationModel(ctx, &openfgav1.WriteAuthorizationModelRequest{
+		StoreId: storeID,
+		TypeDefinitions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type org
+				relations
+					define member: [user]
+
+			type repo
+				relations
+					define owner: [org]
+					define admin: [user]
+					define reader: admin or member from owner`).GetTypeDefinitions(),
+		SchemaVersion: typesystem.SchemaVersion1_1,
+	})
+	require.NoError(t, err)
+
+	_, err = s.Write(ctx, &openfgav1.WriteRequest{
+		StoreId: storeID,
+		Writes: &openfgav1.WriteRequestWrites{TupleKeys: []*openfgav1.TupleKey{
+			tuple.NewTupleKey("org:openfga", "member", "user:jon"),
+			tuple.NewTupleKey("repo:c", "owner", "org:openfga"),
+			tuple.NewTupleKey("repo:b", "owner", "org:openfga"),
+			tuple.NewTupleKey("repo:a", "admin", "user:jon"),
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Run("objects_by_target", func(t *testing.T) {
+		resp, err := s.MultiTypeListObjects(ctx, &queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{
+				{Type: "repo", Relation: "admin"},
+				{Type: "org"},
+			},
+			Relation: "member",
+			User:     "user:jon",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+
+		require.Equal(t, "repo", resp.GetResults()[0].GetType())
+		require.Equal(t, "admin", resp.GetResults()[0].GetRelation())
+		require.Equal(t, []string{"repo:a"}, resp.GetResults()[0].GetObjects())
+
+		require.Equal(t, "org", resp.GetResults()[1].GetType())
+		require.Equal(t, "member", resp.GetResults()[1].GetRelation())
+		require.Equal(t, []string{"org:openfga"}, resp.GetResults()[1].GetObjects())
+	})
+
+	t.Run("max_results", func(t *testing.T) {
+		resp, err := s.MultiTypeListObjects(ctx, &queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{
+				{Type: "repo", Relation: "reader"},
+				{Type: "repo", Relation: "admin", MaxResults: 1},
+			},
+			User: "user:jon",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults()[0].GetObjects(), 2)
+		require.Subset(t, []string{"repo:a", "repo:b", "repo:c"}, resp.GetResults()[0].GetObjects())
+		require.True(t, slices.IsSorted(resp.GetResults()[0].GetObjects()))
+		require.Equal(t, []string{"repo:a"}, resp.GetResults()[1].GetObjects())
+	})
+
+	t.Run("max_results_above_max_results", func(t *testing.T) {
+		_, err := s.MultiTypeListObjects(ctx, &queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{{Type: "repo", Relation: "reader", MaxResults: 3}},
+			User:    "user:jon",
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("duplicate_target", func(t *testing.T) {
+		_, err := s.MultiTypeListObjects(ctx, &queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{
+				{Type: "repo", Relation: "reader"},
+				{Type: "repo"},
+			},
+			Relation: "reader",
+			User:     "user:jon",
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+	})
+
+	t.Run("invalid_request", func(t *testing.T) {
+		_, err := s.MultiTypeListObjects(ctx, &queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			User:    "user:jon",
+		})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_validation_error), status.Code(err))
+
+		_, err = s.MultiTypeListObjects(ctx, &queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{{Type: "repo"}},
+			User:    "user:jon",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("streamed", func(t *testing.T) {
+		// The maximum number of results of ListObjects does not apply to the streamed version.
+		srv := &streamedMultiTypeListObjectsServer{ctx: ctx, objects: map[string][]string{}}
+		err := s.StreamedMultiTypeListObjects(&queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{
+				{Type: "repo", Relation: "reader"},
+				{Type: "org", Relation: "member"},
+			},
+			User: "user:jon",
+		}, srv)
+		require.NoError(t, err)
+		require.Len(t, srv.objects, 2)
+		require.ElementsMatch(t, []string{"repo:a", "repo:b", "repo:c"}, srv.objects["repo#reader"])
+		require.Equal(t, []string{"org:openfga"}, srv.objects["org#member"])
+	})
+
+	t.Run("streamed_unknown_type", func(t *testing.T) {
+		err := s.StreamedMultiTypeListObjects(&queryv1.MultiTypeListObjectsRequest{
+			StoreId: storeID,
+			Targets: []*queryv1.ListObjectsTarget{
+				{Type: "repo", Relation: "reader"},
+				{Type: "team", Relation: "member"},
+			},
+			User: "user:jon",
+		}, &streamedMultiTypeListObjectsServer{ctx: ctx, objects: map[string][]string{}})
+		require.Equal(t, codes.Code(openfgav1.ErrorCode_type_not_found), status.Code(err))
+	})
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/internal/utils/apimethod"
+	"github.com/openfga/openfga/pkg/server/commands"
+	watchv1 "github.com/openfga/openfga/pkg/server/proto/openfga/watch/v1"
+	"github.com/openfga/openfga/pkg/telemetry"
+)
+
+var _ watchv1.WatchServiceServer = (*Server)(nil)
+
+// Watch streams the changes of a store as they are committed, see [watchv1.WatchServiceServer].
+func (s *Server) Watch(req *watchv1.WatchRequest, srv grpc.ServerStreamingServer[watchv1.WatchResponse]) error {
+	ctx, span := tracer.Start(srv.Context(), apimethod.Watch.String(), trace.WithAttributes(
+		attribute.String("store_id", req.GetStoreId()),
+		attribute.String("type", req.GetType()),
+		attribute.String("relation", req.GetRelation()),
+	))
+	defer span.End()
+
+	// The request has no generated validation, reuse the rules of ReadChanges for the fields they share.
+	if err := (&openfgav1.ReadChangesRequest{StoreId: req.GetStoreId(), Type: req.GetType()}).Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx = telemetry.ContextWithRPCInfo(ctx, telemetry.RPCInfo{
+		Service: s.serviceName,
+		Method:  apimethod.Watch.String(),
+	})
+
+	err := s.checkAuthz(ctx, req.GetStoreId(), apimethod.Watch)
+	if err != nil {
+		return err
+	}
+
+	// End the stream when the server shuts down, as it would otherwise never end.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(s.ctx, cancel)
+	defer stop()
+
+	q := commands.NewWatchQuery(s.datastore,
+		commands.WithWatchQueryLogger(s.logger),
+		commands.WithWatchQueryEncoder(s.encoder),
+		commands.WithWatchQueryContinuationTokenSerializer(s.tokenSerializer),
+		commands.WithWatchQueryHorizonOffset(s.changelogHorizonOffset),
+		commands.WithWatchQueryCheckHorizon(s.changelogRetention),
+		commands.WithWatchQueryPollInterval(s.watchPollInterval),
+	)
+	return q.Execute(ctx, req, srv)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/openfga/openfga/pkg/authclaims"
 	"github.com/openfga/openfga/pkg/middleware/validator"
 	"github.com/openfga/openfga/pkg/server/commands"
+	serverErrors "github.com/openfga/openfga/pkg/server/errors"
 	"github.com/openfga/openfga/pkg/telemetry"
 )
 
@@ -38,6 +40,11 @@ func (s *Server) Write(ctx context.Context, req *openfgav1.WriteRequest) (*openf
 		Method:  apimethod.Write.String(),
 	})
 
+	expiresAt, err := tupleExpiresAtFromContext(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+
 	storeID := req.GetStoreId()
 
 	typesys, err := s.resolveTypesystem(ctx, storeID, req.GetAuthorizationModelId())
@@ -54,6 +61,7 @@ func (s *Server) Write(ctx context.Context, req *openfgav1.WriteRequest) (*openf
 	cmd := commands.NewWriteCommand(
 		s.datastore,
 		commands.WithWriteCmdLogger(s.logger),
+		commands.WithWriteCmdTupleExpiresAt(expiresAt),
 	)
 	resp, err := cmd.Execute(ctx, &openfgav1.WriteRequest{
 		StoreId:              storeID,
@@ -72,3 +80,16 @@ func (s *Server) Write(ctx context.Context, req *openfgav1.WriteRequest) (*openf
 
 	return resp, err
 }
+
+// tupleExpiresAtFromContext returns the expiry set by the [TupleExpiresAtHeader] of the request,
+// or the zero time if the header is absent. The expiry must be after now.
+func tupleExpiresAtFromContext(ctx context.Context, now time.Time) (time.Time, error) {
+	expiresAt, err := timeFromHeader(ctx, TupleExpiresAtHeader)
+	if err != nil || expiresAt.IsZero() {
+		return time.Time{}, err
+	}
+	if !expiresAt.After(now) {
+		return time.Time{}, serverErrors.ValidationError(fmt.Errorf("invalid %s header: %s is not in the future", TupleExpiresAtHeader, expiresAt.Format(time.RFC3339Nano)))
+	}
+	return expiresAt, nil
+}
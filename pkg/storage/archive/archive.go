@@ -0,0 +1,275 @@
+// Package archive exports a store of a [storage.OpenFGADatastore] to a portable archive, and imports
+// it back into any datastore.
+//
+// An archive is a gzip-compressed tar file made of, in this order:
+//
+//   - manifest.json, holding the format version and the store metadata.
+//   - models/NNNNNNNN.json, one per authorization model, oldest first, holding the model and its
+//     assertions.
+//   - tuples/NNNNNNNN.ndjson, chunks of at most [DefaultTuplesPerChunk] tuples, one per line along
+//     with its expiry, if it expires.
+//
+// The models, assertions, store and tuples are encoded with protojson, so that archives are
+// readable with standard tools. Entries are written and read one at a time, so that stores with
+// tens of millions of tuples never sit in memory.
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+const (
+	// FormatVersion is the version of the archives written by [Export]. [Import] rejects archives
+	// with another version.
+	FormatVersion = 1
+
+	// DefaultTuplesPerChunk is the default number of tuples per tuples entry of an archive.
+	DefaultTuplesPerChunk = 10000
+
+	manifestEntry = "manifest.json"
+	modelsDir     = "models/"
+	tuplesDir     = "tuples/"
+)
+
+// ErrInvalidArchive is returned by [Import] when the archive is not a valid store archive.
+var ErrInvalidArchive = errors.New("invalid store archive")
+
+type manifest struct {
+	Version    int             `json:"version"`
+	Store      json.RawMessage `json:"store"`
+	ExportedAt time.Time       `json:"exported_at"`
+}
+
+type modelRecord struct {
+	AuthorizationModel json.RawMessage   `json:"authorization_model"`
+	Assertions         []json.RawMessage `json:"assertions,omitempty"`
+}
+
+// tupleRecord is a line of a tuples entry.
+type tupleRecord struct {
+	Tuple     json.RawMessage `json:"tuple"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty"`
+}
+
+// Stats counts the models and tuples of an exported or imported store.
+type Stats struct {
+	Models int
+	Tuples int
+}
+
+// ExportOptions configures [Export].
+type ExportOptions struct {
+	// TuplesPerChunk is the number of tuples per tuples entry. Defaults to DefaultTuplesPerChunk.
+	TuplesPerChunk int
+}
+
+// Export writes the archive of the store with the given ID to w: its metadata, all the versions of
+// its authorization model along with their assertions, and all its tuples.
+func Export(ctx context.Context, datastore storage.OpenFGADatastore, storeID string, w io.Writer, options ExportOptions) (Stats, error) {
+	var stats Stats
+	tuplesPerChunk := options.TuplesPerChunk
+	if tuplesPerChunk <= 0 {
+		tuplesPerChunk = DefaultTuplesPerChunk
+	}
+
+	store, err := datastore.GetStore(ctx, storeID)
+	if err != nil {
+		return stats, fmt.Errorf("get store: %w", err)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	storeJSON, err := protojson.Marshal(store)
+	if err != nil {
+		return stats, err
+	}
+	err = writeJSONEntry(tw, manifestEntry, manifest{
+		Version:    FormatVersion,
+		Store:      storeJSON,
+		ExportedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	modelIDs, err := readModelIDs(ctx, datastore, storeID)
+	if err != nil {
+		return stats, err
+	}
+	for _, modelID := range modelIDs {
+		model, err := datastore.ReadAuthorizationModel(ctx, storeID, modelID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				// Models without types are not readable, nor usable.
+				continue
+			}
+			return stats, fmt.Errorf("read authorization model %s: %w", modelID, err)
+		}
+		assertions, err := datastore.ReadAssertions(ctx, storeID, modelID)
+		if err != nil {
+			return stats, fmt.Errorf("read assertions of authorization model %s: %w", modelID, err)
+		}
+
+		record := modelRecord{}
+		if record.AuthorizationModel, err = protojson.Marshal(model); err != nil {
+			return stats, err
+		}
+		for _, assertion := range assertions {
+			assertionJSON, err := protojson.Marshal(assertion)
+			if err != nil {
+				return stats, err
+			}
+			record.Assertions = append(record.Assertions, assertionJSON)
+		}
+
+		stats.Models++
+		if err := writeJSONEntry(tw, fmt.Sprintf("%s%08d.json", modelsDir, stats.Models), record); err != nil {
+			return stats, err
+		}
+	}
+
+	if err := exportTuples(ctx, datastore, storeID, tw, tuplesPerChunk, &stats); err != nil {
+		return stats, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return stats, err
+	}
+	return stats, gw.Close()
+}
+
+// exportTuples writes the tuples of the store to tw in chunks of tuplesPerChunk tuples. The tuples are
+// read with an iterator rather than pages, since the datastores report the expiry of a tuple as
+// Next returns it, which ties the expiry to the tuple.
+func exportTuples(ctx context.Context, datastore storage.OpenFGADatastore, storeID string, tw *tar.Writer, tuplesPerChunk int, stats *Stats) error {
+	var expiresAt time.Time
+	ctx = storage.ContextWithTupleExpiryObserver(ctx, func(t time.Time) {
+		expiresAt = t
+	})
+
+	iter, err := datastore.Read(ctx, storeID, storage.ReadFilter{}, storage.ReadOptions{
+		Consistency: storage.ConsistencyOptions{
+			Preference: openfgav1.ConsistencyPreference_HIGHER_CONSISTENCY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("read tuples: %w", err)
+	}
+	defer iter.Stop()
+
+	var (
+		chunk       bytes.Buffer
+		chunkTuples int
+		chunks      int
+	)
+	flush := func() error {
+		if chunkTuples == 0 {
+			return nil
+		}
+		chunks++
+		stats.Tuples += chunkTuples
+		if err := writeEntry(tw, fmt.Sprintf("%s%08d.ndjson", tuplesDir, chunks), chunk.Bytes()); err != nil {
+			return err
+		}
+		chunk.Reset()
+		chunkTuples = 0
+		return nil
+	}
+
+	for {
+		expiresAt = time.Time{}
+		t, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, storage.ErrIteratorDone) {
+				return flush()
+			}
+			return fmt.Errorf("read tuples: %w", err)
+		}
+
+		record := tupleRecord{}
+		if record.Tuple, err = protojson.Marshal(t); err != nil {
+			return err
+		}
+		if !expiresAt.IsZero() {
+			record.ExpiresAt = &expiresAt
+		}
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		chunk.Write(line)
+		chunk.WriteByte('\n')
+
+		chunkTuples++
+		if chunkTuples == tuplesPerChunk {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readModelIDs returns the IDs of the authorization models of the store, oldest first.
+func readModelIDs(ctx context.Context, datastore storage.OpenFGADatastore, storeID string) ([]string, error) {
+	var (
+		modelIDs          []string
+		continuationToken string
+	)
+	for {
+		models, token, err := datastore.ReadAuthorizationModels(ctx, storeID, storage.ReadAuthorizationModelsOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, continuationToken),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("read authorization models: %w", err)
+		}
+		for _, model := range models {
+			modelIDs = append(modelIDs, model.GetId())
+		}
+		if token == "" {
+			break
+		}
+		continuationToken = token
+	}
+
+	slices.Reverse(modelIDs)
+	return modelIDs, nil
+}
+
+func writeJSONEntry(tw *tar.Writer, name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return writeEntry(tw, name, data)
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte) error {
+	err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(data)),
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
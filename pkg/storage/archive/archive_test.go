@@ -0,0 +1,305 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	parser "github.com/openfga/language/pkg/go/transformer"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/testutils"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
+)
+
+const tupleCount = 25
+
+// newStore writes a store with two models, assertions on the first one, and tupleCount tuples.
+func newStore(t *testing.T, ds storage.OpenFGADatastore) (*openfgav1.Store, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	store, err := ds.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "exported"})
+	require.NoError(t, err)
+
+	var modelIDs []string
+	for _, dsl := range []string{`
+		model
+			schema 1.1
+		type user
+		type document
+			relations
+				define viewer: [user]`, `
+		model
+			schema 1.1
+		type user
+		type document
+			relations
+				define viewer: [user, user with in_office]
+		condition in_office(office: string) {
+			office == "paris"
+		}`,
+	} {
+		model := &openfgav1.AuthorizationModel{
+			Id:              ulid.Make().String(),
+			SchemaVersion:   typesystem.SchemaVersion1_1,
+			TypeDefinitions: parser.MustTransformDSLToProto(dsl).GetTypeDefinitions(),
+			Conditions:      parser.MustTransformDSLToProto(dsl).GetConditions(),
+		}
+		require.NoError(t, ds.WriteAuthorizationModel(ctx, store.GetId(), model))
+		modelIDs = append(modelIDs, model.GetId())
+	}
+	require.NoError(t, ds.WriteAssertions(ctx, store.GetId(), modelIDs[0], []*openfgav1.Assertion{{
+		TupleKey:    tuple.NewAssertionTupleKey("document:1", "viewer", "user:jon"),
+		Expectation: true,
+	}}))
+
+	writes := make([]*openfgav1.TupleKey, 0, tupleCount)
+	for i := 0; i < tupleCount-1; i++ {
+		writes = append(writes, tuple.NewTupleKey(fmt.Sprintf("document:%d", i), "viewer", "user:jon"))
+	}
+	writes = append(writes, tuple.NewTupleKeyWithCondition("document:paris", "viewer", "user:jon", "in_office", testutils.MustNewStruct(t, map[string]any{"office": "paris"})))
+	require.NoError(t, ds.Write(ctx, store.GetId(), nil, writes))
+
+	return store, modelIDs
+}
+
+func readTuples(t *testing.T, ds storage.OpenFGADatastore, storeID string) []string {
+	t.Helper()
+	tuples, _, err := ds.ReadPage(context.Background(), storeID, storage.ReadFilter{}, storage.ReadPageOptions{
+		Pagination: storage.NewPaginationOptions(100, ""),
+	})
+	require.NoError(t, err)
+
+	res := make([]string, 0, len(tuples))
+	for _, t := range tuples {
+		res = append(res, tuple.TupleKeyWithConditionToString(t.GetKey()))
+	}
+	return res
+}
+
+func export(t *testing.T, ds storage.OpenFGADatastore, storeID string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	stats, err := Export(context.Background(), ds, storeID, &buf, ExportOptions{TuplesPerChunk: 10})
+	require.NoError(t, err)
+	require.Equal(t, Stats{Models: 2, Tuples: tupleCount}, stats)
+	return buf.Bytes()
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	source := memory.New()
+	t.Cleanup(source.Close)
+
+	store, modelIDs := newStore(t, source)
+	archive := export(t, source, store.GetId())
+
+	t.Run("preserve_ids", func(t *testing.T) {
+		target := memory.New()
+		t.Cleanup(target.Close)
+
+		stats, err := Import(ctx, target, bytes.NewReader(archive), ImportOptions{PreserveModelIDs: true})
+		require.NoError(t, err)
+		require.Equal(t, Stats{Models: 2, Tuples: tupleCount}, stats)
+
+		imported, err := target.GetStore(ctx, store.GetId())
+		require.NoError(t, err)
+		require.Equal(t, "exported", imported.GetName())
+
+		latest, err := target.FindLatestAuthorizationModel(ctx, store.GetId())
+		require.NoError(t, err)
+		require.Equal(t, modelIDs[1], latest.GetId())
+		require.Len(t, latest.GetConditions(), 1)
+
+		assertions, err := target.ReadAssertions(ctx, store.GetId(), modelIDs[0])
+		require.NoError(t, err)
+		require.Len(t, assertions, 1)
+
+		require.ElementsMatch(t, readTuples(t, source, store.GetId()), readTuples(t, target, store.GetId()))
+	})
+
+	t.Run("remap_store_and_model_ids", func(t *testing.T) {
+		target := memory.New()
+		t.Cleanup(target.Close)
+
+		storeID := ulid.Make().String()
+		var checkpoint Checkpoint
+		_, err := Import(ctx, target, bytes.NewReader(archive), ImportOptions{
+			StoreID:   storeID,
+			StoreName: "imported",
+			OnCheckpoint: func(c Checkpoint) error {
+				checkpoint = c
+				return nil
+			},
+		})
+		require.NoError(t, err)
+
+		imported, err := target.GetStore(ctx, storeID)
+		require.NoError(t, err)
+		require.Equal(t, "imported", imported.GetName())
+
+		require.Len(t, checkpoint.ModelIDs, 2)
+		latest, err := target.FindLatestAuthorizationModel(ctx, storeID)
+		require.NoError(t, err)
+		require.Equal(t, checkpoint.ModelIDs[modelIDs[1]], latest.GetId())
+		require.NotEqual(t, modelIDs[1], latest.GetId())
+
+		assertions, err := target.ReadAssertions(ctx, storeID, checkpoint.ModelIDs[modelIDs[0]])
+		require.NoError(t, err)
+		require.Len(t, assertions, 1)
+
+		require.ElementsMatch(t, readTuples(t, source, store.GetId()), readTuples(t, target, storeID))
+	})
+
+	t.Run("store_already_exists", func(t *testing.T) {
+		_, err := Import(ctx, source, bytes.NewReader(archive), ImportOptions{})
+		require.ErrorIs(t, err, storage.ErrCollision)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		target := memory.New()
+		t.Cleanup(target.Close)
+
+		interrupted := errors.New("interrupted")
+		var checkpoint Checkpoint
+		_, err := Import(ctx, target, bytes.NewReader(archive), ImportOptions{
+			PreserveModelIDs: true,
+			OnCheckpoint: func(c Checkpoint) error {
+				checkpoint = c
+				if strings.HasPrefix(c.Entry, tuplesDir) {
+					return interrupted
+				}
+				return nil
+			},
+		})
+		require.ErrorIs(t, err, interrupted)
+		require.Equal(t, "tuples/00000001.ndjson", checkpoint.Entry)
+		require.Len(t, readTuples(t, target, store.GetId()), 10)
+
+		stats, err := Import(ctx, target, bytes.NewReader(archive), ImportOptions{
+			PreserveModelIDs: true,
+			Resume:           &checkpoint,
+		})
+		require.NoError(t, err)
+		require.Equal(t, Stats{Tuples: tupleCount - 10}, stats)
+		require.ElementsMatch(t, readTuples(t, source, store.GetId()), readTuples(t, target, store.GetId()))
+	})
+
+	t.Run("resume_remapped_model_ids", func(t *testing.T) {
+		target := memory.New()
+		t.Cleanup(target.Close)
+
+		storeID := ulid.Make().String()
+		interrupted := errors.New("interrupted")
+		var checkpoint Checkpoint
+		_, err := Import(ctx, target, bytes.NewReader(archive), ImportOptions{
+			StoreID: storeID,
+			OnCheckpoint: func(c Checkpoint) error {
+				// The import is interrupted once the first model is written, but before its
+				// entry is recorded.
+				if strings.HasPrefix(c.Entry, modelsDir) {
+					return interrupted
+				}
+				checkpoint = c
+				return nil
+			},
+		})
+		require.ErrorIs(t, err, interrupted)
+		require.Len(t, checkpoint.ModelIDs, 1)
+
+		_, err = Import(ctx, target, bytes.NewReader(archive), ImportOptions{
+			Resume: &checkpoint,
+			OnCheckpoint: func(c Checkpoint) error {
+				checkpoint = c
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, checkpoint.ModelIDs, 2)
+
+		models, _, err := target.ReadAuthorizationModels(ctx, storeID, storage.ReadAuthorizationModelsOptions{})
+		require.NoError(t, err)
+		require.Len(t, models, 2)
+		require.ElementsMatch(t, readTuples(t, source, store.GetId()), readTuples(t, target, storeID))
+	})
+
+	t.Run("invalid_archive", func(t *testing.T) {
+		target := memory.New()
+		t.Cleanup(target.Close)
+
+		_, err := Import(ctx, target, strings.NewReader("not an archive"), ImportOptions{})
+		require.ErrorIs(t, err, ErrInvalidArchive)
+
+		_, err = Import(ctx, target, bytes.NewReader(archive[:len(archive)/2]), ImportOptions{StoreID: ulid.Make().String()})
+		require.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
+
+func TestExportImportExpiringTuples(t *testing.T) {
+	ctx := context.Background()
+	source := memory.New()
+	t.Cleanup(source.Close)
+
+	store, err := source.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "exported"})
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	expiries := map[string]time.Time{
+		"document:permanent": {},
+		"document:hour":      now.Add(time.Hour),
+		"document:day":       now.Add(24 * time.Hour),
+	}
+	for object, expiresAt := range expiries {
+		require.NoError(t, source.Write(ctx, store.GetId(), nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey(object, "viewer", "user:jon"),
+		}, storage.WithExpiresAt(expiresAt)))
+	}
+	// The tuples of the same expiry are imported together.
+	require.NoError(t, source.Write(ctx, store.GetId(), nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:hour_too", "viewer", "user:jon"),
+	}, storage.WithExpiresAt(now.Add(time.Hour))))
+	expiries["document:hour_too"] = now.Add(time.Hour)
+
+	var buf bytes.Buffer
+	_, err = Export(ctx, source, store.GetId(), &buf, ExportOptions{})
+	require.NoError(t, err)
+
+	target := memory.New()
+	t.Cleanup(target.Close)
+	stats, err := Import(ctx, target, &buf, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, Stats{Tuples: len(expiries)}, stats)
+
+	for object, expiresAt := range expiries {
+		var observed time.Time
+		readCtx := storage.ContextWithTupleExpiryObserver(ctx, func(t time.Time) {
+			observed = t
+		})
+		_, err := target.ReadUserTuple(readCtx, store.GetId(), storage.ReadUserTupleFilter{
+			Object:   object,
+			Relation: "viewer",
+			User:     "user:jon",
+		}, storage.ReadUserTupleOptions{})
+		require.NoError(t, err)
+		require.True(t, expiresAt.Equal(observed), "%s expires at %s, want %s", object, observed, expiresAt)
+	}
+}
+
+func TestExportStoreNotFound(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	_, err := Export(context.Background(), ds, ulid.Make().String(), &bytes.Buffer{}, ExportOptions{})
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
@@ -0,0 +1,286 @@
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// maxTupleLineSize bounds the size of a tuple in a tuples entry, conditions included.
+const maxTupleLineSize = 1 << 20
+
+// Checkpoint records the progress of an [Import], so that an interrupted import can be resumed.
+type Checkpoint struct {
+	// StoreID is the ID of the imported store.
+	StoreID string `json:"store_id"`
+
+	// ModelIDs maps the IDs of the models of the archive to the IDs they were imported with.
+	ModelIDs map[string]string `json:"model_ids,omitempty"`
+
+	// Entry is the name of the last archive entry fully imported.
+	Entry string `json:"entry"`
+}
+
+// ImportOptions configures [Import].
+type ImportOptions struct {
+	// StoreID is the ID of the imported store. Defaults to the ID of the exported store.
+	StoreID string
+
+	// StoreName is the name of the imported store. Defaults to the name of the exported store.
+	StoreName string
+
+	// PreserveModelIDs imports the authorization models with their IDs in the archive, rather than
+	// new IDs. The models are imported in the same order either way, so that the latest model of
+	// the store is the same.
+	PreserveModelIDs bool
+
+	// Resume resumes the import recorded by the checkpoint, skipping the entries it already
+	// imported. The store is created when it is nil, and must not exist.
+	Resume *Checkpoint
+
+	// OnCheckpoint is called after each archive entry is imported, with the progress to resume from
+	// if the import is interrupted. Import stops if it returns an error.
+	OnCheckpoint func(Checkpoint) error
+}
+
+// Import reads the archive written by [Export] from r, and imports the store it holds into
+// datastore. It returns the number of models and tuples imported, excluding those skipped when
+// resuming.
+func Import(ctx context.Context, datastore storage.OpenFGADatastore, r io.Reader, options ImportOptions) (Stats, error) {
+	var stats Stats
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return stats, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	tr := tar.NewReader(gr)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return stats, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if hdr.Name != manifestEntry {
+		return stats, fmt.Errorf("%w: the first entry must be %s", ErrInvalidArchive, manifestEntry)
+	}
+	var m manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return stats, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if m.Version != FormatVersion {
+		return stats, fmt.Errorf("%w: unsupported format version %d", ErrInvalidArchive, m.Version)
+	}
+	var store openfgav1.Store
+	if err := protojson.Unmarshal(m.Store, &store); err != nil {
+		return stats, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	checkpoint := Checkpoint{
+		StoreID:  store.GetId(),
+		ModelIDs: make(map[string]string),
+		Entry:    manifestEntry,
+	}
+	if options.StoreID != "" {
+		checkpoint.StoreID = options.StoreID
+	}
+	if options.Resume != nil {
+		checkpoint.StoreID = options.Resume.StoreID
+		maps.Copy(checkpoint.ModelIDs, options.Resume.ModelIDs)
+		if _, err := datastore.GetStore(ctx, checkpoint.StoreID); err != nil {
+			return stats, fmt.Errorf("get store %s: %w", checkpoint.StoreID, err)
+		}
+	} else {
+		name := store.GetName()
+		if options.StoreName != "" {
+			name = options.StoreName
+		}
+		_, err := datastore.CreateStore(ctx, &openfgav1.Store{Id: checkpoint.StoreID, Name: name})
+		if err != nil {
+			return stats, fmt.Errorf("create store %s: %w", checkpoint.StoreID, err)
+		}
+		if err := saveCheckpoint(options, checkpoint); err != nil {
+			return stats, err
+		}
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		if options.Resume != nil && hdr.Name <= options.Resume.Entry {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(hdr.Name, modelsDir):
+			if err := importModel(ctx, datastore, tr, options, checkpoint); err != nil {
+				return stats, fmt.Errorf("import %s: %w", hdr.Name, err)
+			}
+			stats.Models++
+		case strings.HasPrefix(hdr.Name, tuplesDir):
+			n, err := importTuples(ctx, datastore, tr, checkpoint.StoreID)
+			if err != nil {
+				return stats, fmt.Errorf("import %s: %w", hdr.Name, err)
+			}
+			stats.Tuples += n
+		default:
+			return stats, fmt.Errorf("%w: unexpected entry %s", ErrInvalidArchive, hdr.Name)
+		}
+
+		checkpoint.Entry = hdr.Name
+		if err := saveCheckpoint(options, checkpoint); err != nil {
+			return stats, err
+		}
+	}
+}
+
+func saveCheckpoint(options ImportOptions, checkpoint Checkpoint) error {
+	if options.OnCheckpoint == nil {
+		return nil
+	}
+	checkpoint.ModelIDs = maps.Clone(checkpoint.ModelIDs)
+	if err := options.OnCheckpoint(checkpoint); err != nil {
+		return fmt.Errorf("save checkpoint: %w", err)
+	}
+	return nil
+}
+
+// importModel imports the model of a models entry and its assertions, and records the ID it is
+// imported with in checkpoint.
+func importModel(ctx context.Context, datastore storage.OpenFGADatastore, r io.Reader, options ImportOptions, checkpoint Checkpoint) error {
+	var record modelRecord
+	if err := json.NewDecoder(r).Decode(&record); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	var model openfgav1.AuthorizationModel
+	if err := protojson.Unmarshal(record.AuthorizationModel, &model); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	assertions := make([]*openfgav1.Assertion, 0, len(record.Assertions))
+	for _, assertionJSON := range record.Assertions {
+		var assertion openfgav1.Assertion
+		if err := protojson.Unmarshal(assertionJSON, &assertion); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		assertions = append(assertions, &assertion)
+	}
+
+	archivedID := model.GetId()
+	if !options.PreserveModelIDs {
+		id, ok := checkpoint.ModelIDs[archivedID]
+		if !ok {
+			// The new ID is saved before the model is written, so that resuming an import
+			// interrupted in between finds the model rather than writing it again.
+			id = ulid.Make().String()
+			checkpoint.ModelIDs[archivedID] = id
+			if err := saveCheckpoint(options, checkpoint); err != nil {
+				return err
+			}
+		}
+		model.Id = id
+	}
+
+	written := false
+	if options.Resume != nil {
+		// The model may have been written before the import was interrupted.
+		_, err := datastore.ReadAuthorizationModel(ctx, checkpoint.StoreID, model.GetId())
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		written = err == nil
+	}
+	if !written {
+		if err := datastore.WriteAuthorizationModel(ctx, checkpoint.StoreID, &model); err != nil {
+			return err
+		}
+	}
+	checkpoint.ModelIDs[archivedID] = model.GetId()
+
+	if len(assertions) == 0 {
+		return nil
+	}
+	return datastore.WriteAssertions(ctx, checkpoint.StoreID, model.GetId(), assertions)
+}
+
+// importTuples writes the tuples of a tuples entry, ignoring those that already exist so that a
+// partially imported entry can be imported again. The tuples are written in batches of the same
+// expiry, since a write sets the expiry of all its tuples.
+func importTuples(ctx context.Context, datastore storage.OpenFGADatastore, r io.Reader, storeID string) (int, error) {
+	batchSize := datastore.MaxTuplesPerWrite()
+	if batchSize <= 0 {
+		batchSize = storage.DefaultPageSize
+	}
+
+	var (
+		imported int
+		batches  = make(map[time.Time]storage.Writes)
+	)
+	flush := func(expiresAt time.Time) error {
+		batch := batches[expiresAt]
+		if len(batch) == 0 {
+			return nil
+		}
+		err := datastore.Write(ctx, storeID, nil, batch,
+			storage.WithOnDuplicateInsert(storage.OnDuplicateInsertIgnore),
+			storage.WithExpiresAt(expiresAt),
+		)
+		if err != nil {
+			return err
+		}
+		imported += len(batch)
+		delete(batches, expiresAt)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxTupleLineSize)
+	for scanner.Scan() {
+		var record tupleRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return imported, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		var t openfgav1.Tuple
+		if err := protojson.Unmarshal(record.Tuple, &t); err != nil {
+			return imported, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+
+		var expiresAt time.Time
+		if record.ExpiresAt != nil {
+			expiresAt = record.ExpiresAt.UTC()
+		}
+		batches[expiresAt] = append(batches[expiresAt], t.GetKey())
+		if len(batches[expiresAt]) == batchSize {
+			if err := flush(expiresAt); err != nil {
+				return imported, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return imported, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	for _, expiresAt := range slices.SortedFunc(maps.Keys(batches), time.Time.Compare) {
+		if err := flush(expiresAt); err != nil {
+			return imported, err
+		}
+	}
+	return imported, nil
+}
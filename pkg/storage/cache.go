@@ -41,6 +41,8 @@ const (
 	iteratorCachePrefix        = "ic."
 	changelogCachePrefix       = "cc."
 	invalidIteratorCachePrefix = "iq."
+	invalidQueryCachePrefix    = "iqc."
+	invalidCheckCachePrefix    = "ick."
 	defaultMaxCacheSize        = 10000
 	oneYear                    = time.Hour * 24 * 365
 
@@ -201,6 +203,37 @@ func GetInvalidIteratorByObjectRelationCacheKey(storeID, object, relation string
 	return invalidIteratorCachePrefix + storeID + "-or/" + object + "#" + relation
 }
 
+// GetInvalidIteratorByObjectTypeCacheKey returns the key invalidating the cached iterators over
+// the tuples of objectType in the store.
+func GetInvalidIteratorByObjectTypeCacheKey(storeID, objectType string) string {
+	return invalidIteratorCachePrefix + storeID + "-ot/" + objectType
+}
+
+// GetInvalidQueryCacheKey returns the key holding the time of the last invalidation of the
+// store received from an [InvalidationNotifier].
+func GetInvalidQueryCacheKey(storeID string) string {
+	return invalidQueryCachePrefix + storeID
+}
+
+// GetInvalidCheckCacheKey returns the key invalidating every cached Check response of the store
+// carrying dependencies.
+func GetInvalidCheckCacheKey(storeID string) string {
+	return invalidCheckCachePrefix + storeID
+}
+
+// GetInvalidCheckRecordedCacheKey returns the key holding the time of the last write of the store
+// whose invalidations by object type and relation were recorded under the keys of
+// GetInvalidCheckCacheKey and GetInvalidCheckByObjectRelationCacheKey.
+func GetInvalidCheckRecordedCacheKey(storeID string) string {
+	return invalidCheckCachePrefix + storeID + "-rec"
+}
+
+// GetInvalidCheckByObjectRelationCacheKey returns the key invalidating the cached Check responses
+// of the store that depend on the tuples of objectRelation, formatted as "objectType#relation".
+func GetInvalidCheckByObjectRelationCacheKey(storeID, objectRelation string) string {
+	return invalidCheckCachePrefix + storeID + "-or/" + objectRelation
+}
+
 func GetInvalidIteratorByUserObjectTypeCacheKeys(storeID string, users []string, objectType string) []string {
 	res := make([]string, len(users))
 	var i int
@@ -214,6 +247,9 @@ func GetInvalidIteratorByUserObjectTypeCacheKeys(storeID string, users []string,
 type TupleIteratorCacheEntry struct {
 	Tuples       []*TupleRecord
 	LastModified time.Time
+
+	// ExpiresAt is the earliest expiry of the tuples, or the zero time if none of them expires.
+	ExpiresAt time.Time
 }
 
 func (t *TupleIteratorCacheEntry) CacheEntityType() string {
@@ -19,6 +19,14 @@ var (
 	// ErrInvalidStartTime is returned when start time param for ReadChanges API is invalid.
 	ErrInvalidStartTime = errors.New("invalid start time")
 
+	// ErrChangelogTruncated is returned when reading changes from a ULID before the retention
+	// horizon of the store, as the changes following it were pruned, see [ChangelogPruner].
+	ErrChangelogTruncated = errors.New("changes were pruned from the changelog past the continuation token")
+
+	// ErrHistoryPruned is returned when reading the tuples of a store as they were at a point in
+	// time requires changes pruned from its changelog, see [ChangelogPruner].
+	ErrHistoryPruned = errors.New("changes following the point in time were pruned from the changelog")
+
 	// ErrInvalidWriteInput is returned when the tuple to be written
 	// already existed or the tuple to be deleted did not exist.
 	ErrInvalidWriteInput = errors.New("tuple to be written already existed or the tuple to be deleted did not exist")
@@ -32,9 +40,21 @@ var (
 	// ErrTransactionalWriteFailed is returned when two writes attempt to write the same tuple at the same time.
 	ErrTransactionalWriteFailed = errors.New("transactional write failed due to conflict")
 
+	// ErrTransactionRetriesExhausted is returned when a transaction kept conflicting with
+	// concurrent transactions until the datastore gave up retrying it.
+	ErrTransactionRetriesExhausted = errors.New("transaction retries exhausted due to concurrent modifications")
+
+	// ErrTransactionTooLarge is returned when a write modifies more rows than the datastore
+	// can commit in a single transaction.
+	ErrTransactionTooLarge = errors.New("write exceeds the maximum number of rows modified in a single transaction")
+
 	// ErrTransactionThrottled is returned when throttling is applied at the datastore level.
 	ErrTransactionThrottled = errors.New("transaction throttled")
 
+	// ErrTupleExpiryNotSupported is returned when writing tuples with an expiry, see
+	// [WithExpiresAt], to a datastore that can not store it.
+	ErrTupleExpiryNotSupported = errors.New("the datastore does not support expiring tuples")
+
 	// ErrNotFound is returned when the object does not exist.
 	ErrNotFound = errors.New("not found")
 )
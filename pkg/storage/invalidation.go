@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Invalidation describes a write to the tuples of a store.
+type Invalidation struct {
+	StoreID string
+
+	// ObjectRelations are the distinct object types and relations of the written and deleted
+	// tuples, formatted as "objectType#relation". Empty means that any tuple of the store may
+	// have changed.
+	ObjectRelations []string
+}
+
+// ObjectTypes returns the distinct object types of the ObjectRelations, in order of first
+// appearance.
+func (i Invalidation) ObjectTypes() []string {
+	objectTypes := make([]string, 0, len(i.ObjectRelations))
+	for _, objectRelation := range i.ObjectRelations {
+		objectType, _, _ := strings.Cut(objectRelation, "#")
+		if !slices.Contains(objectTypes, objectType) {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	return objectTypes
+}
+
+// InvalidationNotifier broadcasts the writes made to a datastore, so that caches can discard
+// the entries they invalidate as soon as they happen rather than on their next poll of the
+// changelog.
+type InvalidationNotifier interface {
+	// Notify broadcasts inv to the subscribers of every instance sharing the notifier.
+	Notify(ctx context.Context, inv Invalidation) error
+
+	// Subscribe registers fn to be called with every invalidation received. It returns a
+	// function that cancels the subscription.
+	Subscribe(fn func(Invalidation)) (unsubscribe func())
+
+	// Close stops receiving invalidations and releases the notifier resources.
+	Close()
+}
+
+// InProcessInvalidationNotifier is an [InvalidationNotifier] that delivers invalidations to the
+// subscribers of the same process only. It suits the memory datastore and tests.
+type InProcessInvalidationNotifier struct {
+	mu          sync.RWMutex
+	nextID      uint64
+	subscribers map[uint64]func(Invalidation)
+}
+
+var _ InvalidationNotifier = (*InProcessInvalidationNotifier)(nil)
+
+// NewInProcessInvalidationNotifier returns an [InProcessInvalidationNotifier] without subscribers.
+func NewInProcessInvalidationNotifier() *InProcessInvalidationNotifier {
+	return &InProcessInvalidationNotifier{
+		subscribers: make(map[uint64]func(Invalidation)),
+	}
+}
+
+// Notify calls every subscriber with inv before returning.
+func (n *InProcessInvalidationNotifier) Notify(_ context.Context, inv Invalidation) error {
+	n.Broadcast(inv)
+	return nil
+}
+
+// Broadcast calls every subscriber with inv. It lets other notifiers reuse the in-process
+// delivery for the invalidations they receive.
+func (n *InProcessInvalidationNotifier) Broadcast(inv Invalidation) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	for _, fn := range n.subscribers {
+		fn(inv)
+	}
+}
+
+// Subscribe see [InvalidationNotifier].Subscribe.
+func (n *InProcessInvalidationNotifier) Subscribe(fn func(Invalidation)) func() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	n.nextID++
+	n.subscribers[id] = fn
+
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		delete(n.subscribers, id)
+	}
+}
+
+// Close removes every subscriber.
+func (n *InProcessInvalidationNotifier) Close() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	clear(n.subscribers)
+}
@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcessInvalidationNotifier(t *testing.T) {
+	notifier := NewInProcessInvalidationNotifier()
+
+	var first, second []Invalidation
+	unsubscribe := notifier.Subscribe(func(inv Invalidation) { first = append(first, inv) })
+	notifier.Subscribe(func(inv Invalidation) { second = append(second, inv) })
+
+	inv := Invalidation{StoreID: "store", ObjectRelations: []string{"document#viewer"}}
+	require.NoError(t, notifier.Notify(context.Background(), inv))
+	require.Equal(t, []Invalidation{inv}, first)
+	require.Equal(t, []Invalidation{inv}, second)
+
+	unsubscribe()
+	require.NoError(t, notifier.Notify(context.Background(), inv))
+	require.Len(t, first, 1)
+	require.Len(t, second, 2)
+
+	notifier.Close()
+	require.NoError(t, notifier.Notify(context.Background(), inv))
+	require.Len(t, second, 2)
+}
+
+func TestInvalidationObjectTypes(t *testing.T) {
+	inv := Invalidation{StoreID: "store", ObjectRelations: []string{"folder#viewer", "document#viewer", "folder#parent"}}
+	require.Equal(t, []string{"folder", "document"}, inv.ObjectTypes())
+	require.Empty(t, Invalidation{StoreID: "store"}.ObjectTypes())
+}
@@ -3,6 +3,8 @@ package memory
 import (
 	"context"
 	"fmt"
+	"io"
+	"maps"
 	"slices"
 	"sort"
 	"strconv"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/oklog/ulid/v2"
 	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -76,6 +79,7 @@ func (s *staticIterator) Next(ctx context.Context) (*openfgav1.Tuple, error) {
 
 	next, rest := s.records[0], s.records[1:]
 	s.records = rest
+	storage.ObserveTupleExpiry(ctx, next.ExpiresAt)
 	return next.AsTuple(), nil
 }
 
@@ -95,6 +99,7 @@ func (s *staticIterator) Head(ctx context.Context) (*openfgav1.Tuple, error) {
 	}
 
 	rec := s.records[0]
+	storage.ObserveTupleExpiry(ctx, rec.ExpiresAt)
 	return rec.AsTuple(), nil
 }
 
@@ -127,6 +132,9 @@ type MemoryBackend struct {
 	maxTuplesPerWrite             int
 	maxTypesPerAuthorizationModel int
 
+	// now is the clock of the datastore, see WithClock.
+	now func() time.Time
+
 	// TupleBackend
 	// map: store => set of tuples
 	tuples      map[string][]*storage.TupleRecord // GUARDED_BY(mutexTuples).
@@ -135,6 +143,8 @@ type MemoryBackend struct {
 	// ChangelogBackend
 	// map: store => set of changes
 	changes map[string][]*tupleChangeRec // GUARDED_BY(mutexTuples).
+	// map: store => ULID of the latest pruned change
+	changelogHorizons map[string]ulid.ULID // GUARDED_BY(mutexTuples).
 
 	// AuthorizationModelBackend
 	// map: store = > map: type definition id => type definition
@@ -153,6 +163,18 @@ type MemoryBackend struct {
 // Ensures that [MemoryBackend] implements the [storage.OpenFGADatastore] interface.
 var _ storage.OpenFGADatastore = (*MemoryBackend)(nil)
 
+// Ensures that [MemoryBackend] implements the [storage.ChangelogPruner] interface.
+var _ storage.ChangelogPruner = (*MemoryBackend)(nil)
+
+// Ensures that [MemoryBackend] implements the [storage.TupleExpirer] interface.
+var _ storage.TupleExpirer = (*MemoryBackend)(nil)
+
+// Ensures that [MemoryBackend] implements the [storage.StoreCloner] interface.
+var _ storage.StoreCloner = (*MemoryBackend)(nil)
+
+// Ensures that [MemoryBackend] implements the [storage.BulkLoader] interface.
+var _ storage.BulkLoader = (*MemoryBackend)(nil)
+
 // AuthorizationModelEntry represents an entry in a storage system
 // that holds information about an authorization model.
 type AuthorizationModelEntry struct {
@@ -165,8 +187,10 @@ func New(opts ...StorageOption) storage.OpenFGADatastore {
 	ds := &MemoryBackend{
 		maxTuplesPerWrite:             defaultMaxTuplesPerWrite,
 		maxTypesPerAuthorizationModel: defaultMaxTypesPerAuthorizationModel,
+		now:                           time.Now,
 		tuples:                        make(map[string][]*storage.TupleRecord, 0),
 		changes:                       make(map[string][]*tupleChangeRec, 0),
+		changelogHorizons:             make(map[string]ulid.ULID, 0),
 		authorizationModels:           make(map[string]map[string]*AuthorizationModelEntry),
 		stores:                        make(map[string]*openfgav1.Store, 0),
 		assertions:                    make(map[string][]*openfgav1.Assertion, 0),
@@ -193,6 +217,13 @@ func WithMaxTypesPerAuthorizationModel(n int) StorageOption {
 	return func(ds *MemoryBackend) { ds.maxTypesPerAuthorizationModel = n }
 }
 
+// WithClock returns a [StorageOption] that sets the clock of a [MemoryBackend] instance, time.Now by default.
+// The clock dates the written tuples and changes, and decides which tuples have expired, so that tests can
+// expire tuples without waiting.
+func WithClock(now func() time.Time) StorageOption {
+	return func(ds *MemoryBackend) { ds.now = now }
+}
+
 // Close does not do anything for [MemoryBackend].
 func (s *MemoryBackend) Close() {}
 
@@ -233,12 +264,15 @@ func (s *MemoryBackend) ReadChanges(ctx context.Context, store string, filter st
 		}
 		from = &parsed
 	}
+	if horizon, ok := s.changelogHorizons[store]; ok && storage.IsBeforeChangelogHorizon(options, horizon.String()) {
+		return nil, "", storage.ErrChangelogTruncated
+	}
 
 	objectType := filter.ObjectType
 	horizonOffset := filter.HorizonOffset
 
 	var allChanges []*tupleChangeRec
-	now := time.Now().UTC()
+	now := s.now().UTC()
 	for _, changeRec := range s.changes[store] {
 		if objectType == "" || (strings.HasPrefix(changeRec.Change.GetTupleKey().GetObject(), objectType+":")) {
 			if changeRec.Change.GetTimestamp().AsTime().After(now.Add(-horizonOffset)) {
@@ -278,6 +312,7 @@ func (s *MemoryBackend) ReadChanges(ctx context.Context, store string, filter st
 
 	var last ulid.ULID
 	for _, change := range allChanges[:to] {
+		storage.ObserveChangeExpiry(ctx, change.Change, change.ExpiresAt)
 		res = append(res, change.Change)
 		last = change.Ulid
 	}
@@ -285,6 +320,33 @@ func (s *MemoryBackend) ReadChanges(ctx context.Context, store string, filter st
 	return res, last.String(), nil
 }
 
+// PruneChanges see [storage.ChangelogPruner].PruneChanges.
+func (s *MemoryBackend) PruneChanges(ctx context.Context, store string, options storage.PruneChangesOptions) (int, error) {
+	_, span := tracer.Start(ctx, "memory.PruneChanges")
+	defer span.End()
+
+	s.mutexTuples.Lock()
+	defer s.mutexTuples.Unlock()
+
+	changes := s.changes[store]
+	pruned := 0
+	if options.MaxChanges > 0 && len(changes) > options.MaxChanges {
+		pruned = len(changes) - options.MaxChanges
+	}
+	if cutoff := storage.ChangelogPruneCutoff(s.now(), options.MaxAge); cutoff != "" {
+		for pruned < len(changes) && changes[pruned].Ulid.String() < cutoff {
+			pruned++
+		}
+	}
+	if pruned == 0 {
+		return 0, nil
+	}
+
+	s.changelogHorizons[store] = changes[pruned-1].Ulid
+	s.changes[store] = slices.Clone(changes[pruned:])
+	return pruned, nil
+}
+
 // read returns an iterator of a store's tuples with a given tuple as filter.
 // A nil paginationOptions input means the returned iterator will iterate through all values.
 func (s *MemoryBackend) read(ctx context.Context, store string, filter storage.ReadFilter, options *storage.ReadPageOptions) (*staticIterator, error) {
@@ -294,12 +356,20 @@ func (s *MemoryBackend) read(ctx context.Context, store string, filter storage.R
 	s.mutexTuples.RLock()
 	defer s.mutexTuples.RUnlock()
 
+	now := storage.TupleExpiryTime(ctx, s.now())
 	var matches []*storage.TupleRecord
 	if filter.Object == "" && filter.Relation == "" && filter.User == "" {
-		matches = make([]*storage.TupleRecord, len(s.tuples[store]))
-		copy(matches, s.tuples[store])
+		matches = make([]*storage.TupleRecord, 0, len(s.tuples[store]))
+		for _, t := range s.tuples[store] {
+			if !storage.IsExpired(t.ExpiresAt, now) {
+				matches = append(matches, t)
+			}
+		}
 	} else {
 		for _, t := range s.tuples[store] {
+			if storage.IsExpired(t.ExpiresAt, now) {
+				continue
+			}
 			if match(t, &openfgav1.TupleKey{
 				Object:   filter.Object,
 				Relation: filter.Relation,
@@ -336,8 +406,9 @@ func (s *MemoryBackend) read(ctx context.Context, store string, filter storage.R
 }
 
 type tupleChangeRec struct {
-	Change *openfgav1.TupleChange
-	Ulid   ulid.ULID
+	Change    *openfgav1.TupleChange
+	Ulid      ulid.ULID
+	ExpiresAt time.Time // The expiry of the tuple written, the zero time if it never expires.
 }
 
 // Write see [storage.RelationshipTupleWriter].Write.
@@ -348,36 +419,37 @@ func (s *MemoryBackend) Write(ctx context.Context, store string, deletes storage
 	s.mutexTuples.Lock()
 	defer s.mutexTuples.Unlock()
 
-	now := timestamppb.Now()
+	now := timestamppb.New(s.now())
+	writeOpts := storage.NewTupleWriteOptions(opts...)
+	entropy := ulid.DefaultEntropy()
+
+	// Expired tuples behave as if they were deleted, so the write deletes those it modifies first.
+	var expiredChanges []*tupleChangeRec
+	current := make([]*storage.TupleRecord, 0, len(s.tuples[store]))
+	for _, tr := range s.tuples[store] {
+		if storage.IsExpired(tr.ExpiresAt, now.AsTime()) && matchesAny(tr, deletes, writes) {
+			expiredChanges = append(expiredChanges, newDeleteChange(tr, now, entropy))
+			continue
+		}
+		current = append(current, tr)
+	}
 
-	duplicateDeletes, _, err := sanitizeTuplesWriteDelete(s.tuples[store], deletes, writes, storage.NewTupleWriteOptions(opts...))
+	duplicateDeletes, _, err := sanitizeTuplesWriteDelete(current, deletes, writes, writeOpts)
 	if err != nil {
 		return err
 	}
+	s.changes[store] = append(s.changes[store], expiredChanges...)
 
 	var records []*storage.TupleRecord
-	entropy := ulid.DefaultEntropy()
 Delete:
-	for _, tr := range s.tuples[store] {
-		t := tr.AsTuple()
-		tk := t.GetKey()
+	for _, tr := range current {
 		for i, k := range deletes {
 			if match(tr, tupleUtils.TupleKeyWithoutConditionToTupleKey(k)) {
 				if slices.Contains(duplicateDeletes, i) {
 					// noop for duplicate delete
 					continue
 				}
-				s.changes[store] = append(
-					s.changes[store],
-					&tupleChangeRec{
-						Change: &openfgav1.TupleChange{
-							TupleKey:  tupleUtils.NewTupleKey(tk.GetObject(), tk.GetRelation(), tk.GetUser()), // Redact the condition info.
-							Operation: openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
-							Timestamp: now,
-						},
-						Ulid: ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy),
-					},
-				)
+				s.changes[store] = append(s.changes[store], newDeleteChange(tr, now, entropy))
 				continue Delete
 			}
 		}
@@ -413,6 +485,7 @@ Write:
 			ConditionContext: conditionContext,
 			Ulid:             ulid.MustNew(ulid.Timestamp(now.AsTime()), ulid.DefaultEntropy()).String(),
 			InsertedAt:       now.AsTime(),
+			ExpiresAt:        writeOpts.ExpiresAt,
 		})
 
 		tk := tupleUtils.NewTupleKeyWithCondition(
@@ -429,13 +502,159 @@ Write:
 				Operation: openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
 				Timestamp: now,
 			},
-			Ulid: ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy),
+			Ulid:      ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy),
+			ExpiresAt: writeOpts.ExpiresAt,
 		})
 	}
 	s.tuples[store] = records
 	return nil
 }
 
+// matchesAny returns true if tr is the tuple of one of deletes or writes.
+func matchesAny(tr *storage.TupleRecord, deletes storage.Deletes, writes storage.Writes) bool {
+	for _, k := range deletes {
+		if match(tr, tupleUtils.TupleKeyWithoutConditionToTupleKey(k)) {
+			return true
+		}
+	}
+	for _, k := range writes {
+		if match(tr, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// newDeleteChange returns the change recording the deletion of tr.
+func newDeleteChange(tr *storage.TupleRecord, now *timestamppb.Timestamp, entropy io.Reader) *tupleChangeRec {
+	tk := tr.AsTuple().GetKey()
+	return &tupleChangeRec{
+		Change: &openfgav1.TupleChange{
+			TupleKey:  tupleUtils.NewTupleKey(tk.GetObject(), tk.GetRelation(), tk.GetUser()), // Redact the condition info.
+			Operation: openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+			Timestamp: now,
+		},
+		Ulid: ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy),
+	}
+}
+
+// BulkLoad see [storage.BulkLoader].BulkLoad.
+func (s *MemoryBackend) BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options storage.BulkLoadOptions) (int, error) {
+	_, span := tracer.Start(ctx, "memory.BulkLoad")
+	defer span.End()
+
+	s.mutexTuples.Lock()
+	defer s.mutexTuples.Unlock()
+
+	now := timestamppb.New(s.now())
+	entropy := ulid.DefaultEntropy()
+
+	loaded := make(map[string]struct{}, len(tuples))
+	for _, tk := range tuples {
+		loaded[tupleUtils.TupleKeyToString(tk)] = struct{}{}
+	}
+
+	// Expired tuples behave as if they were deleted, so the load replaces those it loads, like Write.
+	deleted := 0
+	existing := make(map[string]struct{}, len(s.tuples[store]))
+	current := make([]*storage.TupleRecord, 0, len(s.tuples[store]))
+	for _, tr := range s.tuples[store] {
+		key := tupleUtils.TupleKeyToString(tr.AsTuple().GetKey())
+		if _, ok := loaded[key]; ok && storage.IsExpired(tr.ExpiresAt, now.AsTime()) {
+			deleted++
+			if !options.SkipChangelog {
+				s.changes[store] = append(s.changes[store], newDeleteChange(tr, now, entropy))
+			}
+			continue
+		}
+		existing[key] = struct{}{}
+		current = append(current, tr)
+	}
+	s.tuples[store] = current
+
+	written := 0
+	for _, tk := range tuples {
+		key := tupleUtils.TupleKeyToString(tk)
+		if _, ok := existing[key]; ok {
+			continue
+		}
+		existing[key] = struct{}{}
+
+		objectType, objectID := tupleUtils.SplitObject(tk.GetObject())
+		s.tuples[store] = append(s.tuples[store], &storage.TupleRecord{
+			Store:            store,
+			ObjectType:       objectType,
+			ObjectID:         objectID,
+			Relation:         tk.GetRelation(),
+			User:             tk.GetUser(),
+			ConditionName:    tk.GetCondition().GetName(),
+			ConditionContext: tk.GetCondition().GetContext(),
+			Ulid:             ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy).String(),
+			InsertedAt:       now.AsTime(),
+		})
+		written++
+
+		if !options.SkipChangelog {
+			s.changes[store] = append(s.changes[store], &tupleChangeRec{
+				Change: &openfgav1.TupleChange{
+					TupleKey:  tupleUtils.NewTupleKeyWithCondition(tk.GetObject(), tk.GetRelation(), tk.GetUser(), tk.GetCondition().GetName(), tk.GetCondition().GetContext()),
+					Operation: openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
+					Timestamp: now,
+				},
+				Ulid: ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy),
+			})
+		}
+	}
+
+	if options.SkipChangelog && written+deleted > 0 {
+		// The changelog misses the load, so the changes before it no longer tell the state of the store.
+		horizon := ulid.MustNew(ulid.Timestamp(now.AsTime()), entropy)
+		if current, ok := s.changelogHorizons[store]; !ok || current.Compare(horizon) < 0 {
+			s.changelogHorizons[store] = horizon
+		}
+	}
+	return written, nil
+}
+
+// DeleteExpiredTuples see [storage.TupleExpirer].DeleteExpiredTuples.
+func (s *MemoryBackend) DeleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	_, span := tracer.Start(ctx, "memory.DeleteExpiredTuples")
+	defer span.End()
+
+	s.mutexTuples.Lock()
+	defer s.mutexTuples.Unlock()
+
+	if limit <= 0 {
+		limit = storage.DefaultDeleteExpiredTuplesBatchSize
+	}
+
+	changeTime := timestamppb.New(s.now())
+	entropy := ulid.DefaultEntropy()
+	deleted := 0
+	for _, store := range slices.Sorted(maps.Keys(s.tuples)) {
+		records := s.tuples[store]
+		if !slices.ContainsFunc(records, func(tr *storage.TupleRecord) bool { return storage.IsExpired(tr.ExpiresAt, now) }) {
+			continue
+		}
+
+		remaining := make([]*storage.TupleRecord, 0, len(records))
+		for _, tr := range records {
+			if deleted < limit && storage.IsExpired(tr.ExpiresAt, now) {
+				s.changes[store] = append(s.changes[store], newDeleteChange(tr, changeTime, entropy))
+				deleted++
+				continue
+			}
+			remaining = append(remaining, tr)
+		}
+		s.tuples[store] = remaining
+
+		if deleted == limit {
+			break
+		}
+	}
+	return deleted, nil
+}
+
 func sanitizeTuplesWriteDelete(
 	records []*storage.TupleRecord,
 	deletes []*openfgav1.TupleKeyWithoutCondition,
@@ -489,11 +708,16 @@ func (s *MemoryBackend) ReadUserTuple(ctx context.Context, store string, filter
 	s.mutexTuples.RLock()
 	defer s.mutexTuples.RUnlock()
 
+	now := storage.TupleExpiryTime(ctx, s.now())
 	for _, t := range s.tuples[store] {
+		if storage.IsExpired(t.ExpiresAt, now) {
+			continue
+		}
 		if match(t, tupleUtils.NewTupleKey(filter.Object, filter.Relation, filter.User)) {
 			if len(filter.Conditions) > 0 && !slices.Contains(filter.Conditions, t.ConditionName) {
 				continue
 			}
+			storage.ObserveTupleExpiry(ctx, t.ExpiresAt)
 			return t.AsTuple(), nil
 		}
 	}
@@ -515,8 +739,12 @@ func (s *MemoryBackend) ReadUsersetTuples(
 	s.mutexTuples.RLock()
 	defer s.mutexTuples.RUnlock()
 
+	now := storage.TupleExpiryTime(ctx, s.now())
 	var matches []*storage.TupleRecord
 	for _, t := range s.tuples[store] {
+		if storage.IsExpired(t.ExpiresAt, now) {
+			continue
+		}
 		if match(t, &openfgav1.TupleKey{
 			Object:   filter.Object,
 			Relation: filter.Relation,
@@ -558,12 +786,17 @@ func (s *MemoryBackend) ReadStartingWithUser(
 	s.mutexTuples.RLock()
 	defer s.mutexTuples.RUnlock()
 
+	now := storage.TupleExpiryTime(ctx, s.now())
 	var matches []*storage.TupleRecord
 	for _, t := range s.tuples[store] {
 		if t.ObjectType != filter.ObjectType {
 			continue
 		}
 
+		if storage.IsExpired(t.ExpiresAt, now) {
+			continue
+		}
+
 		if t.Relation != filter.Relation {
 			continue
 		}
@@ -572,6 +805,10 @@ func (s *MemoryBackend) ReadStartingWithUser(
 			continue
 		}
 
+		if filter.AfterObjectID != "" && t.ObjectID <= filter.AfterObjectID {
+			continue
+		}
+
 		if len(filter.Conditions) > 0 && !slices.Contains(filter.Conditions, t.ConditionName) {
 			continue
 		}
@@ -752,7 +989,7 @@ func (s *MemoryBackend) CreateStore(ctx context.Context, newStore *openfgav1.Sto
 		return nil, storage.ErrCollision
 	}
 
-	now := timestamppb.New(time.Now().UTC())
+	now := timestamppb.New(s.now().UTC())
 	s.stores[newStore.GetId()] = &openfgav1.Store{
 		Id:        newStore.GetId(),
 		Name:      newStore.GetName(),
@@ -775,6 +1012,70 @@ func (s *MemoryBackend) DeleteStore(ctx context.Context, id string) error {
 	return nil
 }
 
+// CloneStore see [storage.StoreCloner].CloneStore. The tuples are copied at once, in a single batch.
+func (s *MemoryBackend) CloneStore(ctx context.Context, source string, target *openfgav1.Store, options storage.CloneStoreOptions) (*openfgav1.Store, error) {
+	ctx, span := tracer.Start(ctx, "memory.CloneStore")
+	defer span.End()
+
+	if _, err := s.GetStore(ctx, source); err != nil {
+		return nil, err
+	}
+	store, err := s.CreateStore(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutexModels.Lock()
+	if models, ok := s.authorizationModels[source]; ok {
+		cloned := make(map[string]*AuthorizationModelEntry, len(models))
+		for id, entry := range models {
+			cloned[id] = &AuthorizationModelEntry{
+				model:  proto.Clone(entry.model).(*openfgav1.AuthorizationModel),
+				latest: entry.latest,
+			}
+		}
+		s.authorizationModels[store.GetId()] = cloned
+	}
+	s.mutexModels.Unlock()
+
+	s.mutexAssertions.Lock()
+	for assertionsID, assertions := range s.assertions {
+		modelID, ok := strings.CutPrefix(assertionsID, source+"|")
+		if !ok {
+			continue
+		}
+		cloned := make([]*openfgav1.Assertion, 0, len(assertions))
+		for _, assertion := range assertions {
+			cloned = append(cloned, proto.Clone(assertion).(*openfgav1.Assertion))
+		}
+		s.assertions[fmt.Sprintf("%s|%s", store.GetId(), modelID)] = cloned
+	}
+	s.mutexAssertions.Unlock()
+
+	s.mutexTuples.Lock()
+	now := s.now()
+	entropy := ulid.DefaultEntropy()
+	records := make([]*storage.TupleRecord, 0, len(s.tuples[source]))
+	for _, tr := range s.tuples[source] {
+		cloned := *tr
+		cloned.Store = store.GetId()
+		cloned.Ulid = ulid.MustNew(ulid.Timestamp(now), entropy).String()
+		if tr.ConditionContext != nil {
+			cloned.ConditionContext = proto.Clone(tr.ConditionContext).(*structpb.Struct)
+		}
+		records = append(records, &cloned)
+	}
+	s.tuples[store.GetId()] = records
+	// The copy has no changes from before it, so its state back then cannot be reconstructed.
+	s.changelogHorizons[store.GetId()] = ulid.MustNew(ulid.Timestamp(now), entropy)
+	s.mutexTuples.Unlock()
+
+	if options.OnProgress != nil {
+		options.OnProgress(len(records))
+	}
+	return store, nil
+}
+
 // WriteAssertions see [storage.AssertionsBackend].WriteAssertions.
 func (s *MemoryBackend) WriteAssertions(ctx context.Context, store, modelID string, assertions []*openfgav1.Assertion) error {
 	_, span := tracer.Start(ctx, "memory.WriteAssertions")
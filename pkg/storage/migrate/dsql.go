@@ -1,9 +1,12 @@
 package migrate
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
-	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
 
 	"github.com/openfga/openfga/assets"
 	"github.com/openfga/openfga/internal/dsql"
@@ -12,43 +15,85 @@ import (
 
 // dsqlMigrationConfig holds DSQL-specific migration configuration.
 type dsqlMigrationConfig struct {
-	driver         string
+	db             *sql.DB
 	migrationsPath string
-	uri            string
+	targetVersion  uint
 }
 
-// prepareDSQLMigration prepares the migration configuration for DSQL.
-func prepareDSQLMigration(uri string, username string, log logger.Logger) (*dsqlMigrationConfig, error) {
-	pgURI, err := dsql.PreparePostgresURI(uri, username)
+// prepareDSQLMigration prepares the migration configuration for DSQL. The returned connection
+// pool authenticates every new connection with a fresh IAM token, so that migrations outlasting
+// a token keep working.
+// A target version below [dsql.MigrationVersionMultiplier] is a schema revision,
+// and is converted to the version of the last DSQL migration of that revision.
+func prepareDSQLMigration(ctx context.Context, cfg MigrationConfig, log logger.Logger) (*dsqlMigrationConfig, error) {
+	if err := dsql.ValidateMigrations(assets.EmbedMigrations, assets.DSQLMigrationDir); err != nil {
+		return nil, fmt.Errorf("invalid DSQL migrations: %w", err)
+	}
+
+	targetVersion := cfg.TargetVersion
+	if targetVersion > 0 && targetVersion < dsql.MigrationVersionMultiplier {
+		version, err := dsql.RevisionVersion(int64(targetVersion))
+		if err != nil {
+			return nil, err
+		}
+		targetVersion = uint(version)
+	}
+
+	db, err := dsql.OpenDB(ctx, cfg.URI, cfg.Username, dsql.TokenConfig{
+		Profile:         cfg.DSQLAWSProfile,
+		RoleARN:         cfg.DSQLRoleARN,
+		RoleSessionName: cfg.DSQLRoleSessionName,
+		RoleExternalID:  cfg.DSQLRoleExternalID,
+		Lifetime:        cfg.DSQLTokenLifetime,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("prepare DSQL URI: %w", err)
+		return nil, fmt.Errorf("prepare DSQL connection: %w", err)
 	}
 
-	if err := ensureGooseTableForDSQL(pgURI, log); err != nil {
+	if err := ensureGooseTableForDSQL(ctx, db, log); err != nil {
+		_ = db.Close()
 		return nil, fmt.Errorf("create goose version table: %w", err)
 	}
 
 	log.Info("using DSQL datastore with IAM authentication")
 
 	return &dsqlMigrationConfig{
-		driver:         "pgx",
+		db:             db,
 		migrationsPath: assets.DSQLMigrationDir,
-		uri:            pgURI,
+		targetVersion:  targetVersion,
 	}, nil
 }
 
-// ensureGooseTableForDSQL creates the goose_db_version table if it doesn't exist.
-func ensureGooseTableForDSQL(uri string, log logger.Logger) error {
-	db, err := goose.OpenDBWithDriver("pgx", uri)
+// dsqlIndexPollInterval is how often the status of the async index builds is checked.
+var dsqlIndexPollInterval = 5 * time.Second
+
+// waitForDSQLIndexes waits up to timeout for the CREATE INDEX ASYNC jobs to complete.
+func waitForDSQLIndexes(ctx context.Context, db *sql.DB, timeout time.Duration, log logger.Logger) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	log.Info("waiting for DSQL async index builds to complete", zap.Duration("timeout", timeout))
+	err := dsql.WaitForIndexes(ctx, db, dsqlIndexPollInterval, func(pending []string) {
+		log.Info("DSQL indexes still building", zap.Strings("pending", pending))
+	})
 	if err != nil {
-		return fmt.Errorf("open connection: %w", err)
+		return fmt.Errorf("failed waiting for DSQL indexes: %w", err)
 	}
-	defer db.Close()
 
+	log.Info("DSQL indexes are ready")
+	return nil
+}
+
+// ensureGooseTableForDSQL creates the goose_db_version table if it doesn't exist.
+func ensureGooseTableForDSQL(ctx context.Context, db *sql.DB, log logger.Logger) error {
 	if err := dsql.EnsureGooseTable(db); err != nil {
 		return err
 	}
 
 	log.Info("ensured goose_db_version table exists for DSQL")
+
+	if err := dsql.UpgradeLegacyGooseVersions(ctx, db); err != nil {
+		return fmt.Errorf("upgrade goose versions of the earlier DSQL migrations: %w", err)
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package migrate
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/url"
 	"time"
@@ -25,6 +26,21 @@ type MigrationConfig struct {
 	Username      string
 	Password      string
 	Logger        logger.Logger
+	// WaitForIndexes is how long to wait, once the migrations ran, for the indexes that the
+	// 'dsql' engine builds asynchronously to become usable. 0 means do not wait.
+	WaitForIndexes time.Duration
+	// DSQLAWSProfile is the AWS shared config profile whose credentials generate the IAM
+	// authentication tokens of the 'dsql' engine. Empty uses the default credential chain.
+	DSQLAWSProfile string
+	// DSQLRoleARN is an IAM role to assume to generate the IAM authentication tokens of the
+	// 'dsql' engine, with DSQLRoleSessionName and DSQLRoleExternalID as session name and
+	// external id when set.
+	DSQLRoleARN         string
+	DSQLRoleSessionName string
+	DSQLRoleExternalID  string
+	// DSQLTokenLifetime is how long the IAM authentication tokens of the 'dsql' engine are
+	// valid. New tokens are generated before they expire. 0 means 15 minutes.
+	DSQLTokenLifetime time.Duration
 }
 
 // RunMigrations runs the migrations for the given config. This function is exposed to allow embedding openFGA
@@ -37,6 +53,11 @@ type MigrationConfig struct {
 // The function handles migrations for multiple database engines (postgres, mysql, sqlite) and supports
 // both upgrading and downgrading to specific versions.
 func RunMigrations(cfg MigrationConfig) error {
+	return RunMigrationsWithContext(context.Background(), cfg)
+}
+
+// RunMigrationsWithContext is RunMigrations, stopping when ctx is canceled.
+func RunMigrationsWithContext(ctx context.Context, cfg MigrationConfig) error {
 	goose.SetLogger(goose.NopLogger())
 	goose.SetVerbose(cfg.Verbose)
 
@@ -47,6 +68,7 @@ func RunMigrations(cfg MigrationConfig) error {
 
 	var driver, migrationsPath string
 	var uri string
+	var db *sql.DB
 	// We set uri based on engine
 	uri = cfg.URI
 	switch cfg.Engine {
@@ -104,37 +126,40 @@ func RunMigrations(cfg MigrationConfig) error {
 			return err
 		}
 	case "dsql":
-		dsqlCfg, err := prepareDSQLMigration(uri, cfg.Username, log)
+		dsqlCfg, err := prepareDSQLMigration(ctx, cfg, log)
 		if err != nil {
 			return err
 		}
-		driver = dsqlCfg.driver
+		db = dsqlCfg.db
 		migrationsPath = dsqlCfg.migrationsPath
-		uri = dsqlCfg.uri
+		cfg.TargetVersion = dsqlCfg.targetVersion
 	case "":
 		return fmt.Errorf("missing datastore engine type")
 	default:
 		return fmt.Errorf("unknown datastore engine type: %s", cfg.Engine)
 	}
 
-	db, err := goose.OpenDBWithDriver(driver, uri)
-	if err != nil {
-		return fmt.Errorf("failed to open a connection to the datastore: %w", err)
+	if db == nil {
+		var err error
+		db, err = goose.OpenDBWithDriver(driver, uri)
+		if err != nil {
+			return fmt.Errorf("failed to open a connection to the datastore: %w", err)
+		}
 	}
 	defer db.Close()
 
 	policy := backoff.NewExponentialBackOff()
 	policy.MaxElapsedTime = cfg.Timeout
-	err = backoff.Retry(func() error {
-		return db.PingContext(context.Background())
-	}, policy)
+	err := backoff.Retry(func() error {
+		return db.PingContext(ctx)
+	}, backoff.WithContext(policy, ctx))
 	if err != nil {
 		return fmt.Errorf("failed to initialize database connection: %w", err)
 	}
 
 	goose.SetBaseFS(assets.EmbedMigrations)
 
-	currentVersion, err := goose.GetDBVersion(db)
+	currentVersion, err := goose.GetDBVersionContext(ctx, db)
 	if err != nil {
 		return fmt.Errorf("failed to get db version: %w", err)
 	}
@@ -143,11 +168,11 @@ func RunMigrations(cfg MigrationConfig) error {
 
 	if cfg.TargetVersion == 0 {
 		log.Info("running all migrations")
-		if err := goose.Up(db, migrationsPath); err != nil {
+		if err := goose.UpContext(ctx, db, migrationsPath); err != nil {
 			return fmt.Errorf("failed to run migrations: %w", err)
 		}
 		log.Info("migration done")
-		return nil
+		return waitForIndexes(ctx, db, cfg, log)
 	}
 
 	log.Info("migration to", zap.Uint("target version", cfg.TargetVersion))
@@ -155,18 +180,27 @@ func RunMigrations(cfg MigrationConfig) error {
 
 	switch {
 	case targetInt64Version < currentVersion:
-		if err := goose.DownTo(db, migrationsPath, targetInt64Version); err != nil {
+		if err := goose.DownToContext(ctx, db, migrationsPath, targetInt64Version); err != nil {
 			return fmt.Errorf("failed to run migrations down to %v: %w", targetInt64Version, err)
 		}
 	case targetInt64Version > currentVersion:
-		if err := goose.UpTo(db, migrationsPath, targetInt64Version); err != nil {
+		if err := goose.UpToContext(ctx, db, migrationsPath, targetInt64Version); err != nil {
 			return fmt.Errorf("failed to run migrations up to %v: %w", targetInt64Version, err)
 		}
 	default:
 		log.Info("nothing to do")
-		return nil
+		return waitForIndexes(ctx, db, cfg, log)
 	}
 
 	log.Info("migration done")
-	return nil
+	return waitForIndexes(ctx, db, cfg, log)
+}
+
+// waitForIndexes waits for the indexes built asynchronously by the migrations to become usable.
+// Only the 'dsql' engine builds indexes asynchronously.
+func waitForIndexes(ctx context.Context, db *sql.DB, cfg MigrationConfig, log logger.Logger) error {
+	if cfg.Engine != "dsql" || cfg.WaitForIndexes <= 0 {
+		return nil
+	}
+	return waitForDSQLIndexes(ctx, db, cfg.WaitForIndexes, log)
 }
@@ -1,13 +1,18 @@
 package migrate_test
 
 import (
+	"context"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/require"
 
 	"github.com/openfga/openfga/cmd/util"
+	"github.com/openfga/openfga/internal/dsql"
 	"github.com/openfga/openfga/pkg/storage/migrate"
+	storagefixtures "github.com/openfga/openfga/pkg/testfixtures/storage"
 )
 
 func TestMigrateCommandRollbacks(t *testing.T) {
@@ -43,3 +48,37 @@ func TestMigrateCommandRollbacks(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeLegacyDSQLGooseVersions(t *testing.T) {
+	for legacyVersion, expectedVersion := range map[int64]int64{3: 301, 6: 602} {
+		t.Run(strconv.FormatInt(legacyVersion, 10), func(t *testing.T) {
+			container := storagefixtures.RunDatastoreTestContainer(t, "postgres")
+			db, err := goose.OpenDBWithDriver("pgx", container.GetConnectionURI(true))
+			require.NoError(t, err)
+			defer db.Close()
+
+			// Recreate the goose table the way the DSQL migrations did before they were split.
+			_, err = db.Exec(`DROP TABLE goose_db_version`)
+			require.NoError(t, err)
+			require.NoError(t, dsql.EnsureGooseTable(db))
+			for v := int64(1); v <= legacyVersion; v++ {
+				_, err = db.Exec(`INSERT INTO goose_db_version (id, version_id, is_applied) VALUES ($1, $2, TRUE)`, v, v)
+				require.NoError(t, err)
+			}
+
+			ctx := context.Background()
+			require.NoError(t, dsql.UpgradeLegacyGooseVersions(ctx, db))
+			// Upgrading twice is a no-op.
+			require.NoError(t, dsql.UpgradeLegacyGooseVersions(ctx, db))
+
+			version, err := goose.GetDBVersionContext(ctx, db)
+			require.NoError(t, err)
+			require.Equal(t, expectedVersion, version)
+
+			var legacyRows int
+			err = db.QueryRow(`SELECT COUNT(*) FROM goose_db_version WHERE version_id > 0 AND version_id < 100`).Scan(&legacyRows)
+			require.NoError(t, err)
+			require.Zero(t, legacyRows)
+		})
+	}
+}
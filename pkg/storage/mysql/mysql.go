@@ -22,6 +22,7 @@ import (
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
+	"github.com/openfga/openfga/internal/build"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
@@ -49,6 +50,25 @@ type Datastore struct {
 // Ensures that Datastore implements the OpenFGADatastore interface.
 var _ storage.OpenFGADatastore = (*Datastore)(nil)
 
+// Ensures that Datastore implements the ChangelogPruner interface.
+var _ storage.ChangelogPruner = (*Datastore)(nil)
+
+// Ensures that Datastore implements the TupleExpirer interface.
+var _ storage.TupleExpirer = (*Datastore)(nil)
+
+// Ensures that Datastore implements the StoreCloner interface.
+var _ storage.StoreCloner = (*Datastore)(nil)
+
+// Ensures that Datastore implements the BulkLoader interface.
+var _ storage.BulkLoader = (*Datastore)(nil)
+
+// copyStoreSchema see [sqlcommon.CopyStoreSchema].
+var copyStoreSchema = sqlcommon.CopyStoreSchema{
+	AuthorizationModelColumns: []string{"type", "type_definition", "schema_version", "serialized_protobuf"},
+	TupleColumns:              []string{"object_type", "object_id", "relation", "_user", "user_type", "inserted_at", "condition_name", "condition_context", "expires_at"},
+	TupleUlid:                 "CONCAT(?, LPAD(? + ROW_NUMBER() OVER (ORDER BY ulid), 10, '0'))",
+}
+
 // New creates a new [Datastore] storage.
 func New(uri string, cfg *sqlcommon.Config) (*Datastore, error) {
 	if cfg.Username != "" || cfg.Password != "" {
@@ -166,10 +186,11 @@ func (s *Datastore) read(ctx context.Context, store string, filter storage.ReadF
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
-		Where(sq.Eq{"store": store})
+		Where(sq.Eq{"store": store}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 	if options != nil {
 		sb = sb.OrderBy("ulid")
 	}
@@ -241,13 +262,14 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 
 	sb := s.stbl.
 		Select(
 			"object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context",
+			"condition_name", "condition_context", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{
@@ -257,7 +279,8 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 			"relation":    filter.Relation,
 			"_user":       filter.User,
 			"user_type":   userType,
-		})
+		}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 
 	if len(filter.Conditions) > 0 {
 		sb = sb.Where(sq.Eq{"COALESCE(condition_name, '')": filter.Conditions})
@@ -271,6 +294,7 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 			&record.User,
 			&conditionName,
 			&conditionContext,
+			&expiresAt,
 		)
 	if err != nil {
 		return nil, HandleSQLError(err)
@@ -288,6 +312,7 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 		}
 	}
 
+	storage.ObserveTupleExpiry(ctx, expiresAt.Time)
 	return record.AsTuple(), nil
 }
 
@@ -305,11 +330,12 @@ func (s *Datastore) ReadUsersetTuples(
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{"store": store}).
-		Where(sq.Eq{"user_type": tupleUtils.UserSet})
+		Where(sq.Eq{"user_type": tupleUtils.UserSet}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 
 	objectType, objectID := tupleUtils.SplitObject(filter.Object)
 	if objectType != "" {
@@ -367,7 +393,7 @@ func (s *Datastore) ReadStartingWithUser(
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{
@@ -375,11 +401,16 @@ func (s *Datastore) ReadStartingWithUser(
 			"object_type": filter.ObjectType,
 			"relation":    filter.Relation,
 			"_user":       targetUsersArg,
-		}).OrderBy("object_id")
+		}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now()))).
+		OrderBy("object_id")
 
 	if filter.ObjectIDs != nil && filter.ObjectIDs.Size() > 0 {
 		builder = builder.Where(sq.Eq{"object_id": filter.ObjectIDs.Values()})
 	}
+	if filter.AfterObjectID != "" {
+		builder = builder.Where(sq.Gt{"object_id": filter.AfterObjectID})
+	}
 	if len(filter.Conditions) > 0 {
 		builder = builder.Where(sq.Eq{"COALESCE(condition_name, '')": filter.Conditions})
 	}
@@ -708,6 +739,16 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 	ctx, span := startTrace(ctx, "ReadChanges")
 	defer span.End()
 
+	if storage.ChecksChangelogHorizon(options) {
+		horizon, err := sqlcommon.ReadChangelogHorizon(ctx, s.dbInfo, store)
+		if err != nil {
+			return nil, "", err
+		}
+		if storage.IsBeforeChangelogHorizon(options, horizon) {
+			return nil, "", storage.ErrChangelogTruncated
+		}
+	}
+
 	objectTypeFilter := filter.ObjectType
 	horizonOffset := filter.HorizonOffset
 
@@ -721,7 +762,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			"ulid", "object_type", "object_id", "relation",
 			"_user",
 			"operation",
-			"condition_name", "condition_context", "inserted_at",
+			"condition_name", "condition_context", "inserted_at", "expires_at",
 		).
 		From("changelog").
 		Where(sq.Eq{"store": store}).
@@ -750,6 +791,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 		var objectType, objectID, relation, user string
 		var operation int
 		var insertedAt time.Time
+		var expiresAt sql.NullTime
 		var conditionName sql.NullString
 		var conditionContext []byte
 
@@ -763,6 +805,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			&conditionName,
 			&conditionContext,
 			&insertedAt,
+			&expiresAt,
 		)
 		if err != nil {
 			return nil, "", HandleSQLError(err)
@@ -785,11 +828,13 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			&conditionContextStruct,
 		)
 
-		changes = append(changes, &openfgav1.TupleChange{
+		change := &openfgav1.TupleChange{
 			TupleKey:  tk,
 			Operation: openfgav1.TupleOperation(operation),
 			Timestamp: timestamppb.New(insertedAt.UTC()),
-		})
+		}
+		storage.ObserveChangeExpiry(ctx, change, expiresAt.Time)
+		changes = append(changes, change)
 	}
 
 	if len(changes) == 0 {
@@ -799,9 +844,52 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 	return changes, ulid, nil
 }
 
+// PruneChanges see [storage.ChangelogPruner].PruneChanges.
+func (s *Datastore) PruneChanges(ctx context.Context, store string, options storage.PruneChangesOptions) (int, error) {
+	ctx, span := startTrace(ctx, "PruneChanges")
+	defer span.End()
+
+	return sqlcommon.PruneChanges(ctx, s.dbInfo, store, options)
+}
+
+// CloneStore see [storage.StoreCloner].CloneStore.
+func (s *Datastore) CloneStore(ctx context.Context, source string, target *openfgav1.Store, options storage.CloneStoreOptions) (*openfgav1.Store, error) {
+	ctx, span := startTrace(ctx, "CloneStore")
+	defer span.End()
+
+	if _, err := s.GetStore(ctx, source); err != nil {
+		return nil, err
+	}
+	store, err := s.CreateStore(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sqlcommon.CopyStore(ctx, s.dbInfo, copyStoreSchema, source, store.GetId(), options); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// BulkLoad see [storage.BulkLoader].BulkLoad.
+func (s *Datastore) BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options storage.BulkLoadOptions) (int, error) {
+	ctx, span := startTrace(ctx, "BulkLoad")
+	defer span.End()
+
+	return sqlcommon.BulkLoad(ctx, s.dbInfo, s.db, sqlcommon.BulkLoadSchema{}, store, tuples, options)
+}
+
+// DeleteExpiredTuples see [storage.TupleExpirer].DeleteExpiredTuples.
+func (s *Datastore) DeleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	ctx, span := startTrace(ctx, "DeleteExpiredTuples")
+	defer span.End()
+
+	return sqlcommon.DeleteExpiredTuples(ctx, s.dbInfo, s.db, now, limit)
+}
+
 // IsReady see [sqlcommon.IsReady].
 func (s *Datastore) IsReady(ctx context.Context) (storage.ReadinessStatus, error) {
-	versionReady, err := sqlcommon.IsReady(ctx, s.versionReady, s.db)
+	versionReady, err := sqlcommon.IsReady(ctx, s.versionReady, s.db, build.MinimumSupportedMySQLSchemaRevision)
 	if err != nil {
 		return versionReady, err
 	}
@@ -4,16 +4,79 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/awslabs/aurora-dsql-connectors/go/pgx/dsql"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/openfga/openfga/internal/build"
+	fgadsql "github.com/openfga/openfga/internal/dsql"
+	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
 )
 
+// dsqlEmulatorScheme is the URI scheme of the offline DSQL emulation mode. The URI is
+// otherwise a regular postgres:// URI pointing at a plain PostgreSQL database; the datastore
+// then issues the DSQL query shapes and injects synthetic OCC conflicts at commit time.
+// It exists so that the DSQL code paths can be tested without an AWS account.
+const dsqlEmulatorScheme = "dsql-emulator://"
+
+// occConflictRateParam is the dsql-emulator:// query parameter holding the probability,
+// between 0 and 1, that a commit fails with a synthetic OCC conflict.
+const occConflictRateParam = "occ_conflict_rate"
+
+// occConflictCodes are the SQLSTATE codes that DSQL returns on commit when a transaction
+// conflicts with a concurrent one.
+var occConflictCodes = []string{"OC000", "OC001", "40001"}
+
+// occConflictInjector fails commits with synthetic OCC conflicts in emulation mode.
+type occConflictInjector struct {
+	rate float64
+}
+
+// beforeCommit returns an OCC conflict error with probability rate, or nil.
+func (i *occConflictInjector) beforeCommit() error {
+	if i == nil || i.rate <= 0 || rand.Float64() >= i.rate {
+		return nil
+	}
+	return &pgconn.PgError{
+		Severity: "ERROR",
+		Code:     occConflictCodes[rand.IntN(len(occConflictCodes))],
+		Message:  "change conflicts with another transaction, please retry (injected by dsql emulator)",
+	}
+}
+
+// parseDSQLEmulatorURI converts a dsql-emulator:// URI into the postgres:// URI of the
+// underlying database and the conflict injector configured by its query parameters.
+func parseDSQLEmulatorURI(uri string) (string, *occConflictInjector, error) {
+	parsed, err := url.Parse("postgres://" + strings.TrimPrefix(uri, dsqlEmulatorScheme))
+	if err != nil {
+		return "", nil, fmt.Errorf("parse DSQL emulator URI: %w", err)
+	}
+
+	injector := &occConflictInjector{}
+	q := parsed.Query()
+	if rate := q.Get(occConflictRateParam); rate != "" {
+		injector.rate, err = strconv.ParseFloat(rate, 64)
+		if err != nil || injector.rate < 0 || injector.rate > 1 {
+			return "", nil, fmt.Errorf("invalid %s %q: must be a number between 0 and 1", occConflictRateParam, rate)
+		}
+	}
+	q.Del(occConflictRateParam)
+	parsed.RawQuery = q.Encode()
+
+	return parsed.String(), injector, nil
+}
+
 // isOCCError checks if the error is a DSQL optimistic concurrency control conflict.
 // DSQL returns OC000 for mutation conflicts and OC001 for schema conflicts.
 func isOCCError(err error) bool {
@@ -27,22 +90,179 @@ func isOCCError(err error) bool {
 	return false
 }
 
-// withOCCRetry executes fn with automatic retry on DSQL OCC errors.
-func withOCCRetry(ctx context.Context, fn func() error) error {
+var (
+	occAttemptsHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace:                       build.ProjectName,
+		Name:                            "dsql_occ_attempts",
+		Help:                            "The number of attempts needed to commit a DSQL transaction that is retried on OCC conflicts, labeled by store and method.",
+		Buckets:                         []float64{1, 2, 3, 5, 8, 13, 21},
+		NativeHistogramBucketFactor:     1.1,
+		NativeHistogramMaxBucketNumber:  100,
+		NativeHistogramMinResetDuration: time.Hour,
+	}, []string{"store", "method"})
+
+	occConflictCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: build.ProjectName,
+		Name:      "dsql_occ_conflict_count",
+		Help:      "The total number of DSQL OCC conflicts encountered, labeled by store and method.",
+	}, []string{"store", "method"})
+
+	occRetriesExhaustedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: build.ProjectName,
+		Name:      "dsql_occ_retries_exhausted_count",
+		Help:      "The total number of DSQL transactions that were given up on after repeated OCC conflicts, labeled by store and method.",
+	}, []string{"store", "method"})
+)
+
+// newOCCRetryBackOff builds the backoff policy described by cfg.
+// An empty cfg falls back to [sqlcommon.DefaultOCCRetryConfig].
+func newOCCRetryBackOff(cfg sqlcommon.OCCRetryConfig) backoff.BackOff {
+	if cfg == (sqlcommon.OCCRetryConfig{}) {
+		cfg = sqlcommon.DefaultOCCRetryConfig()
+	}
+
 	policy := backoff.NewExponentialBackOff()
-	policy.InitialInterval = 10 * time.Millisecond
-	policy.MaxElapsedTime = 5 * time.Second
+	policy.InitialInterval = cfg.InitialInterval
+	policy.MaxInterval = cfg.MaxInterval
+	policy.MaxElapsedTime = cfg.MaxElapsedTime
+	policy.RandomizationFactor = cfg.Jitter
+	policy.Reset()
 
-	return backoff.Retry(func() error {
+	if cfg.MaxAttempts > 0 {
+		return backoff.WithMaxRetries(policy, uint64(cfg.MaxAttempts-1))
+	}
+	return policy
+}
+
+// withOCCRetry executes fn with automatic retry on DSQL OCC errors, following the configured
+// retry policy. If fn keeps failing with OCC errors until the policy gives up, the returned error
+// wraps [storage.ErrTransactionRetriesExhausted].
+func (s *Datastore) withOCCRetry(ctx context.Context, store, method string, fn func() error) error {
+	attempts := 0
+	err := backoff.Retry(func() error {
+		attempts++
 		err := fn()
 		if err == nil {
 			return nil
 		}
 		if isOCCError(err) {
+			occConflictCounter.WithLabelValues(store, method).Inc()
 			return err
 		}
 		return backoff.Permanent(err)
-	}, backoff.WithContext(policy, ctx))
+	}, backoff.WithContext(newOCCRetryBackOff(s.occRetry), ctx))
+
+	occAttemptsHistogram.WithLabelValues(store, method).Observe(float64(attempts))
+
+	if isOCCError(err) {
+		occRetriesExhaustedCounter.WithLabelValues(store, method).Inc()
+		return fmt.Errorf("%w after %d attempts: %w", storage.ErrTransactionRetriesExhausted, attempts, err)
+	}
+	return err
+}
+
+// retryOnOCC runs fn and, when the datastore is backed by DSQL, retries it on OCC conflicts
+// (see withOCCRetry). fn must be safe to run more than once.
+func (s *Datastore) retryOnOCC(ctx context.Context, store, method string, fn func() error) error {
+	if !s.isDSQL {
+		return fn()
+	}
+	return s.withOCCRetry(ctx, store, method, fn)
+}
+
+// dsqlRowsPerTupleChange is the number of rows a DSQL transaction modifies for each written or
+// deleted tuple: the tuple row, its idx_tuple_user, idx_tuple_ulid, idx_user_lookup and
+// idx_tuple_expires_at index entries, and the changelog row. DSQL counts secondary index entries
+// against its row limit.
+const dsqlRowsPerTupleChange = 6
+
+// dsqlMaxRows returns the number of rows that a single DSQL transaction may modify, the
+// configured limit or its default.
+func (s *Datastore) dsqlMaxRows() int {
+	if s.dsqlMaxRowsPerTransaction <= 0 {
+		return sqlcommon.DefaultDSQLMaxRowsPerTransaction
+	}
+	return s.dsqlMaxRowsPerTransaction
+}
+
+// dsqlMaxTuplesPerTransaction returns the number of tuple changes that fit in the row
+// budget of a single DSQL transaction.
+func (s *Datastore) dsqlMaxTuplesPerTransaction() int {
+	return max(1, s.dsqlMaxRows()/dsqlRowsPerTupleChange)
+}
+
+// dsqlMaxChangesPerTransaction returns the number of changelog rows that can be deleted in a single
+// DSQL transaction. The changelog has no secondary index, so each change is a single row.
+func (s *Datastore) dsqlMaxChangesPerTransaction() int {
+	return s.dsqlMaxRows()
+}
+
+// writeDSQLBatches commits a write that does not fit in a single DSQL transaction as consecutive
+// transactions of at most maxChanges tuple changes, deletes first. Each transaction is retried
+// on OCC conflicts on its own; if one fails, the previous ones remain committed.
+func (s *Datastore) writeDSQLBatches(
+	ctx context.Context,
+	store string,
+	deletes storage.Deletes,
+	writes storage.Writes,
+	opts storage.TupleWriteOptions,
+	maxChanges int,
+) error {
+	for len(deletes) > 0 || len(writes) > 0 {
+		batchDeletes := deletes[:min(len(deletes), maxChanges)]
+		deletes = deletes[len(batchDeletes):]
+		batchWrites := writes[:min(len(writes), maxChanges-len(batchDeletes))]
+		writes = writes[len(batchWrites):]
+
+		err := s.retryOnOCC(ctx, store, "Write", func() error {
+			return s.write(ctx, store, batchDeletes, batchWrites, opts, time.Now().UTC())
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isDSQLIndexesReady reports the datastore as not ready while indexes created with
+// CREATE INDEX ASYNC are still being built, since queries relying on them would
+// fall back to full scans.
+func isDSQLIndexesReady(ctx context.Context, db *pgxpool.Pool) (storage.ReadinessStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	sqlDB := stdlib.OpenDBFromPool(db)
+	defer func() {
+		_ = sqlDB.Close()
+	}()
+
+	pending, err := fgadsql.PendingIndexes(ctx, sqlDB)
+	if err != nil {
+		return storage.ReadinessStatus{}, err
+	}
+	if len(pending) > 0 {
+		return storage.ReadinessStatus{
+			Message: "datastore indexes are still being built: " + strings.Join(pending, ", "),
+			IsReady: false,
+		}, nil
+	}
+	return storage.ReadinessStatus{IsReady: true}, nil
+}
+
+// isDSQLURI reports whether uri addresses an Aurora DSQL cluster, or the DSQL emulator.
+func isDSQLURI(uri string) bool {
+	return strings.HasPrefix(uri, "dsql://") || strings.HasPrefix(uri, dsqlEmulatorScheme)
+}
+
+// NewDSQLWithDB creates a new [Datastore] storage on Aurora DSQL with the provided database
+// connection, such as a pool authenticating with IAM tokens of a custom identity.
+func NewDSQLWithDB(db *pgxpool.Pool, cfg *sqlcommon.Config) (*Datastore, error) {
+	ds, err := NewWithDB(db, nil, cfg)
+	if err != nil {
+		return nil, err
+	}
+	ds.isDSQL = true
+	return ds, nil
 }
 
 // initDSQLDB initializes a new Aurora DSQL database connection.
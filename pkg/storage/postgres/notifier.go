@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"go.uber.org/zap"
+
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// DefaultInvalidationChannel is the channel on which [InvalidationNotifier] notifies and listens
+// by default.
+const DefaultInvalidationChannel = "openfga_invalidations"
+
+// maxNotificationPayload is the size in bytes above which the object relations are dropped from a
+// notification, as PostgreSQL rejects payloads of 8000 bytes or more.
+const maxNotificationPayload = 7900
+
+// ErrNotificationsNotSupported is returned when creating an [InvalidationNotifier] over Aurora
+// DSQL, which doesn't support LISTEN/NOTIFY.
+var ErrNotificationsNotSupported = errors.New("LISTEN/NOTIFY is not supported by Aurora DSQL")
+
+// invalidationPayload is the JSON payload of a notification.
+type invalidationPayload struct {
+	Origin          string   `json:"origin"`
+	StoreID         string   `json:"store_id"`
+	ObjectRelations []string `json:"object_relations,omitempty"`
+}
+
+// InvalidationNotifier is a [storage.InvalidationNotifier] that broadcasts invalidations to every
+// OpenFGA instance sharing the database, using PostgreSQL LISTEN/NOTIFY. Invalidations are
+// delivered to the subscribers of the notifying instance before being sent to the database.
+type InvalidationNotifier struct {
+	*storage.InProcessInvalidationNotifier
+
+	db      *pgxpool.Pool
+	channel string
+	origin  string
+	logger  logger.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var _ storage.InvalidationNotifier = (*InvalidationNotifier)(nil)
+
+// NewInvalidationNotifier returns an [InvalidationNotifier] using channel on the primary database
+// of the datastore. It holds one connection of the pool, on which it listens until closed.
+func (s *Datastore) NewInvalidationNotifier(channel string) (*InvalidationNotifier, error) {
+	if s.isDSQL {
+		return nil, ErrNotificationsNotSupported
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &InvalidationNotifier{
+		InProcessInvalidationNotifier: storage.NewInProcessInvalidationNotifier(),
+		db:                            s.primaryDB,
+		channel:                       channel,
+		origin:                        ulid.Make().String(),
+		logger:                        s.logger,
+		cancel:                        cancel,
+		done:                          make(chan struct{}),
+	}
+	if n.logger == nil {
+		n.logger = logger.NewNoopLogger()
+	}
+
+	go n.listen(ctx)
+	return n, nil
+}
+
+// Notify see [storage.InvalidationNotifier].Notify.
+func (n *InvalidationNotifier) Notify(ctx context.Context, inv storage.Invalidation) error {
+	n.Broadcast(inv)
+
+	payload, err := json.Marshal(invalidationPayload{Origin: n.origin, StoreID: inv.StoreID, ObjectRelations: inv.ObjectRelations})
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxNotificationPayload {
+		// Too many object relations, invalidate the whole store instead.
+		payload, err = json.Marshal(invalidationPayload{Origin: n.origin, StoreID: inv.StoreID})
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := n.db.Exec(ctx, "SELECT pg_notify($1, $2)", n.channel, string(payload)); err != nil {
+		return fmt.Errorf("notify invalidation: %w", err)
+	}
+	return nil
+}
+
+// Close stops listening, releases the connection and removes every subscriber.
+func (n *InvalidationNotifier) Close() {
+	n.cancel()
+	<-n.done
+	n.InProcessInvalidationNotifier.Close()
+}
+
+// listen receives the notifications of the channel until ctx is cancelled, reconnecting with
+// an exponential backoff. Notifications sent while disconnected are lost, the cache controller
+// polling invalidates the caches in that case.
+func (n *InvalidationNotifier) listen(ctx context.Context) {
+	defer close(n.done)
+
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxElapsedTime = 0
+	policy.MaxInterval = 30 * time.Second
+
+	for {
+		err := n.listenOnce(ctx, policy)
+		if ctx.Err() != nil {
+			return
+		}
+
+		wait := policy.NextBackOff()
+		n.logger.Warn("postgres invalidation listener disconnected",
+			zap.String("channel", n.channel),
+			zap.Duration("retry_in", wait),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// listenOnce listens on a dedicated connection until it fails or ctx is cancelled.
+func (n *InvalidationNotifier) listenOnce(ctx context.Context, policy backoff.BackOff) error {
+	pooled, err := n.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// A connection in LISTEN mode can not be returned to the pool.
+	conn := pooled.Hijack()
+	defer func() {
+		_ = conn.Close(context.Background())
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{n.channel}.Sanitize()); err != nil {
+		return err
+	}
+	policy.Reset()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var payload invalidationPayload
+		if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			n.logger.Warn("ignoring malformed invalidation notification",
+				zap.String("channel", n.channel),
+				zap.Error(err))
+			continue
+		}
+		if payload.Origin == n.origin {
+			continue
+		}
+
+		n.Broadcast(storage.Invalidation{StoreID: payload.StoreID, ObjectRelations: payload.ObjectRelations})
+	}
+}
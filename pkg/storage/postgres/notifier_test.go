@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/sqlcommon"
+	storagefixtures "github.com/openfga/openfga/pkg/testfixtures/storage"
+)
+
+func TestInvalidationNotifier(t *testing.T) {
+	testDatastore := storagefixtures.RunDatastoreTestContainer(t, "postgres")
+
+	uri := testDatastore.GetConnectionURI(true)
+	ds, err := New(uri, sqlcommon.NewConfig())
+	require.NoError(t, err)
+	defer ds.Close()
+
+	sender, err := ds.NewInvalidationNotifier(DefaultInvalidationChannel)
+	require.NoError(t, err)
+	defer sender.Close()
+
+	receiver, err := ds.NewInvalidationNotifier(DefaultInvalidationChannel)
+	require.NoError(t, err)
+	defer receiver.Close()
+
+	sent := make(chan storage.Invalidation, 10)
+	sender.Subscribe(func(inv storage.Invalidation) { sent <- inv })
+	received := make(chan storage.Invalidation, 10)
+	receiver.Subscribe(func(inv storage.Invalidation) { received <- inv })
+
+	t.Run("invalidations_reach_every_notifier_once", func(t *testing.T) {
+		inv := storage.Invalidation{StoreID: "store", ObjectRelations: []string{"document#viewer", "folder#viewer"}}
+
+		// The receiver may not be listening yet, so notify until it receives.
+		require.Eventually(t, func() bool {
+			require.NoError(t, sender.Notify(context.Background(), inv))
+			select {
+			case got := <-received:
+				require.Equal(t, inv, got)
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 10*time.Second, 10*time.Millisecond)
+
+		// The sender delivers to its own subscribers directly, and ignores its own notifications.
+		require.Eventually(t, func() bool { return len(sent) > 0 }, time.Second, 10*time.Millisecond)
+		for len(sent) > 0 {
+			require.Equal(t, inv, <-sent)
+		}
+		time.Sleep(200 * time.Millisecond)
+		require.Empty(t, sent)
+
+		// Drop the notifications of the earlier attempts.
+		for len(received) > 0 {
+			require.Equal(t, inv, <-received)
+		}
+	})
+
+	t.Run("large_invalidations_invalidate_the_store", func(t *testing.T) {
+		objectRelations := make([]string, 0, 100)
+		for i := 0; i < 100; i++ {
+			objectRelations = append(objectRelations, strings.Repeat("t", 100)+string(rune('a'+i%26))+"#viewer")
+		}
+
+		require.NoError(t, sender.Notify(context.Background(), storage.Invalidation{StoreID: "store", ObjectRelations: objectRelations}))
+		select {
+		case got := <-received:
+			require.Equal(t, storage.Invalidation{StoreID: "store"}, got)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "invalidation not received")
+		}
+	})
+}
+
+func TestInvalidationNotifierNotSupportedByDSQL(t *testing.T) {
+	ds := &Datastore{isDSQL: true}
+	_, err := ds.NewInvalidationNotifier(DefaultInvalidationChannel)
+	require.ErrorIs(t, err, ErrNotificationsNotSupported)
+}
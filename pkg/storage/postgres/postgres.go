@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/oklog/ulid/v2"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -25,6 +27,8 @@ import (
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
+	"github.com/openfga/openfga/internal/build"
+	fgadsql "github.com/openfga/openfga/internal/dsql"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
@@ -47,12 +51,112 @@ type Datastore struct {
 	maxTuplesPerWriteField    int
 	maxTypesPerModelField     int
 	versionReady              bool
-	isDSQL                    bool // true when using Aurora DSQL (affects query behavior)
+	isDSQL                    bool                 // true when using Aurora DSQL (affects query behavior)
+	occConflicts              *occConflictInjector // non-nil only in DSQL emulation mode
+	occRetry                  sqlcommon.OCCRetryConfig
+	dsqlMaxRowsPerTransaction int
 }
 
 // Ensures that Datastore implements the OpenFGADatastore interface.
 var _ storage.OpenFGADatastore = (*Datastore)(nil)
 
+// Ensures that Datastore implements the ChangelogPruner interface.
+var _ storage.ChangelogPruner = (*Datastore)(nil)
+
+// Ensures that Datastore implements the TupleExpirer interface.
+var _ storage.TupleExpirer = (*Datastore)(nil)
+
+// Ensures that Datastore implements the StoreCloner interface.
+var _ storage.StoreCloner = (*Datastore)(nil)
+
+// Ensures that Datastore implements the BulkLoader interface.
+var _ storage.BulkLoader = (*Datastore)(nil)
+
+// copyStoreSchema see [sqlcommon.CopyStoreSchema].
+var copyStoreSchema = sqlcommon.CopyStoreSchema{
+	AuthorizationModelColumns: []string{"type", "type_definition", "schema_version", "serialized_protobuf"},
+	TupleColumns:              []string{"object_type", "object_id", "relation", "_user", "user_type", "inserted_at", "condition_name", "condition_context", "expires_at"},
+	TupleUlid:                 "? || LPAD(CAST(? + ROW_NUMBER() OVER (ORDER BY ulid) AS TEXT), 10, '0')",
+	TargetStore:               "CAST(? AS TEXT)",
+}
+
+// bulkLoadColumns are the columns of the tuples loaded by BulkLoad, in the order of the values
+// returned by bulkLoadRows.
+var bulkLoadColumns = []string{
+	"object_type",
+	"object_id",
+	"relation",
+	"_user",
+	"user_type",
+	"condition_name",
+	"condition_context",
+	"ulid",
+}
+
+// bulkLoadExpiredUlid is the column of bulk_load_tuple holding the ULID of the change deleting the
+// expired tuple that a loaded tuple replaces, if any, which precedes the ULID of the loaded tuple.
+// Its values follow those of the bulkLoadColumns in the rows returned by bulkLoadRows.
+const bulkLoadExpiredUlid = "expired_ulid"
+
+// bulkLoadTable is the temporary table BulkLoad copies the tuples into on PostgreSQL. It is
+// dropped at the end of the transaction.
+const bulkLoadTable = `CREATE TEMPORARY TABLE bulk_load_tuple (
+	object_type TEXT NOT NULL,
+	object_id TEXT NOT NULL,
+	relation TEXT NOT NULL,
+	_user TEXT NOT NULL,
+	user_type TEXT NOT NULL,
+	condition_name TEXT,
+	condition_context BYTEA,
+	ulid TEXT NOT NULL,
+	expired_ulid TEXT NOT NULL
+) ON COMMIT DROP`
+
+// bulkLoadDeleteExpired deletes the expired tuples of the store $1 that bulk_load_tuple replaces,
+// and records a change with the operation $2 for each, as a write does.
+const bulkLoadDeleteExpired = `WITH deleted AS (
+	DELETE FROM tuple USING bulk_load_tuple
+	WHERE tuple.store = $1
+		AND tuple.object_type = bulk_load_tuple.object_type
+		AND tuple.object_id = bulk_load_tuple.object_id
+		AND tuple.relation = bulk_load_tuple.relation
+		AND tuple._user = bulk_load_tuple._user
+		AND tuple.expires_at <= NOW()
+	RETURNING tuple.object_type, tuple.object_id, tuple.relation, tuple._user, bulk_load_tuple.expired_ulid
+)
+INSERT INTO changelog (store, object_type, object_id, relation, _user, condition_name, condition_context, operation, ulid, inserted_at)
+SELECT $1, object_type, object_id, relation, _user, '', NULL, $2, expired_ulid, NOW()
+FROM deleted`
+
+// bulkLoadDeleteExpiredWithoutChangelog deletes the expired tuples of the store $1 that
+// bulk_load_tuple replaces.
+const bulkLoadDeleteExpiredWithoutChangelog = `DELETE FROM tuple USING bulk_load_tuple
+WHERE tuple.store = $1
+	AND tuple.object_type = bulk_load_tuple.object_type
+	AND tuple.object_id = bulk_load_tuple.object_id
+	AND tuple.relation = bulk_load_tuple.relation
+	AND tuple._user = bulk_load_tuple._user
+	AND tuple.expires_at <= NOW()`
+
+// bulkLoadInsert inserts the tuples of bulk_load_tuple into the store $1, and records a change
+// with the operation $2 for each tuple actually inserted.
+const bulkLoadInsert = `WITH inserted AS (
+	INSERT INTO tuple (store, object_type, object_id, relation, _user, user_type, condition_name, condition_context, ulid, inserted_at)
+	SELECT $1, object_type, object_id, relation, _user, user_type, condition_name, condition_context, ulid, NOW()
+	FROM bulk_load_tuple
+	ON CONFLICT (store, object_type, object_id, relation, _user) DO NOTHING
+	RETURNING object_type, object_id, relation, _user, condition_name, condition_context, ulid
+)
+INSERT INTO changelog (store, object_type, object_id, relation, _user, condition_name, condition_context, operation, ulid, inserted_at)
+SELECT $1, object_type, object_id, relation, _user, condition_name, condition_context, $2, ulid, NOW()
+FROM inserted`
+
+// bulkLoadInsertWithoutChangelog inserts the tuples of bulk_load_tuple into the store $1.
+const bulkLoadInsertWithoutChangelog = `INSERT INTO tuple (store, object_type, object_id, relation, _user, user_type, condition_name, condition_context, ulid, inserted_at)
+SELECT $1, object_type, object_id, relation, _user, user_type, condition_name, condition_context, ulid, NOW()
+FROM bulk_load_tuple
+ON CONFLICT (store, object_type, object_id, relation, _user) DO NOTHING`
+
 func parseConfig(uri string, override bool, cfg *sqlcommon.Config) (*pgxpool.Config, error) {
 	c, err := pgxpool.ParseConfig(uri)
 	if err != nil {
@@ -110,6 +214,15 @@ func initDB(uri string, override bool, cfg *sqlcommon.Config) (*pgxpool.Pool, er
 		return initDSQLDB(uri, cfg)
 	}
 
+	// The DSQL emulator talks to a plain PostgreSQL database.
+	if strings.HasPrefix(uri, dsqlEmulatorScheme) {
+		pgURI, _, err := parseDSQLEmulatorURI(uri)
+		if err != nil {
+			return nil, err
+		}
+		uri = pgURI
+	}
+
 	c, err := parseConfig(uri, override, cfg)
 	if err != nil {
 		return nil, err
@@ -128,6 +241,19 @@ func New(uri string, cfg *sqlcommon.Config) (*Datastore, error) {
 	// Detect if this is a DSQL connection
 	isDSQL := strings.HasPrefix(uri, "dsql://")
 
+	if cfg.SecondaryURI != "" && isDSQLURI(cfg.SecondaryURI) != isDSQLURI(uri) {
+		return nil, errors.New("initialize postgres connection: the primary and secondary URIs must both be DSQL URIs or both be postgres URIs")
+	}
+
+	var occConflicts *occConflictInjector
+	if strings.HasPrefix(uri, dsqlEmulatorScheme) {
+		var err error
+		if _, occConflicts, err = parseDSQLEmulatorURI(uri); err != nil {
+			return nil, fmt.Errorf("initialize postgres connection: %w", err)
+		}
+		isDSQL = true
+	}
+
 	primaryDB, err := initDB(uri, cfg.Username != "" || cfg.Password != "", cfg)
 	if err != nil {
 		return nil, fmt.Errorf("initialize postgres connection: %w", err)
@@ -135,8 +261,14 @@ func New(uri string, cfg *sqlcommon.Config) (*Datastore, error) {
 
 	var secondaryDB *pgxpool.Pool
 	if cfg.SecondaryURI != "" {
-		secondaryDB, err = initDB(cfg.SecondaryURI, cfg.SecondaryUsername != "" || cfg.SecondaryPassword != "", cfg)
+		// The secondary is connected with its own credentials, e.g. the local-region endpoint
+		// of a multi-region DSQL cluster.
+		secondaryCfg := *cfg
+		secondaryCfg.Username = cfg.SecondaryUsername
+		secondaryCfg.Password = cfg.SecondaryPassword
+		secondaryDB, err = initDB(cfg.SecondaryURI, cfg.SecondaryUsername != "" || cfg.SecondaryPassword != "", &secondaryCfg)
 		if err != nil {
+			primaryDB.Close()
 			return nil, fmt.Errorf("initialize postgres connection: %w", err)
 		}
 	}
@@ -146,10 +278,11 @@ func New(uri string, cfg *sqlcommon.Config) (*Datastore, error) {
 		return nil, err
 	}
 	ds.isDSQL = isDSQL
+	ds.occConflicts = occConflicts
 	return ds, nil
 }
 
-func configureDB(db *pgxpool.Pool, cfg *sqlcommon.Config, dbName string) (prometheus.Collector, error) {
+func configureDB(db *pgxpool.Pool, cfg *sqlcommon.Config, labels map[string]string) (prometheus.Collector, error) {
 	policy := backoff.NewExponentialBackOff()
 	policy.MaxElapsedTime = 1 * time.Minute
 	attempt := 1
@@ -169,7 +302,7 @@ func configureDB(db *pgxpool.Pool, cfg *sqlcommon.Config, dbName string) (promet
 	var collector prometheus.Collector
 
 	if cfg.ExportMetrics {
-		collector = pgxpoolprometheus.NewCollector(db, map[string]string{"db_name": dbName})
+		collector = pgxpoolprometheus.NewCollector(db, labels)
 		if err := prometheus.Register(collector); err != nil {
 			return nil, fmt.Errorf("initialize metrics: %w", err)
 		}
@@ -180,14 +313,24 @@ func configureDB(db *pgxpool.Pool, cfg *sqlcommon.Config, dbName string) (promet
 
 // NewWithDB creates a new [Datastore] storage with the provided database connection.
 func NewWithDB(primaryDB, secondaryDB *pgxpool.Pool, cfg *sqlcommon.Config) (*Datastore, error) {
-	primaryCollector, err := configureDB(primaryDB, cfg, "openfga")
+	// The pools of a multi-region DSQL cluster are told apart by their endpoint too. The other
+	// deployments keep the labels that their dashboards match on.
+	dbLabels := func(db *pgxpool.Pool, dbName string) map[string]string {
+		labels := map[string]string{"db_name": dbName}
+		if secondaryDB != nil && isDSQLURI(cfg.SecondaryURI) {
+			labels["endpoint"] = db.Config().ConnConfig.Host
+		}
+		return labels
+	}
+
+	primaryCollector, err := configureDB(primaryDB, cfg, dbLabels(primaryDB, "openfga"))
 	if err != nil {
 		return nil, fmt.Errorf("configure primary db: %w", err)
 	}
 
 	var secondaryCollector prometheus.Collector
 	if secondaryDB != nil {
-		secondaryCollector, err = configureDB(secondaryDB, cfg, "openfga_secondary")
+		secondaryCollector, err = configureDB(secondaryDB, cfg, dbLabels(secondaryDB, "openfga_secondary"))
 		if err != nil {
 			return nil, fmt.Errorf("configure secondary db: %w", err)
 		}
@@ -202,6 +345,8 @@ func NewWithDB(primaryDB, secondaryDB *pgxpool.Pool, cfg *sqlcommon.Config) (*Da
 		maxTuplesPerWriteField:    cfg.MaxTuplesPerWriteField,
 		maxTypesPerModelField:     cfg.MaxTypesPerModelField,
 		versionReady:              false,
+		occRetry:                  cfg.OCCRetry,
+		dsqlMaxRowsPerTransaction: cfg.DSQLMaxRowsPerTransaction,
 	}, nil
 }
 
@@ -274,10 +419,11 @@ func (s *Datastore) read(ctx context.Context, store string, filter storage.ReadF
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
-		Where(sq.Eq{"store": store})
+		Where(sq.Eq{"store": store}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 	if options != nil {
 		sb = sb.OrderBy("ulid")
 	}
@@ -335,21 +481,32 @@ func (s *Datastore) Write(
 	defer span.End()
 	writeOpts := storage.NewTupleWriteOptions(opts...)
 	if s.isDSQL {
-		return withOCCRetry(ctx, func() error { return s.write(ctx, store, deletes, writes, writeOpts, time.Now().UTC()) })
+		if changes, maxChanges := len(deletes)+len(writes), s.dsqlMaxTuplesPerTransaction(); changes > maxChanges {
+			if !writeOpts.NonTransactional {
+				return fmt.Errorf("%w: %d tuple changes modify up to %d rows, the limit is %d",
+					storage.ErrTransactionTooLarge, changes, changes*dsqlRowsPerTupleChange, s.dsqlMaxRows())
+			}
+			return s.writeDSQLBatches(ctx, store, deletes, writes, writeOpts, maxChanges)
+		}
 	}
-	return s.write(ctx, store, deletes, writes, writeOpts, time.Now().UTC())
+	return s.retryOnOCC(ctx, store, "Write", func() error {
+		return s.write(ctx, store, deletes, writes, writeOpts, time.Now().UTC())
+	})
 }
 
-// selectAllExistingRowsForUpdate executes SELECT … FOR UPDATE for all lockKeys.
+// selectAllExistingRowsForUpdate executes SELECT … FOR UPDATE for all lockKeys, and returns the
+// existing rows and those that expired at now.
 // For PostgreSQL (isDSQL=false), uses FOR UPDATE. For DSQL (isDSQL=true), uses OCC.
 func selectAllExistingRowsForUpdate(ctx context.Context,
 	lockKeys []sqlcommon.TupleLockKey,
 	txn PgxQuery,
 	store string,
-	isDSQL bool) (map[string]*openfgav1.Tuple, error) {
+	now time.Time,
+	isDSQL bool) (map[string]*openfgav1.Tuple, map[string]*openfgav1.Tuple, error) {
 	total := len(lockKeys)
 	stbl := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	existing := make(map[string]*openfgav1.Tuple, total)
+	expired := make(map[string]*openfgav1.Tuple)
 
 	for start := 0; start < total; start += storage.DefaultMaxTuplesPerWrite {
 		end := start + storage.DefaultMaxTuplesPerWrite
@@ -358,11 +515,11 @@ func selectAllExistingRowsForUpdate(ctx context.Context,
 		}
 		keys := lockKeys[start:end]
 
-		if err := selectExistingRowsForWrite(ctx, stbl, txn, store, keys, existing, isDSQL); err != nil {
-			return nil, err
+		if err := selectExistingRowsForWrite(ctx, stbl, txn, store, keys, now, existing, expired, isDSQL); err != nil {
+			return nil, nil, err
 		}
 	}
-	return existing, nil
+	return existing, expired, nil
 }
 
 // For the prepared deleteConditions, execute delete tuples.
@@ -439,6 +596,7 @@ func executeWriteTuples(ctx context.Context, txn PgxExec, writeItems [][]interfa
 				"condition_context",
 				"ulid",
 				"inserted_at",
+				"expires_at",
 			)
 
 		for _, item := range writesBatch {
@@ -487,6 +645,7 @@ func executeInsertChanges(ctx context.Context, txn PgxExec, changeLogItems [][]i
 				"operation",
 				"ulid",
 				"inserted_at",
+				"expires_at",
 			)
 
 		for _, item := range changeLogBatch {
@@ -542,13 +701,13 @@ func (s *Datastore) write(
 
 	// 3. If list compiled in step 2 is not empty, execute SELECT … FOR UPDATE statement
 	// DSQL uses OCC, so skip FOR UPDATE (conflicts detected at commit time).
-	existing, err := selectAllExistingRowsForUpdate(ctx, lockKeys, txn, store, s.isDSQL)
+	existing, expired, err := selectAllExistingRowsForUpdate(ctx, lockKeys, txn, store, now, s.isDSQL)
 	if err != nil {
 		return err
 	}
 
 	// 4. Construct the deleteConditions, write and changelog items to be written
-	deleteConditions, writeItems, changeLogItems, err := sqlcommon.GetDeleteWriteChangelogItems(store, existing,
+	deleteConditions, writeItems, changeLogItems, err := sqlcommon.GetDeleteWriteChangelogItems(store, existing, expired,
 		sqlcommon.WriteData{
 			Deletes: deletes,
 			Writes:  writes,
@@ -576,6 +735,9 @@ func (s *Datastore) write(
 	}
 
 	// 6. Commit Transaction
+	if err := s.occConflicts.beforeCommit(); err != nil {
+		return HandleSQLError(err)
+	}
 	if err := txn.Commit(ctx); err != nil {
 		return HandleSQLError(err)
 	}
@@ -594,13 +756,14 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 
 	stbl := readStbl.
 		Select(
 			"object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context",
+			"condition_name", "condition_context", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{
@@ -610,7 +773,8 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 			"relation":    filter.Relation,
 			"_user":       filter.User,
 			"user_type":   userType,
-		})
+		}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 
 	if len(filter.Conditions) > 0 {
 		stbl = stbl.Where(sq.Eq{"COALESCE(condition_name, '')": filter.Conditions})
@@ -629,6 +793,7 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 		&record.User,
 		&conditionName,
 		&conditionContext,
+		&expiresAt,
 	)
 
 	if err != nil {
@@ -647,6 +812,7 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 		}
 	}
 
+	storage.ObserveTupleExpiry(ctx, expiresAt.Time)
 	return record.AsTuple(), nil
 }
 
@@ -665,11 +831,12 @@ func (s *Datastore) ReadUsersetTuples(
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{"store": store}).
-		Where(sq.Eq{"user_type": tupleUtils.UserSet})
+		Where(sq.Eq{"user_type": tupleUtils.UserSet}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 
 	objectType, objectID := tupleUtils.SplitObject(filter.Object)
 	if objectType != "" {
@@ -734,7 +901,7 @@ func (s *Datastore) ReadStartingWithUser(
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"_user",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{
@@ -742,11 +909,17 @@ func (s *Datastore) ReadStartingWithUser(
 			"object_type": filter.ObjectType,
 			"relation":    filter.Relation,
 			"_user":       targetUsersArg,
-		}).OrderBy("object_id collate \"C\"")
+		}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now()))).
+		OrderBy("object_id collate \"C\"")
 
 	if filter.ObjectIDs != nil && filter.ObjectIDs.Size() > 0 {
 		builder = builder.Where(sq.Eq{"object_id": filter.ObjectIDs.Values()})
 	}
+	if filter.AfterObjectID != "" {
+		// compared with the collation that the tuples are sorted with
+		builder = builder.Where(sq.Expr("object_id collate \"C\" > ?", filter.AfterObjectID))
+	}
 	if len(filter.Conditions) > 0 {
 		builder = builder.Where(sq.Eq{"COALESCE(condition_name, '')": filter.Conditions})
 	}
@@ -759,6 +932,9 @@ func (s *Datastore) ReadStartingWithUser(
 
 // MaxTuplesPerWrite see [storage.RelationshipTupleWriter].MaxTuplesPerWrite.
 func (s *Datastore) MaxTuplesPerWrite() int {
+	if s.isDSQL {
+		return min(s.maxTuplesPerWriteField, s.dsqlMaxTuplesPerTransaction())
+	}
 	return s.maxTuplesPerWriteField
 }
 
@@ -895,6 +1071,36 @@ func (s *Datastore) MaxTypesPerAuthorizationModel() int {
 	return s.maxTypesPerModelField
 }
 
+// execInTxn runs fn in a transaction of its own and commits it. The conflicts of the DSQL emulator
+// are injected after fn ran, at commit time as DSQL reports them, so that the retries see
+// statements that executed without committing.
+func (s *Datastore) execInTxn(ctx context.Context, fn func(txn pgx.Tx) error) error {
+	// DSQL uses strong snapshot isolation; skip explicit isolation level.
+	var txn pgx.Tx
+	var err error
+	if s.isDSQL {
+		txn, err = s.primaryDB.Begin(ctx)
+	} else {
+		txn, err = s.primaryDB.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	}
+	if err != nil {
+		return HandleSQLError(err)
+	}
+	defer func() { _ = txn.Rollback(ctx) }()
+
+	if err := fn(txn); err != nil {
+		return err
+	}
+
+	if err := s.occConflicts.beforeCommit(); err != nil {
+		return HandleSQLError(err)
+	}
+	if err := txn.Commit(ctx); err != nil {
+		return HandleSQLError(err)
+	}
+	return nil
+}
+
 // WriteAuthorizationModel see [storage.TypeDefinitionWriteBackend].WriteAuthorizationModel.
 func (s *Datastore) WriteAuthorizationModel(ctx context.Context, store string, model *openfgav1.AuthorizationModel) error {
 	ctx, span := startTrace(ctx, "WriteAuthorizationModel")
@@ -912,20 +1118,28 @@ func (s *Datastore) WriteAuthorizationModel(ctx context.Context, store string, m
 		return err
 	}
 
-	stmt, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("authorization_model").
 		Columns("store", "authorization_model_id", "schema_version", "type", "type_definition", "serialized_protobuf").
-		Values(store, model.GetId(), schemaVersion, "", nil, pbdata).
-		ToSql()
-	if err != nil {
-		return HandleSQLError(err)
+		Values(store, model.GetId(), schemaVersion, "", nil, pbdata)
+	if s.isDSQL {
+		// Models are immutable, so a retry of a write that did go through is a no-op.
+		sb = sb.Suffix("ON CONFLICT (store, authorization_model_id, type) DO NOTHING")
 	}
-	_, err = s.primaryDB.Exec(ctx, stmt, args...)
+	stmt, args, err := sb.ToSql()
 	if err != nil {
 		return HandleSQLError(err)
 	}
 
-	return nil
+	return s.retryOnOCC(ctx, store, "WriteAuthorizationModel", func() error {
+		return s.execInTxn(ctx, func(txn pgx.Tx) error {
+			_, err := txn.Exec(ctx, stmt, args...)
+			if err != nil {
+				return HandleSQLError(err)
+			}
+			return nil
+		})
+	})
 }
 
 // CreateStore adds a new store to storage.
@@ -936,21 +1150,33 @@ func (s *Datastore) CreateStore(ctx context.Context, store *openfgav1.Store) (*o
 	var id, name string
 	var createdAt, updatedAt time.Time
 
-	stmt, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("store").
 		Columns("id", "name", "created_at", "updated_at").
-		Values(store.GetId(), store.GetName(), sq.Expr("NOW()"), sq.Expr("NOW()")).
-		Suffix("returning id, name, created_at, updated_at").ToSql()
-
+		Values(store.GetId(), store.GetName(), sq.Expr("NOW()"), sq.Expr("NOW()"))
+	if s.isDSQL {
+		// An existing store inserts no row, which is reported as a collision below.
+		sb = sb.Suffix("ON CONFLICT (id) DO NOTHING")
+	}
+	stmt, args, err := sb.Suffix("returning id, name, created_at, updated_at").ToSql()
 	if err != nil {
 		return nil, HandleSQLError(err)
 	}
 
-	row := s.primaryDB.QueryRow(ctx, stmt, args...)
-	err = row.Scan(&id, &name, &createdAt, &updatedAt)
-
+	err = s.retryOnOCC(ctx, store.GetId(), "CreateStore", func() error {
+		return s.execInTxn(ctx, func(txn pgx.Tx) error {
+			err := txn.QueryRow(ctx, stmt, args...).Scan(&id, &name, &createdAt, &updatedAt)
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrCollision
+			}
+			if err != nil {
+				return HandleSQLError(err)
+			}
+			return nil
+		})
+	})
 	if err != nil {
-		return nil, HandleSQLError(err)
+		return nil, err
 	}
 
 	return &openfgav1.Store{
@@ -1076,21 +1302,29 @@ func (s *Datastore) DeleteStore(ctx context.Context, id string) error {
 	ctx, span := startTrace(ctx, "DeleteStore")
 	defer span.End()
 
-	db := s.primaryDB
-	stmt, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Update("store").
 		Set("deleted_at", sq.Expr("NOW()")).
-		Where(sq.Eq{"id": id}).ToSql()
-	if err != nil {
-		return HandleSQLError(err)
+		Where(sq.Eq{"id": id})
+	if s.isDSQL {
+		// Keep the original deletion time when a delete is retried, and avoid
+		// conflicting with concurrent deletes of an already deleted store.
+		sb = sb.Where(sq.Eq{"deleted_at": nil})
 	}
-
-	_, err = db.Exec(ctx, stmt, args...)
+	stmt, args, err := sb.ToSql()
 	if err != nil {
 		return HandleSQLError(err)
 	}
 
-	return nil
+	return s.retryOnOCC(ctx, id, "DeleteStore", func() error {
+		return s.execInTxn(ctx, func(txn pgx.Tx) error {
+			_, err := txn.Exec(ctx, stmt, args...)
+			if err != nil {
+				return HandleSQLError(err)
+			}
+			return nil
+		})
+	})
 }
 
 // WriteAssertions see [storage.AssertionsBackend].WriteAssertions.
@@ -1103,8 +1337,6 @@ func (s *Datastore) WriteAssertions(ctx context.Context, store, modelID string,
 		return err
 	}
 
-	db := s.primaryDB
-
 	stmt, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert("assertion").
 		Columns("store", "authorization_model_id", "assertions").
@@ -1114,12 +1346,16 @@ func (s *Datastore) WriteAssertions(ctx context.Context, store, modelID string,
 	if err != nil {
 		return HandleSQLError(err)
 	}
-	_, err = db.Exec(ctx, stmt, args...)
-	if err != nil {
-		return HandleSQLError(err)
-	}
 
-	return nil
+	return s.retryOnOCC(ctx, store, "WriteAssertions", func() error {
+		return s.execInTxn(ctx, func(txn pgx.Tx) error {
+			_, err := txn.Exec(ctx, stmt, args...)
+			if err != nil {
+				return HandleSQLError(err)
+			}
+			return nil
+		})
+	})
 }
 
 // ReadAssertions see [storage.AssertionsBackend].ReadAssertions.
@@ -1173,12 +1409,24 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 	}
 	db := s.getPgxPool(openfgav1.ConsistencyPreference_MINIMIZE_LATENCY)
 
+	if storage.ChecksChangelogHorizon(options) {
+		// Read the horizon from the same database as the changes, so that a lagging secondary is
+		// consistent with itself.
+		horizon, err := readChangelogHorizon(ctx, db, store)
+		if err != nil {
+			return nil, "", err
+		}
+		if storage.IsBeforeChangelogHorizon(options, horizon) {
+			return nil, "", storage.ErrChangelogTruncated
+		}
+	}
+
 	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select(
 			"ulid", "object_type", "object_id", "relation",
 			"_user",
 			"operation",
-			"condition_name", "condition_context", "inserted_at",
+			"condition_name", "condition_context", "inserted_at", "expires_at",
 		).
 		From("changelog").
 		Where(sq.Eq{"store": store}).
@@ -1212,6 +1460,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 		var objectType, objectID, relation, user string
 		var operation int
 		var insertedAt time.Time
+		var expiresAt sql.NullTime
 		var conditionName sql.NullString
 		var conditionContext []byte
 
@@ -1225,6 +1474,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			&conditionName,
 			&conditionContext,
 			&insertedAt,
+			&expiresAt,
 		)
 		if err != nil {
 			return nil, "", HandleSQLError(err)
@@ -1247,11 +1497,13 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			&conditionContextStruct,
 		)
 
-		changes = append(changes, &openfgav1.TupleChange{
+		change := &openfgav1.TupleChange{
 			TupleKey:  tk,
 			Operation: openfgav1.TupleOperation(operation),
 			Timestamp: timestamppb.New(insertedAt.UTC()),
-		})
+		}
+		storage.ObserveChangeExpiry(ctx, change, expiresAt.Time)
+		changes = append(changes, change)
 	}
 
 	if len(changes) == 0 {
@@ -1261,7 +1513,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 	return changes, ulid, nil
 }
 
-func isDBReady(ctx context.Context, versionReady bool, db *pgxpool.Pool) (storage.ReadinessStatus, error) {
+func isDBReady(ctx context.Context, versionReady bool, db *pgxpool.Pool, isDSQL bool) (storage.ReadinessStatus, error) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
@@ -1274,16 +1526,641 @@ func isDBReady(ctx context.Context, versionReady bool, db *pgxpool.Pool) (storag
 	defer func() {
 		_ = sqlDB.Close()
 	}()
-	return sqlcommon.IsVersionReady(ctx, versionReady, sqlDB)
+	if isDSQL {
+		return sqlcommon.IsSchemaRevisionReady(ctx, versionReady, sqlDB, build.MinimumSupportedDSQLSchemaRevision, fgadsql.SchemaRevision)
+	}
+	return sqlcommon.IsVersionReady(ctx, versionReady, sqlDB, build.MinimumSupportedPostgresSchemaRevision)
+}
+
+// readChangelogHorizon returns the retention horizon of the changelog of store, or an empty string
+// if it was never pruned.
+func readChangelogHorizon(ctx context.Context, db *pgxpool.Pool, store string) (string, error) {
+	stmt, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("changelog_horizon").
+		From("store").
+		Where(sq.Eq{"id": store}).
+		ToSql()
+	if err != nil {
+		return "", HandleSQLError(err)
+	}
+
+	var horizon sql.NullString
+	err = db.QueryRow(ctx, stmt, args...).Scan(&horizon)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", HandleSQLError(err)
+	}
+	return horizon.String, nil
+}
+
+// PruneChanges see [storage.ChangelogPruner].PruneChanges.
+func (s *Datastore) PruneChanges(ctx context.Context, store string, options storage.PruneChangesOptions) (int, error) {
+	ctx, span := startTrace(ctx, "PruneChanges")
+	defer span.End()
+
+	horizon, err := s.changelogPruneHorizon(ctx, store, options)
+	if err != nil || horizon == "" {
+		return 0, err
+	}
+
+	// Record the horizon first, so that readers never miss pruned changes without an error.
+	stmt, args, err := sqlcommon.ChangelogHorizonUpdate(sq.StatementBuilder.PlaceholderFormat(sq.Dollar), store, horizon).ToSql()
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	err = s.retryOnOCC(ctx, store, "PruneChanges", func() error {
+		return s.execInTxn(ctx, func(txn pgx.Tx) error {
+			if _, err := txn.Exec(ctx, stmt, args...); err != nil {
+				return HandleSQLError(err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = storage.DefaultPruneChangesBatchSize
+	}
+	if s.isDSQL {
+		batchSize = min(batchSize, s.dsqlMaxChangesPerTransaction())
+	}
+
+	deleted := 0
+	for {
+		bound, err := s.selectChangelogUlid(ctx, sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select("ulid").
+			From("changelog").
+			Where(sq.Eq{"store": store}).
+			Where(sq.LtOrEq{"ulid": horizon}).
+			OrderBy("ulid asc").
+			Limit(1).
+			Offset(uint64(batchSize-1)))
+		if err != nil {
+			return deleted, err
+		}
+		if bound == "" {
+			bound = horizon
+		}
+
+		stmt, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Delete("changelog").
+			Where(sq.Eq{"store": store}).
+			Where(sq.LtOrEq{"ulid": bound}).
+			ToSql()
+		if err != nil {
+			return deleted, HandleSQLError(err)
+		}
+		err = s.retryOnOCC(ctx, store, "PruneChanges", func() error {
+			return s.execInTxn(ctx, func(txn pgx.Tx) error {
+				res, err := txn.Exec(ctx, stmt, args...)
+				if err != nil {
+					return HandleSQLError(err)
+				}
+				deleted += int(res.RowsAffected())
+				return nil
+			})
+		})
+		if err != nil {
+			return deleted, err
+		}
+
+		if bound == horizon {
+			return deleted, nil
+		}
+	}
+}
+
+// changelogPruneHorizon returns the ULID of the latest change of store beyond the retention policy
+// of options, or an empty string if there is none.
+func (s *Datastore) changelogPruneHorizon(ctx context.Context, store string, options storage.PruneChangesOptions) (string, error) {
+	var horizon string
+	if cutoff := storage.ChangelogPruneCutoff(time.Now(), options.MaxAge); cutoff != "" {
+		var err error
+		horizon, err = s.selectChangelogUlid(ctx, sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select("ulid").
+			From("changelog").
+			Where(sq.Eq{"store": store}).
+			Where(sq.Lt{"ulid": cutoff}).
+			OrderBy("ulid desc").
+			Limit(1))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if options.MaxChanges > 0 {
+		beyondMaxChanges, err := s.selectChangelogUlid(ctx, sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select("ulid").
+			From("changelog").
+			Where(sq.Eq{"store": store}).
+			OrderBy("ulid desc").
+			Limit(1).
+			Offset(uint64(options.MaxChanges)))
+		if err != nil {
+			return "", err
+		}
+		horizon = max(horizon, beyondMaxChanges)
+	}
+	return horizon, nil
+}
+
+// selectChangelogUlid returns the ULID selected by sb on the primary database, or an empty string
+// if there is none.
+func (s *Datastore) selectChangelogUlid(ctx context.Context, sb sq.SelectBuilder) (string, error) {
+	stmt, args, err := sb.ToSql()
+	if err != nil {
+		return "", HandleSQLError(err)
+	}
+
+	var ulid string
+	err = s.primaryDB.QueryRow(ctx, stmt, args...).Scan(&ulid)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", HandleSQLError(err)
+	}
+	return ulid, nil
+}
+
+// CloneStore see [storage.StoreCloner].CloneStore. Each batch is copied by an INSERT ... SELECT
+// statement, and on DSQL holds at most as many tuples as a write transaction. The retention horizon
+// of the changelog of the copy is then set to the end of the copy.
+func (s *Datastore) CloneStore(ctx context.Context, source string, target *openfgav1.Store, options storage.CloneStoreOptions) (*openfgav1.Store, error) {
+	ctx, span := startTrace(ctx, "CloneStore")
+	defer span.End()
+
+	if _, err := s.GetStore(ctx, source); err != nil {
+		return nil, err
+	}
+	store, err := s.CreateStore(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = storage.DefaultCloneStoreBatchSize
+	}
+	if s.isDSQL {
+		batchSize = min(batchSize, s.dsqlMaxTuplesPerTransaction())
+	}
+
+	if err := sqlcommon.CopyStoreWith(ctx, s.copyStoreExecutor(), copyStoreSchema, source, store.GetId(), storage.CloneStoreOptions{
+		BatchSize:  batchSize,
+		OnProgress: options.OnProgress,
+	}); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// copyStoreExecutor returns the [sqlcommon.CopyStoreExecutor] of CloneStore, which copies on the
+// primary database and retries the batches conflicting on DSQL.
+func (s *Datastore) copyStoreExecutor() sqlcommon.CopyStoreExecutor {
+	return sqlcommon.CopyStoreExecutor{
+		Builder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		SelectKey: func(ctx context.Context, sb sq.SelectBuilder) (string, error) {
+			stmt, args, err := sb.ToSql()
+			if err != nil {
+				return "", HandleSQLError(err)
+			}
+
+			var key string
+			err = s.primaryDB.QueryRow(ctx, stmt, args...).Scan(&key)
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return "", HandleSQLError(err)
+			}
+			return key, nil
+		},
+		InsertRows: func(ctx context.Context, target string, ib sq.InsertBuilder) (int, error) {
+			stmt, args, err := ib.ToSql()
+			if err != nil {
+				return 0, HandleSQLError(err)
+			}
+
+			var inserted int
+			err = s.retryOnOCC(ctx, target, "CloneStore", func() error {
+				return s.execInTxn(ctx, func(txn pgx.Tx) error {
+					res, err := txn.Exec(ctx, stmt, args...)
+					if err != nil {
+						return HandleSQLError(err)
+					}
+					inserted = int(res.RowsAffected())
+					return nil
+				})
+			})
+			return inserted, err
+		},
+		UpdateStore: func(ctx context.Context, target string, ub sq.UpdateBuilder) error {
+			stmt, args, err := ub.ToSql()
+			if err != nil {
+				return HandleSQLError(err)
+			}
+
+			return s.retryOnOCC(ctx, target, "CloneStore", func() error {
+				return s.execInTxn(ctx, func(txn pgx.Tx) error {
+					if _, err := txn.Exec(ctx, stmt, args...); err != nil {
+						return HandleSQLError(err)
+					}
+					return nil
+				})
+			})
+		},
+	}
+}
+
+// BulkLoad see [storage.BulkLoader].BulkLoad. On PostgreSQL, the tuples are copied with COPY FROM
+// into a temporary table, then inserted from it in a single transaction. DSQL supports neither, so
+// the tuples are inserted with multi-row inserts instead, in as many transactions as its row limit
+// requires. Like a write, the load deletes the expired tuples that it loads before inserting them
+// again.
+func (s *Datastore) BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options storage.BulkLoadOptions) (int, error) {
+	ctx, span := startTrace(ctx, "BulkLoad")
+	defer span.End()
+
+	rows, err := bulkLoadRows(tuples)
+	if err != nil {
+		return 0, err
+	}
+
+	if s.isDSQL {
+		written := 0
+		// A loaded tuple may delete an expired tuple too.
+		batchSize := max(1, s.dsqlMaxTuplesPerTransaction()/2)
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:min(start+batchSize, len(rows))]
+			err := s.retryOnOCC(ctx, store, "BulkLoad", func() error {
+				n, err := s.bulkLoadValues(ctx, store, batch, options)
+				if err != nil {
+					return err
+				}
+				written += n
+				return nil
+			})
+			if err != nil {
+				return written, err
+			}
+		}
+		return written, nil
+	}
+
+	txn, err := s.primaryDB.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	defer func() { _ = txn.Rollback(ctx) }()
+
+	if _, err := txn.Exec(ctx, bulkLoadTable); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	if _, err := txn.CopyFrom(ctx, pgx.Identifier{"bulk_load_tuple"}, append(slices.Clone(bulkLoadColumns), bulkLoadExpiredUlid), pgx.CopyFromRows(rows)); err != nil {
+		return 0, HandleSQLError(err)
+	}
+
+	deleteStmt, deleteArgs := bulkLoadDeleteExpired, []interface{}{store, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE}
+	stmt, args := bulkLoadInsert, []interface{}{store, openfgav1.TupleOperation_TUPLE_OPERATION_WRITE}
+	if options.SkipChangelog {
+		deleteStmt, deleteArgs = bulkLoadDeleteExpiredWithoutChangelog, []interface{}{store}
+		stmt, args = bulkLoadInsertWithoutChangelog, []interface{}{store}
+	}
+	deleted, err := txn.Exec(ctx, deleteStmt, deleteArgs...)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	res, err := txn.Exec(ctx, stmt, args...)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	if options.SkipChangelog && (deleted.RowsAffected() > 0 || res.RowsAffected() > 0) {
+		if err := advanceBulkLoadHorizon(ctx, txn, store); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := txn.Commit(ctx); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	return int(res.RowsAffected()), nil
+}
+
+// bulkLoadRows returns the values of the bulkLoadColumns of tuples, followed by the value of
+// bulkLoadExpiredUlid, without the repeated tuples.
+func bulkLoadRows(tuples []*openfgav1.TupleKey) ([][]interface{}, error) {
+	now := time.Now()
+	entropy := ulid.DefaultEntropy()
+	seen := make(map[string]struct{}, len(tuples))
+	rows := make([][]interface{}, 0, len(tuples))
+	for _, tk := range tuples {
+		key := tupleUtils.TupleKeyToString(tk)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		objectType, objectID := tupleUtils.SplitObject(tk.GetObject())
+		conditionName, conditionContext, err := sqlcommon.MarshalRelationshipCondition(tk.GetCondition())
+		if err != nil {
+			return nil, err
+		}
+		expiredID := ulid.MustNew(ulid.Timestamp(now), entropy).String()
+		rows = append(rows, []interface{}{
+			objectType,
+			objectID,
+			tk.GetRelation(),
+			tk.GetUser(),
+			string(tupleUtils.GetUserTypeFromUser(tk.GetUser())),
+			conditionName,
+			conditionContext,
+			ulid.MustNew(ulid.Timestamp(now), entropy).String(),
+			expiredID,
+		})
+	}
+	return rows, nil
+}
+
+// bulkLoadValues inserts rows, returned by bulkLoadRows, into store with a multi-row insert, and
+// records a change for each tuple actually inserted, in a single transaction. It returns the
+// number of inserted tuples.
+func (s *Datastore) bulkLoadValues(ctx context.Context, store string, rows [][]interface{}, options storage.BulkLoadOptions) (int, error) {
+	txn, err := s.primaryDB.Begin(ctx)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	defer func() { _ = txn.Rollback(ctx) }()
+
+	changeLogItems := make([][]interface{}, 0, 2*len(rows))
+
+	rowsByKey := make(map[string][]interface{}, len(rows))
+	keys := make(sq.Or, 0, len(rows))
+	for _, row := range rows {
+		rowsByKey[bulkLoadRowKey(row[0], row[1], row[2], row[3])] = row
+		keys = append(keys, sq.Eq{
+			"object_type": row[0],
+			"object_id":   row[1],
+			"relation":    row[2],
+			"_user":       row[3],
+		})
+	}
+	deleteStmt, deleteArgs, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("tuple").
+		Where(sq.Eq{"store": store}).
+		Where(keys).
+		Where(sq.Expr("expires_at <= NOW()")).
+		Suffix("RETURNING object_type, object_id, relation, _user").
+		ToSql()
+	if err != nil {
+		// Should never happen because we craft the delete statement
+		return 0, HandleSQLError(err)
+	}
+	deleted, err := txn.Query(ctx, deleteStmt, deleteArgs...)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	for deleted.Next() {
+		var objectType, objectID, relation, user string
+		if err := deleted.Scan(&objectType, &objectID, &relation, &user); err != nil {
+			deleted.Close()
+			return 0, HandleSQLError(err)
+		}
+		row := rowsByKey[bulkLoadRowKey(objectType, objectID, relation, user)]
+		changeLogItems = append(changeLogItems, []interface{}{
+			store,
+			objectType,
+			objectID,
+			relation,
+			user,
+			"",
+			nil,
+			openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+			row[len(bulkLoadColumns)], // expired_ulid
+			sq.Expr("NOW()"),
+			nil,
+		})
+	}
+	deleted.Close()
+	if err := deleted.Err(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	deletes := len(changeLogItems)
+
+	insertBuilder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("tuple").
+		Columns(append(append([]string{"store"}, bulkLoadColumns...), "inserted_at")...).
+		Suffix("ON CONFLICT (store, object_type, object_id, relation, _user) DO NOTHING RETURNING ulid")
+	rowsByUlid := make(map[string][]interface{}, len(rows))
+	for _, row := range rows {
+		insertBuilder = insertBuilder.Values(append(append([]interface{}{store}, row[:len(bulkLoadColumns)]...), sq.Expr("NOW()"))...)
+		rowsByUlid[row[7].(string)] = row
+	}
+	stmt, args, err := insertBuilder.ToSql()
+	if err != nil {
+		// Should never happen because we craft the insert statement
+		return 0, HandleSQLError(err)
+	}
+
+	inserted, err := txn.Query(ctx, stmt, args...)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	for inserted.Next() {
+		var id string
+		if err := inserted.Scan(&id); err != nil {
+			inserted.Close()
+			return 0, HandleSQLError(err)
+		}
+		row := rowsByUlid[id]
+		changeLogItems = append(changeLogItems, []interface{}{
+			store,
+			row[0], // object_type
+			row[1], // object_id
+			row[2], // relation
+			row[3], // _user
+			row[5], // condition_name
+			row[6], // condition_context
+			openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
+			id,
+			sq.Expr("NOW()"),
+			nil, // The loaded tuples never expire.
+		})
+	}
+	inserted.Close()
+	if err := inserted.Err(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+
+	if !options.SkipChangelog {
+		if err := executeInsertChanges(ctx, txn, changeLogItems); err != nil {
+			return 0, err
+		}
+	} else if len(changeLogItems) > 0 {
+		if err := advanceBulkLoadHorizon(ctx, txn, store); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := s.occConflicts.beforeCommit(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	if err := txn.Commit(ctx); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	return len(changeLogItems) - deletes, nil
+}
+
+// advanceBulkLoadHorizon advances the changelog horizon of store past a load made in txn without
+// recording its changes, since the changes before it no longer tell the state of the store.
+func advanceBulkLoadHorizon(ctx context.Context, txn pgx.Tx, store string) error {
+	stmt, args, err := sqlcommon.ChangelogHorizonUpdate(sq.StatementBuilder.PlaceholderFormat(sq.Dollar), store, ulid.Make().String()).ToSql()
+	if err != nil {
+		// Should never happen because we craft the update statement
+		return HandleSQLError(err)
+	}
+	if _, err := txn.Exec(ctx, stmt, args...); err != nil {
+		return HandleSQLError(err)
+	}
+	return nil
+}
+
+// bulkLoadRowKey returns the key identifying the tuple of a row returned by bulkLoadRows.
+func bulkLoadRowKey(objectType, objectID, relation, user interface{}) string {
+	return fmt.Sprintf("%s:%s#%s@%s", objectType, objectID, relation, user)
+}
+
+// DeleteExpiredTuples see [storage.TupleExpirer].DeleteExpiredTuples.
+func (s *Datastore) DeleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	ctx, span := startTrace(ctx, "DeleteExpiredTuples")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = storage.DefaultDeleteExpiredTuplesBatchSize
+	}
+	if s.isDSQL {
+		// Each expired tuple deletes a tuple row and its index entries, and inserts a change.
+		limit = min(limit, s.dsqlMaxTuplesPerTransaction())
+	}
+
+	var deleted int
+	err := s.retryOnOCC(ctx, "", "DeleteExpiredTuples", func() error {
+		var err error
+		deleted, err = s.deleteExpiredTuples(ctx, now, limit)
+		return err
+	})
+	return deleted, err
+}
+
+func (s *Datastore) deleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	var txn pgx.Tx
+	var err error
+	if s.isDSQL {
+		txn, err = s.primaryDB.Begin(ctx)
+	} else {
+		txn, err = s.primaryDB.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	}
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	defer func() { _ = txn.Rollback(ctx) }()
+
+	stbl := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	sb := stbl.
+		Select("store", "object_type", "object_id", "relation", "_user").
+		From("tuple").
+		Where(sq.LtOrEq{"expires_at": now.UTC()}).
+		OrderBy("expires_at").
+		Limit(uint64(limit))
+	if !s.isDSQL {
+		sb = sb.Suffix("FOR UPDATE")
+	}
+	stmt, args, err := sb.ToSql()
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	rows, err := txn.Query(ctx, stmt, args...)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+
+	entropy := ulid.DefaultEntropy()
+	var (
+		deleteConditions = sq.Or{}
+		changeLogItems   [][]interface{}
+	)
+	for rows.Next() {
+		var store, objectType, objectID, relation, user string
+		if err := rows.Scan(&store, &objectType, &objectID, &relation, &user); err != nil {
+			rows.Close()
+			return 0, HandleSQLError(err)
+		}
+
+		deleteConditions = append(deleteConditions, sq.Eq{
+			"store":       store,
+			"object_type": objectType,
+			"object_id":   objectID,
+			"relation":    relation,
+			"_user":       user,
+			"user_type":   tupleUtils.GetUserTypeFromUser(user),
+		})
+		changeLogItems = append(changeLogItems, []interface{}{
+			store,
+			objectType,
+			objectID,
+			relation,
+			user,
+			"",
+			nil,
+			openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+			ulid.MustNew(ulid.Timestamp(time.Now()), entropy).String(),
+			sq.Expr("NOW()"),
+			nil,
+		})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	if len(deleteConditions) == 0 {
+		return 0, nil
+	}
+
+	for start := 0; start < len(deleteConditions); start += storage.DefaultMaxTuplesPerWrite {
+		end := min(start+storage.DefaultMaxTuplesPerWrite, len(deleteConditions))
+
+		stmt, args, err := stbl.Delete("tuple").Where(deleteConditions[start:end]).ToSql()
+		if err != nil {
+			return 0, HandleSQLError(err)
+		}
+		if _, err := txn.Exec(ctx, stmt, args...); err != nil {
+			return 0, HandleSQLError(err)
+		}
+	}
+
+	if err := executeInsertChanges(ctx, txn, changeLogItems); err != nil {
+		return 0, err
+	}
+
+	if err := s.occConflicts.beforeCommit(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	if err := txn.Commit(ctx); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	return len(deleteConditions), nil
 }
 
 // IsReady see [sqlcommon.IsReady].
 func (s *Datastore) IsReady(ctx context.Context) (storage.ReadinessStatus, error) {
-	primaryStatus, err := isDBReady(ctx, s.versionReady, s.primaryDB)
+	primaryStatus, err := isDBReady(ctx, s.versionReady, s.primaryDB, s.isDSQL)
 	if err != nil {
 		return primaryStatus, err
 	}
 
+	if s.isDSQL && primaryStatus.IsReady && !s.versionReady {
+		primaryStatus, err = isDSQLIndexesReady(ctx, s.primaryDB)
+		if err != nil {
+			return primaryStatus, err
+		}
+	}
+
 	// if secondary is not configured, return primary status only
 	if !s.isSecondaryConfigured() {
 		s.versionReady = primaryStatus.IsReady
@@ -1294,7 +2171,7 @@ func (s *Datastore) IsReady(ctx context.Context) (storage.ReadinessStatus, error
 		primaryStatus.Message = "ready"
 	}
 
-	secondaryStatus, err := isDBReady(ctx, s.versionReady, s.secondaryDB)
+	secondaryStatus, err := isDBReady(ctx, s.versionReady, s.secondaryDB, s.isDSQL)
 	if err != nil {
 		secondaryStatus.Message = err.Error()
 		secondaryStatus.IsReady = false
@@ -1333,10 +2210,11 @@ func HandleSQLError(err error, args ...interface{}) error {
 	return fmt.Errorf("sql error: %w", err)
 }
 
-// selectExistingRowsForWrite selects existing rows for the given keys.
+// selectExistingRowsForWrite selects existing rows for the given keys, and adds them to the
+// existing map, or to the expired map if they expired at now.
 // For PostgreSQL (isDSQL=false), locks rows with FOR UPDATE.
 // For DSQL (isDSQL=true), uses a CTE with JOIN for better query plan performance and OCC.
-func selectExistingRowsForWrite(ctx context.Context, stbl sq.StatementBuilderType, txn PgxQuery, store string, keys []sqlcommon.TupleLockKey, existing map[string]*openfgav1.Tuple, isDSQL bool) error {
+func selectExistingRowsForWrite(ctx context.Context, stbl sq.StatementBuilderType, txn PgxQuery, store string, keys []sqlcommon.TupleLockKey, now time.Time, existing, expired map[string]*openfgav1.Tuple, isDSQL bool) error {
 	var poolGetRows *PgxTxnIterQuery
 	var err error
 
@@ -1345,7 +2223,7 @@ func selectExistingRowsForWrite(ctx context.Context, stbl sq.StatementBuilderTyp
 		ctePrefix, joinCond, cteArgs := sqlcommon.BuildCTESelectJoin(keys)
 
 		// Prefix all columns with "t." to avoid ambiguity
-		cols := sqlcommon.SQLIteratorColumns()
+		cols := sqlcommon.ExistingRowsColumns()
 		selectCols := make([]string, len(cols))
 		for i, col := range cols {
 			selectCols[i] = "t." + col
@@ -1367,7 +2245,7 @@ func selectExistingRowsForWrite(ctx context.Context, stbl sq.StatementBuilderTyp
 		inExpr, args := sqlcommon.BuildRowConstructorIN(keys)
 
 		sb := stbl.
-			Select(sqlcommon.SQLIteratorColumns()...).
+			Select(sqlcommon.ExistingRowsColumns()...).
 			From("tuple").
 			Where(sq.Eq{"store": store}).
 			Where(sq.Expr("(object_type, object_id, relation, _user, user_type) IN "+inExpr, args...)).
@@ -1379,16 +2257,14 @@ func selectExistingRowsForWrite(ctx context.Context, stbl sq.StatementBuilderTyp
 		}
 	}
 
-	iter := sqlcommon.NewSQLTupleIterator(poolGetRows, HandleSQLError)
-	defer iter.Stop()
-
-	items, _, err := iter.ToArray(ctx, storage.PaginationOptions{PageSize: len(keys)})
-
+	rows, err := poolGetRows.GetRows(ctx)
 	if err != nil {
 		return err
 	}
-	for _, tuple := range items {
-		existing[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+	defer rows.Close()
+
+	if err := sqlcommon.ScanExistingRows(rows, now, existing, expired); err != nil {
+		return HandleSQLError(err)
 	}
 	return nil
 }
@@ -26,6 +26,7 @@ type TupleRecord struct {
 	ConditionContext *structpb.Struct
 	Ulid             string
 	InsertedAt       time.Time
+	ExpiresAt        time.Time // The zero time if the tuple never expires.
 }
 
 // AsTuple converts a [TupleRecord] into a [*openfgav1.Tuple].
@@ -0,0 +1,7 @@
+// Package remote contains an implementation of the storage interface that forwards every call to
+// an out-of-tree datastore over gRPC.
+//
+// The datastore must implement the DatastoreService defined in
+// proto/openfga/datastore/v1/datastore.proto. [Server] is a reference implementation of that
+// service serving any in-tree datastore.
+package remote
@@ -0,0 +1,114 @@
+package remote
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openfga/openfga/pkg/storage"
+	datastorev1 "github.com/openfga/openfga/pkg/storage/remote/proto/openfga/datastore/v1"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details that identify the storage
+// errors returned by a DatastoreService.
+const ErrorDomain = "datastore.openfga.dev"
+
+// knownErrors maps the storage errors OpenFGA handles specifically to their reason on the wire.
+// Errors wrapping other errors of the list come first, so that the most specific one is reported.
+var knownErrors = []struct {
+	err    error
+	reason datastorev1.ErrorReason
+	code   codes.Code
+}{
+	{storage.ErrWriteConflictOnInsert, datastorev1.ErrorReason_ERROR_REASON_WRITE_CONFLICT_ON_INSERT, codes.Aborted},
+	{storage.ErrWriteConflictOnDelete, datastorev1.ErrorReason_ERROR_REASON_WRITE_CONFLICT_ON_DELETE, codes.Aborted},
+	{storage.ErrTransactionalWriteFailed, datastorev1.ErrorReason_ERROR_REASON_TRANSACTIONAL_WRITE_FAILED, codes.Aborted},
+	{storage.ErrNotFound, datastorev1.ErrorReason_ERROR_REASON_NOT_FOUND, codes.NotFound},
+	{storage.ErrCollision, datastorev1.ErrorReason_ERROR_REASON_COLLISION, codes.AlreadyExists},
+	{storage.ErrInvalidContinuationToken, datastorev1.ErrorReason_ERROR_REASON_INVALID_CONTINUATION_TOKEN, codes.InvalidArgument},
+	{storage.ErrInvalidStartTime, datastorev1.ErrorReason_ERROR_REASON_INVALID_START_TIME, codes.InvalidArgument},
+	{storage.ErrChangelogTruncated, datastorev1.ErrorReason_ERROR_REASON_CHANGELOG_TRUNCATED, codes.OutOfRange},
+	{storage.ErrInvalidWriteInput, datastorev1.ErrorReason_ERROR_REASON_INVALID_WRITE_INPUT, codes.FailedPrecondition},
+	{storage.ErrTransactionRetriesExhausted, datastorev1.ErrorReason_ERROR_REASON_TRANSACTION_RETRIES_EXHAUSTED, codes.Aborted},
+	{storage.ErrTransactionTooLarge, datastorev1.ErrorReason_ERROR_REASON_TRANSACTION_TOO_LARGE, codes.InvalidArgument},
+	{storage.ErrTransactionThrottled, datastorev1.ErrorReason_ERROR_REASON_TRANSACTION_THROTTLED, codes.ResourceExhausted},
+	{storage.ErrTupleExpiryNotSupported, datastorev1.ErrorReason_ERROR_REASON_TUPLE_EXPIRY_NOT_SUPPORTED, codes.Unimplemented},
+}
+
+// remoteError is a storage error returned by a DatastoreService. It keeps the message of the
+// original error and unwraps to the matching storage error.
+type remoteError struct {
+	msg string
+	err error
+}
+
+func (e *remoteError) Error() string {
+	return e.msg
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.err
+}
+
+// toStatus converts an error returned by a datastore into a gRPC status error.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	for _, known := range knownErrors {
+		if !errors.Is(err, known.err) {
+			continue
+		}
+		st, detailsErr := status.New(known.code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: known.reason.String(),
+			Domain: ErrorDomain,
+		})
+		if detailsErr != nil {
+			return status.Error(known.code, err.Error())
+		}
+		return st.Err()
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// fromStatus converts a gRPC status error returned by a DatastoreService into the storage error
+// it describes, if any.
+func fromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.Canceled:
+		return &remoteError{msg: st.Message(), err: context.Canceled}
+	case codes.DeadlineExceeded:
+		return &remoteError{msg: st.Message(), err: context.DeadlineExceeded}
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != ErrorDomain {
+			continue
+		}
+		for _, known := range knownErrors {
+			if info.GetReason() == known.reason.String() {
+				return &remoteError{msg: st.Message(), err: known.err}
+			}
+		}
+	}
+	return err
+}
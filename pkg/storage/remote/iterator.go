@@ -0,0 +1,114 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// streamIterator is a [storage.TupleIterator] over the tuples of a streaming read. The stream
+// is cancelled when the iterator is stopped or exhausted. The expiry of every tuple returned is
+// reported with [storage.ObserveTupleExpiry].
+type streamIterator struct {
+	recv   func() (*openfgav1.Tuple, time.Time, error)
+	cancel context.CancelFunc
+
+	mu            sync.Mutex
+	head          *openfgav1.Tuple // GUARDED_BY(mu)
+	headExpiresAt time.Time        // GUARDED_BY(mu)
+	err           error            // GUARDED_BY(mu)
+}
+
+var _ storage.TupleIterator = (*streamIterator)(nil)
+
+// newStreamIterator returns a [streamIterator] over stream, whose context is cancelled by cancel.
+func newStreamIterator[T any, R interface {
+	*T
+	GetTuple() *openfgav1.Tuple
+	GetExpiresAt() *timestamppb.Timestamp
+}](stream grpc.ServerStreamingClient[T], cancel context.CancelFunc) *streamIterator {
+	return &streamIterator{
+		recv: func() (*openfgav1.Tuple, time.Time, error) {
+			resp, err := stream.Recv()
+			if err != nil {
+				return nil, time.Time{}, err
+			}
+			return R(resp).GetTuple(), fromExpiresAt(R(resp).GetExpiresAt()), nil
+		},
+		cancel: cancel,
+	}
+}
+
+// fetch receives the next tuple into head, unless it is already there. Must be called with mu held.
+func (s *streamIterator) fetch(ctx context.Context) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.head != nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	t, expiresAt, err := s.recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			s.err = storage.ErrIteratorDone
+		} else {
+			s.err = fromStatus(err)
+		}
+		s.cancel()
+		return s.err
+	}
+	s.head = t
+	s.headExpiresAt = expiresAt
+	return nil
+}
+
+// Next see [storage.Iterator].Next.
+func (s *streamIterator) Next(ctx context.Context) (*openfgav1.Tuple, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.fetch(ctx); err != nil {
+		return nil, err
+	}
+	head := s.head
+	s.head = nil
+	storage.ObserveTupleExpiry(ctx, s.headExpiresAt)
+	return head, nil
+}
+
+// Head see [storage.Iterator].Head.
+func (s *streamIterator) Head(ctx context.Context) (*openfgav1.Tuple, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.fetch(ctx); err != nil {
+		return nil, err
+	}
+	storage.ObserveTupleExpiry(ctx, s.headExpiresAt)
+	return s.head, nil
+}
+
+// Stop see [storage.Iterator].Stop.
+func (s *streamIterator) Stop() {
+	// Cancel first, to unblock a concurrent Recv holding the lock.
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.head = nil
+	s.err = storage.ErrIteratorDone
+}
@@ -0,0 +1,577 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+	datastorev1 "github.com/openfga/openfga/pkg/storage/remote/proto/openfga/datastore/v1"
+)
+
+const (
+	defaultMaxTuplesPerWrite             = 100
+	defaultMaxTypesPerAuthorizationModel = 100
+)
+
+// TLSConfig configures TLS on the connection to the remote datastore.
+type TLSConfig struct {
+	// CAPath is the file path of the PEM encoded CA certificates used to verify the datastore. When
+	// empty, the system certificate pool is used.
+	CAPath string
+
+	// CertPath and KeyPath are the file paths of the client certificate and key presented to the
+	// datastore. They are only needed when the datastore requires mutual TLS.
+	CertPath string
+	KeyPath  string
+}
+
+// Option defines a function type used for configuring a [Datastore] instance.
+type Option func(ds *Datastore)
+
+// WithLogger sets the logger of the [Datastore].
+func WithLogger(l logger.Logger) Option {
+	return func(ds *Datastore) { ds.logger = l }
+}
+
+// WithMaxTuplesPerWrite sets the maximum number of tuples allowed in a single write. The lower
+// limit of this value and the one reported by the remote datastore is enforced.
+func WithMaxTuplesPerWrite(n int) Option {
+	return func(ds *Datastore) { ds.maxTuplesPerWrite = n }
+}
+
+// WithMaxTypesPerAuthorizationModel sets the maximum number of types allowed per authorization
+// model. The lower limit of this value and the one reported by the remote datastore is enforced.
+func WithMaxTypesPerAuthorizationModel(n int) Option {
+	return func(ds *Datastore) { ds.maxTypesPerAuthorizationModel = n }
+}
+
+// WithTLS enables TLS on the connection to the remote datastore.
+func WithTLS(cfg TLSConfig) Option {
+	return func(ds *Datastore) { ds.tls = &cfg }
+}
+
+// WithDialOptions appends gRPC dial options used to connect to the remote datastore. They
+// take precedence over the transport credentials and stats handler set by default.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(ds *Datastore) { ds.dialOpts = append(ds.dialOpts, opts...) }
+}
+
+// Datastore is an implementation of [storage.OpenFGADatastore] that forwards every call to an
+// out-of-tree datastore implementing the DatastoreService gRPC service.
+type Datastore struct {
+	conn   *grpc.ClientConn
+	client datastorev1.DatastoreServiceClient
+
+	logger                        logger.Logger
+	tls                           *TLSConfig
+	dialOpts                      []grpc.DialOption
+	maxTuplesPerWrite             int
+	maxTypesPerAuthorizationModel int
+
+	// supportsTupleExpiry is whether the remote datastore stores the expiry of the tuples.
+	supportsTupleExpiry bool
+}
+
+// Ensures that [Datastore] implements the [storage.OpenFGADatastore] interface.
+var _ storage.OpenFGADatastore = (*Datastore)(nil)
+
+// Ensures that [Datastore] implements the [storage.ChangelogPruner] interface. Pruning fails with
+// an Unimplemented error if the DatastoreService does not support it.
+var _ storage.ChangelogPruner = (*Datastore)(nil)
+
+// Ensures that [Datastore] implements the [storage.TupleExpirer] interface. Writing expiring tuples
+// and deleting expired tuples fail with [storage.ErrTupleExpiryNotSupported] if the
+// DatastoreService does not report supporting it.
+var _ storage.TupleExpirer = (*Datastore)(nil)
+
+// Ensures that [Datastore] implements the [storage.StoreCloner] interface. Cloning fails with an
+// Unimplemented error if the DatastoreService does not support it.
+var _ storage.StoreCloner = (*Datastore)(nil)
+
+// Ensures that [Datastore] implements the [storage.BulkLoader] interface. Bulk loading fails with
+// an Unimplemented error if the DatastoreService does not support it.
+var _ storage.BulkLoader = (*Datastore)(nil)
+
+// New connects to the DatastoreService at target, which is a gRPC target such as
+// "dns:///datastore.example.com:8080", and returns a [Datastore] using it. It waits for up to a
+// minute for the remote datastore to report its limits.
+func New(target string, opts ...Option) (*Datastore, error) {
+	ds := &Datastore{
+		logger:                        logger.NewNoopLogger(),
+		maxTuplesPerWrite:             defaultMaxTuplesPerWrite,
+		maxTypesPerAuthorizationModel: defaultMaxTypesPerAuthorizationModel,
+	}
+	for _, opt := range opts {
+		opt(ds)
+	}
+
+	creds := insecure.NewCredentials()
+	if ds.tls != nil {
+		tlsConfig, err := ds.tls.build()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}, ds.dialOpts...)
+
+	conn, err := grpc.NewClient(target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to remote datastore: %w", err)
+	}
+	ds.conn = conn
+	ds.client = datastorev1.NewDatastoreServiceClient(conn)
+
+	if err := ds.loadLimits(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return ds, nil
+}
+
+// build returns the TLS configuration described by c.
+func (c *TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if c.CAPath != "" {
+		pem, err := os.ReadFile(c.CAPath)
+		if err != nil {
+			return nil, fmt.Errorf("read remote datastore CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in remote datastore CA '%s'", c.CAPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.CertPath != "" || c.KeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("load remote datastore client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// loadLimits lowers the limits of ds to the ones reported by the remote datastore, retrying until
+// it answers.
+func (ds *Datastore) loadLimits() error {
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxElapsedTime = 1 * time.Minute
+	attempt := 1
+
+	var limits *datastorev1.GetLimitsResponse
+	err := backoff.Retry(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var err error
+		limits, err = ds.client.GetLimits(ctx, &datastorev1.GetLimitsRequest{})
+		if err != nil {
+			ds.logger.Info("waiting for remote datastore", zap.Int("attempt", attempt), zap.Error(err))
+			attempt++
+			return err
+		}
+		return nil
+	}, policy)
+	if err != nil {
+		return fmt.Errorf("get remote datastore limits: %w", fromStatus(err))
+	}
+
+	if n := int(limits.GetMaxTuplesPerWrite()); n > 0 && n < ds.maxTuplesPerWrite {
+		ds.maxTuplesPerWrite = n
+	}
+	if n := int(limits.GetMaxTypesPerAuthorizationModel()); n > 0 && n < ds.maxTypesPerAuthorizationModel {
+		ds.maxTypesPerAuthorizationModel = n
+	}
+	ds.supportsTupleExpiry = limits.GetSupportsTupleExpiry()
+	return nil
+}
+
+// Close see [storage.OpenFGADatastore].Close.
+func (ds *Datastore) Close() {
+	_ = ds.conn.Close()
+}
+
+// IsReady see [storage.OpenFGADatastore].IsReady.
+func (ds *Datastore) IsReady(ctx context.Context) (storage.ReadinessStatus, error) {
+	resp, err := ds.client.IsReady(ctx, &datastorev1.IsReadyRequest{})
+	if err != nil {
+		return storage.ReadinessStatus{}, fromStatus(err)
+	}
+	return storage.ReadinessStatus{IsReady: resp.GetIsReady(), Message: resp.GetMessage()}, nil
+}
+
+func toTupleFilter(filter storage.ReadFilter) *datastorev1.TupleFilter {
+	return &datastorev1.TupleFilter{
+		Object:     filter.Object,
+		Relation:   filter.Relation,
+		User:       filter.User,
+		Conditions: filter.Conditions,
+	}
+}
+
+func toPagination(pagination storage.PaginationOptions) *datastorev1.Pagination {
+	return &datastorev1.Pagination{
+		PageSize: int32(pagination.PageSize),
+		From:     pagination.From,
+	}
+}
+
+// fromExpiresAt returns the expiry of a read tuple, the zero time if it never expires.
+func fromExpiresAt(expiresAt *timestamppb.Timestamp) time.Time {
+	if expiresAt == nil {
+		return time.Time{}
+	}
+	return expiresAt.AsTime()
+}
+
+// toExpiryTime returns the expiry_time of the reads made with ctx, see
+// [storage.ContextWithTupleExpiryTime], nil if they evaluate the expiry of the tuples at the
+// current time.
+func toExpiryTime(ctx context.Context) *timestamppb.Timestamp {
+	return toExpiresAt(storage.TupleExpiryTime(ctx, time.Time{}))
+}
+
+// Read see [storage.RelationshipTupleReader].Read.
+func (ds *Datastore) Read(ctx context.Context, store string, filter storage.ReadFilter, options storage.ReadOptions) (storage.TupleIterator, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := ds.client.Read(ctx, &datastorev1.ReadRequest{
+		Store:       store,
+		Filter:      toTupleFilter(filter),
+		Consistency: options.Consistency.Preference,
+		ExpiryTime:  toExpiryTime(ctx),
+	})
+	if err != nil {
+		cancel()
+		return nil, fromStatus(err)
+	}
+	return newStreamIterator(stream, cancel), nil
+}
+
+// ReadPage see [storage.RelationshipTupleReader].ReadPage.
+func (ds *Datastore) ReadPage(ctx context.Context, store string, filter storage.ReadFilter, options storage.ReadPageOptions) ([]*openfgav1.Tuple, string, error) {
+	resp, err := ds.client.ReadPage(ctx, &datastorev1.ReadPageRequest{
+		Store:       store,
+		Filter:      toTupleFilter(filter),
+		Consistency: options.Consistency.Preference,
+		Pagination:  toPagination(options.Pagination),
+		ExpiryTime:  toExpiryTime(ctx),
+	})
+	if err != nil {
+		return nil, "", fromStatus(err)
+	}
+	return resp.GetTuples(), resp.GetContinuationToken(), nil
+}
+
+// ReadUserTuple see [storage.RelationshipTupleReader].ReadUserTuple.
+func (ds *Datastore) ReadUserTuple(ctx context.Context, store string, filter storage.ReadUserTupleFilter, options storage.ReadUserTupleOptions) (*openfgav1.Tuple, error) {
+	resp, err := ds.client.ReadUserTuple(ctx, &datastorev1.ReadUserTupleRequest{
+		Store:       store,
+		Filter:      toTupleFilter(filter),
+		Consistency: options.Consistency.Preference,
+		ExpiryTime:  toExpiryTime(ctx),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	storage.ObserveTupleExpiry(ctx, fromExpiresAt(resp.GetExpiresAt()))
+	return resp.GetTuple(), nil
+}
+
+// ReadUsersetTuples see [storage.RelationshipTupleReader].ReadUsersetTuples.
+func (ds *Datastore) ReadUsersetTuples(ctx context.Context, store string, filter storage.ReadUsersetTuplesFilter, options storage.ReadUsersetTuplesOptions) (storage.TupleIterator, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := ds.client.ReadUsersetTuples(ctx, &datastorev1.ReadUsersetTuplesRequest{
+		Store:                       store,
+		Object:                      filter.Object,
+		Relation:                    filter.Relation,
+		AllowedUserTypeRestrictions: filter.AllowedUserTypeRestrictions,
+		Conditions:                  filter.Conditions,
+		Consistency:                 options.Consistency.Preference,
+		ExpiryTime:                  toExpiryTime(ctx),
+	})
+	if err != nil {
+		cancel()
+		return nil, fromStatus(err)
+	}
+	return newStreamIterator(stream, cancel), nil
+}
+
+// ReadStartingWithUser see [storage.RelationshipTupleReader].ReadStartingWithUser.
+func (ds *Datastore) ReadStartingWithUser(ctx context.Context, store string, filter storage.ReadStartingWithUserFilter, options storage.ReadStartingWithUserOptions) (storage.TupleIterator, error) {
+	req := &datastorev1.ReadStartingWithUserRequest{
+		Store:                      store,
+		ObjectType:                 filter.ObjectType,
+		Relation:                   filter.Relation,
+		UserFilter:                 filter.UserFilter,
+		Conditions:                 filter.Conditions,
+		Consistency:                options.Consistency.Preference,
+		WithResultsSortedAscending: options.WithResultsSortedAscending,
+		ExpiryTime:                 toExpiryTime(ctx),
+		AfterObjectId:              filter.AfterObjectID,
+	}
+	if filter.ObjectIDs != nil {
+		req.ObjectIds = &datastorev1.ObjectIDs{Values: filter.ObjectIDs.Values()}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := ds.client.ReadStartingWithUser(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, fromStatus(err)
+	}
+	return newStreamIterator(stream, cancel), nil
+}
+
+// Write see [storage.RelationshipTupleWriter].Write.
+func (ds *Datastore) Write(ctx context.Context, store string, deletes storage.Deletes, writes storage.Writes, opts ...storage.TupleWriteOption) error {
+	writeOptions := storage.NewTupleWriteOptions(opts...)
+	req := &datastorev1.WriteRequest{
+		Store:             store,
+		Deletes:           deletes,
+		Writes:            writes,
+		OnMissingDelete:   datastorev1.OnMissingDelete(writeOptions.OnMissingDelete),
+		OnDuplicateInsert: datastorev1.OnDuplicateInsert(writeOptions.OnDuplicateInsert),
+		NonTransactional:  writeOptions.NonTransactional,
+	}
+	if !writeOptions.ExpiresAt.IsZero() {
+		// A DatastoreService unaware of expires_at would store the tuples without their expiry.
+		if !ds.supportsTupleExpiry {
+			return storage.ErrTupleExpiryNotSupported
+		}
+		req.ExpiresAt = timestamppb.New(writeOptions.ExpiresAt)
+	}
+	_, err := ds.client.Write(ctx, req)
+	return fromStatus(err)
+}
+
+// MaxTuplesPerWrite see [storage.RelationshipTupleWriter].MaxTuplesPerWrite.
+func (ds *Datastore) MaxTuplesPerWrite() int {
+	return ds.maxTuplesPerWrite
+}
+
+// ReadAuthorizationModel see [storage.AuthorizationModelReadBackend].ReadAuthorizationModel.
+func (ds *Datastore) ReadAuthorizationModel(ctx context.Context, store string, id string) (*openfgav1.AuthorizationModel, error) {
+	resp, err := ds.client.ReadAuthorizationModel(ctx, &datastorev1.ReadAuthorizationModelRequest{
+		Store: store,
+		Id:    id,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetAuthorizationModel(), nil
+}
+
+// ReadAuthorizationModels see [storage.AuthorizationModelReadBackend].ReadAuthorizationModels.
+func (ds *Datastore) ReadAuthorizationModels(ctx context.Context, store string, options storage.ReadAuthorizationModelsOptions) ([]*openfgav1.AuthorizationModel, string, error) {
+	resp, err := ds.client.ReadAuthorizationModels(ctx, &datastorev1.ReadAuthorizationModelsRequest{
+		Store:      store,
+		Pagination: toPagination(options.Pagination),
+	})
+	if err != nil {
+		return nil, "", fromStatus(err)
+	}
+	return resp.GetAuthorizationModels(), resp.GetContinuationToken(), nil
+}
+
+// FindLatestAuthorizationModel see [storage.AuthorizationModelReadBackend].FindLatestAuthorizationModel.
+func (ds *Datastore) FindLatestAuthorizationModel(ctx context.Context, store string) (*openfgav1.AuthorizationModel, error) {
+	resp, err := ds.client.FindLatestAuthorizationModel(ctx, &datastorev1.FindLatestAuthorizationModelRequest{
+		Store: store,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetAuthorizationModel(), nil
+}
+
+// MaxTypesPerAuthorizationModel see [storage.TypeDefinitionWriteBackend].MaxTypesPerAuthorizationModel.
+func (ds *Datastore) MaxTypesPerAuthorizationModel() int {
+	return ds.maxTypesPerAuthorizationModel
+}
+
+// WriteAuthorizationModel see [storage.TypeDefinitionWriteBackend].WriteAuthorizationModel.
+func (ds *Datastore) WriteAuthorizationModel(ctx context.Context, store string, model *openfgav1.AuthorizationModel) error {
+	_, err := ds.client.WriteAuthorizationModel(ctx, &datastorev1.WriteAuthorizationModelRequest{
+		Store:              store,
+		AuthorizationModel: model,
+	})
+	return fromStatus(err)
+}
+
+// CreateStore see [storage.StoresBackend].CreateStore.
+func (ds *Datastore) CreateStore(ctx context.Context, store *openfgav1.Store) (*openfgav1.Store, error) {
+	resp, err := ds.client.CreateStore(ctx, &datastorev1.CreateStoreRequest{Store: store})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetStore(), nil
+}
+
+// DeleteStore see [storage.StoresBackend].DeleteStore.
+func (ds *Datastore) DeleteStore(ctx context.Context, id string) error {
+	_, err := ds.client.DeleteStore(ctx, &datastorev1.DeleteStoreRequest{Id: id})
+	return fromStatus(err)
+}
+
+// GetStore see [storage.StoresBackend].GetStore.
+func (ds *Datastore) GetStore(ctx context.Context, id string) (*openfgav1.Store, error) {
+	resp, err := ds.client.GetStore(ctx, &datastorev1.GetStoreRequest{Id: id})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetStore(), nil
+}
+
+// ListStores see [storage.StoresBackend].ListStores.
+func (ds *Datastore) ListStores(ctx context.Context, options storage.ListStoresOptions) ([]*openfgav1.Store, string, error) {
+	resp, err := ds.client.ListStores(ctx, &datastorev1.ListStoresRequest{
+		Ids:        options.IDs,
+		Name:       options.Name,
+		Pagination: toPagination(options.Pagination),
+	})
+	if err != nil {
+		return nil, "", fromStatus(err)
+	}
+	return resp.GetStores(), resp.GetContinuationToken(), nil
+}
+
+// WriteAssertions see [storage.AssertionsBackend].WriteAssertions.
+func (ds *Datastore) WriteAssertions(ctx context.Context, store, modelID string, assertions []*openfgav1.Assertion) error {
+	_, err := ds.client.WriteAssertions(ctx, &datastorev1.WriteAssertionsRequest{
+		Store:                store,
+		AuthorizationModelId: modelID,
+		Assertions:           assertions,
+	})
+	return fromStatus(err)
+}
+
+// ReadAssertions see [storage.AssertionsBackend].ReadAssertions.
+func (ds *Datastore) ReadAssertions(ctx context.Context, store, modelID string) ([]*openfgav1.Assertion, error) {
+	resp, err := ds.client.ReadAssertions(ctx, &datastorev1.ReadAssertionsRequest{
+		Store:                store,
+		AuthorizationModelId: modelID,
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return resp.GetAssertions(), nil
+}
+
+// ReadChanges see [storage.ChangelogBackend].ReadChanges.
+func (ds *Datastore) ReadChanges(ctx context.Context, store string, filter storage.ReadChangesFilter, options storage.ReadChangesOptions) ([]*openfgav1.TupleChange, string, error) {
+	resp, err := ds.client.ReadChanges(ctx, &datastorev1.ReadChangesRequest{
+		Store:         store,
+		ObjectType:    filter.ObjectType,
+		HorizonOffset: durationpb.New(filter.HorizonOffset),
+		Pagination:    toPagination(options.Pagination),
+		SortDesc:      options.SortDesc,
+		CheckHorizon:  options.CheckHorizon,
+	})
+	if err != nil {
+		return nil, "", fromStatus(err)
+	}
+	for i, expiresAt := range resp.GetExpiresAt() {
+		if int(i) < len(resp.GetChanges()) {
+			storage.ObserveChangeExpiry(ctx, resp.GetChanges()[i], expiresAt.AsTime())
+		}
+	}
+	return resp.GetChanges(), resp.GetContinuationToken(), nil
+}
+
+// PruneChanges see [storage.ChangelogPruner].PruneChanges.
+func (ds *Datastore) PruneChanges(ctx context.Context, store string, options storage.PruneChangesOptions) (int, error) {
+	resp, err := ds.client.PruneChanges(ctx, &datastorev1.PruneChangesRequest{
+		Store:      store,
+		MaxAge:     durationpb.New(options.MaxAge),
+		MaxChanges: int32(options.MaxChanges),
+		BatchSize:  int32(options.BatchSize),
+	})
+	if err != nil {
+		return 0, fromStatus(err)
+	}
+	return int(resp.GetDeleted()), nil
+}
+
+// DeleteExpiredTuples see [storage.TupleExpirer].DeleteExpiredTuples.
+func (ds *Datastore) DeleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	if !ds.supportsTupleExpiry {
+		return 0, storage.ErrTupleExpiryNotSupported
+	}
+	resp, err := ds.client.DeleteExpiredTuples(ctx, &datastorev1.DeleteExpiredTuplesRequest{
+		Now:   timestamppb.New(now),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return 0, fromStatus(err)
+	}
+	return int(resp.GetDeleted()), nil
+}
+
+// CloneStore see [storage.StoreCloner].CloneStore.
+func (ds *Datastore) CloneStore(ctx context.Context, source string, target *openfgav1.Store, options storage.CloneStoreOptions) (*openfgav1.Store, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := ds.client.CloneStore(ctx, &datastorev1.CloneStoreRequest{
+		Source:    source,
+		Target:    target,
+		BatchSize: int32(options.BatchSize),
+	})
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("the CloneStore stream ended without the created store")
+			}
+			return nil, fromStatus(err)
+		}
+		if resp.GetStore() != nil {
+			return resp.GetStore(), nil
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(int(resp.GetCopiedTuples()))
+		}
+	}
+}
+
+// BulkLoad see [storage.BulkLoader].BulkLoad.
+func (ds *Datastore) BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options storage.BulkLoadOptions) (int, error) {
+	resp, err := ds.client.BulkLoad(ctx, &datastorev1.BulkLoadRequest{
+		Store:         store,
+		Tuples:        tuples,
+		SkipChangelog: options.SkipChangelog,
+	})
+	if err != nil {
+		return 0, fromStatus(err)
+	}
+	return int(resp.GetWritten()), nil
+}
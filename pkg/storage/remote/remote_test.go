@@ -0,0 +1,180 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	datastorev1 "github.com/openfga/openfga/pkg/storage/remote/proto/openfga/datastore/v1"
+	"github.com/openfga/openfga/pkg/storage/test"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+// newRemoteDatastore serves backend with an in-process [Server] and returns a [Datastore]
+// connected to it.
+func newRemoteDatastore(t *testing.T, backend storage.OpenFGADatastore, opts ...Option) *Datastore {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	datastorev1.RegisterDatastoreServiceServer(srv, NewServer(backend))
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	t.Cleanup(srv.Stop)
+
+	opts = append(opts, WithDialOptions(
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	))
+	ds, err := New("passthrough:///bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(ds.Close)
+	return ds
+}
+
+func TestRemoteDatastore(t *testing.T) {
+	ds := newRemoteDatastore(t, memory.New())
+	test.RunAllTests(t, ds)
+}
+
+func TestRemoteDatastoreLimits(t *testing.T) {
+	backend := memory.New(memory.WithMaxTuplesPerWrite(10), memory.WithMaxTypesPerAuthorizationModel(500))
+
+	ds := newRemoteDatastore(t, backend, WithMaxTuplesPerWrite(50), WithMaxTypesPerAuthorizationModel(200))
+	require.Equal(t, 10, ds.MaxTuplesPerWrite())
+	require.Equal(t, 200, ds.MaxTypesPerAuthorizationModel())
+}
+
+// withoutTupleExpiry hides the [storage.TupleExpirer] implementation of a datastore.
+type withoutTupleExpiry struct {
+	storage.OpenFGADatastore
+}
+
+func TestRemoteDatastoreTupleExpiry(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := storage.WithExpiresAt(time.Now().Add(time.Hour))
+
+	t.Run("supported", func(t *testing.T) {
+		ds := newRemoteDatastore(t, memory.New())
+		require.NoError(t, ds.Write(ctx, ulid.Make().String(), nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+		}, expiresAt))
+
+		_, err := ds.DeleteExpiredTuples(ctx, time.Now(), 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		backend := withoutTupleExpiry{memory.New()}
+		t.Cleanup(backend.Close)
+		ds := newRemoteDatastore(t, backend)
+
+		store := ulid.Make().String()
+		writes := []*openfgav1.TupleKey{tuple.NewTupleKey("document:1", "viewer", "user:jon")}
+		err := ds.Write(ctx, store, nil, writes, expiresAt)
+		require.ErrorIs(t, err, storage.ErrTupleExpiryNotSupported)
+		require.NoError(t, ds.Write(ctx, store, nil, writes))
+
+		_, err = ds.DeleteExpiredTuples(ctx, time.Now(), 0)
+		require.ErrorIs(t, err, storage.ErrTupleExpiryNotSupported)
+
+		// Clients unaware of the support of the datastore are rejected by the server.
+		_, err = NewServer(backend).Write(ctx, &datastorev1.WriteRequest{
+			Store:     store,
+			Writes:    []*openfgav1.TupleKey{tuple.NewTupleKey("document:2", "viewer", "user:jon")},
+			ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		require.ErrorIs(t, fromStatus(err), storage.ErrTupleExpiryNotSupported)
+	})
+}
+
+func TestStreamIteratorStop(t *testing.T) {
+	ctx := context.Background()
+	ds := newRemoteDatastore(t, memory.New(memory.WithMaxTuplesPerWrite(1000)))
+
+	store := ulid.Make().String()
+	writes := make([]*openfgav1.TupleKey, 0, 500)
+	for i := 0; i < 500; i++ {
+		writes = append(writes, tuple.NewTupleKey(fmt.Sprintf("document:%d", i), "viewer", "user:jon"))
+	}
+	require.NoError(t, ds.Write(ctx, store, nil, writes))
+
+	iter, err := ds.Read(ctx, store, storage.ReadFilter{Object: "document:"}, storage.ReadOptions{})
+	require.NoError(t, err)
+
+	head, err := iter.Head(ctx)
+	require.NoError(t, err)
+	next, err := iter.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, head, next)
+
+	iter.Stop()
+	_, err = iter.Next(ctx)
+	require.ErrorIs(t, err, storage.ErrIteratorDone)
+	_, err = iter.Head(ctx)
+	require.ErrorIs(t, err, storage.ErrIteratorDone)
+}
+
+func TestErrorConversion(t *testing.T) {
+	invalidWrite := storage.InvalidWriteInputError(
+		tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+		openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
+	)
+	conflict := storage.TupleConditionConflictError(tuple.NewTupleKey("document:1", "viewer", "user:jon"))
+
+	for _, err := range []error{
+		storage.ErrNotFound,
+		storage.ErrCollision,
+		storage.ErrInvalidContinuationToken,
+		storage.ErrInvalidStartTime,
+		storage.ErrChangelogTruncated,
+		invalidWrite,
+		conflict,
+		storage.ErrWriteConflictOnInsert,
+		storage.ErrWriteConflictOnDelete,
+		storage.ErrTransactionRetriesExhausted,
+		storage.ErrTransactionTooLarge,
+		storage.ErrTransactionThrottled,
+		storage.ErrTupleExpiryNotSupported,
+		fmt.Errorf("read store: %w", context.DeadlineExceeded),
+	} {
+		t.Run(err.Error(), func(t *testing.T) {
+			converted := fromStatus(toStatus(err))
+			require.EqualError(t, converted, err.Error())
+
+			for _, known := range knownErrors {
+				require.Equal(t, errors.Is(err, known.err), errors.Is(converted, known.err), known.err.Error())
+			}
+		})
+	}
+
+	t.Run("unknown_errors_keep_their_message", func(t *testing.T) {
+		converted := fromStatus(toStatus(errors.New("disk full")))
+		require.Equal(t, codes.Unknown, status.Code(converted))
+		require.ErrorContains(t, converted, "disk full")
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		require.NoError(t, toStatus(nil))
+		require.NoError(t, fromStatus(nil))
+	})
+}
@@ -0,0 +1,435 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	datastorev1 "github.com/openfga/openfga/pkg/storage/remote/proto/openfga/datastore/v1"
+)
+
+// Server is a reference implementation of the DatastoreService that serves any
+// [storage.OpenFGADatastore]. It is the starting point of out-of-tree datastores written in Go,
+// and is used to test [Datastore] against the in-tree datastores.
+type Server struct {
+	datastorev1.UnimplementedDatastoreServiceServer
+
+	datastore storage.OpenFGADatastore
+}
+
+var _ datastorev1.DatastoreServiceServer = (*Server)(nil)
+
+// NewServer returns a [Server] serving datastore. Register it on a gRPC server with
+// datastorev1.RegisterDatastoreServiceServer.
+func NewServer(datastore storage.OpenFGADatastore) *Server {
+	return &Server{datastore: datastore}
+}
+
+func fromTupleFilter(filter *datastorev1.TupleFilter) storage.ReadFilter {
+	return storage.ReadFilter{
+		Object:     filter.GetObject(),
+		Relation:   filter.GetRelation(),
+		User:       filter.GetUser(),
+		Conditions: filter.GetConditions(),
+	}
+}
+
+func fromPagination(pagination *datastorev1.Pagination) storage.PaginationOptions {
+	return storage.PaginationOptions{
+		PageSize: int(pagination.GetPageSize()),
+		From:     pagination.GetFrom(),
+	}
+}
+
+func consistency(preference openfgav1.ConsistencyPreference) storage.ConsistencyOptions {
+	return storage.ConsistencyOptions{Preference: preference}
+}
+
+// toExpiresAt returns the expires_at of a read tuple expiring at expiresAt, nil if it never expires.
+func toExpiresAt(expiresAt time.Time) *timestamppb.Timestamp {
+	if expiresAt.IsZero() {
+		return nil
+	}
+	return timestamppb.New(expiresAt)
+}
+
+// withExpiryTime returns a context derived from ctx with which the datastore evaluates the expiry of
+// the tuples read at expiryTime, see [storage.ContextWithTupleExpiryTime], or ctx itself if
+// expiryTime is unset.
+func withExpiryTime(ctx context.Context, expiryTime *timestamppb.Timestamp) context.Context {
+	if expiryTime == nil {
+		return ctx
+	}
+	return storage.ContextWithTupleExpiryTime(ctx, expiryTime.AsTime())
+}
+
+// readTupleExpiry returns a context derived from ctx with which the expiry of the tuple read by a
+// call is stored in expiresAt, see [storage.ObserveTupleExpiry]. expiresAt is to be reset before
+// every call.
+func readTupleExpiry(ctx context.Context, expiresAt *time.Time) context.Context {
+	return storage.ContextWithTupleExpiryObserver(ctx, func(t time.Time) {
+		*expiresAt = t
+	})
+}
+
+// sendTuples sends every tuple of iter on stream, wrapped by newResponse with its expiry, and
+// stops iter.
+func sendTuples[T any](ctx context.Context, iter storage.TupleIterator, stream grpc.ServerStreamingServer[T], newResponse func(*openfgav1.Tuple, *timestamppb.Timestamp) *T) error {
+	defer iter.Stop()
+
+	var expiresAt time.Time
+	ctx = readTupleExpiry(ctx, &expiresAt)
+	for {
+		expiresAt = time.Time{}
+		t, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, storage.ErrIteratorDone) {
+				return nil
+			}
+			return toStatus(err)
+		}
+		if err := stream.Send(newResponse(t, toExpiresAt(expiresAt))); err != nil {
+			return err
+		}
+	}
+}
+
+// GetLimits see [datastorev1.DatastoreServiceServer].GetLimits.
+func (s *Server) GetLimits(context.Context, *datastorev1.GetLimitsRequest) (*datastorev1.GetLimitsResponse, error) {
+	return &datastorev1.GetLimitsResponse{
+		MaxTuplesPerWrite:             int32(s.datastore.MaxTuplesPerWrite()),
+		MaxTypesPerAuthorizationModel: int32(s.datastore.MaxTypesPerAuthorizationModel()),
+		SupportsTupleExpiry:           supportsTupleExpiry(s.datastore),
+	}, nil
+}
+
+// IsReady see [datastorev1.DatastoreServiceServer].IsReady.
+func (s *Server) IsReady(ctx context.Context, _ *datastorev1.IsReadyRequest) (*datastorev1.IsReadyResponse, error) {
+	status, err := s.datastore.IsReady(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.IsReadyResponse{IsReady: status.IsReady, Message: status.Message}, nil
+}
+
+// Read see [datastorev1.DatastoreServiceServer].Read.
+func (s *Server) Read(req *datastorev1.ReadRequest, stream grpc.ServerStreamingServer[datastorev1.ReadResponse]) error {
+	ctx := withExpiryTime(stream.Context(), req.GetExpiryTime())
+	iter, err := s.datastore.Read(ctx, req.GetStore(), fromTupleFilter(req.GetFilter()), storage.ReadOptions{
+		Consistency: consistency(req.GetConsistency()),
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	return sendTuples(ctx, iter, stream, func(t *openfgav1.Tuple, expiresAt *timestamppb.Timestamp) *datastorev1.ReadResponse {
+		return &datastorev1.ReadResponse{Tuple: t, ExpiresAt: expiresAt}
+	})
+}
+
+// ReadPage see [datastorev1.DatastoreServiceServer].ReadPage.
+func (s *Server) ReadPage(ctx context.Context, req *datastorev1.ReadPageRequest) (*datastorev1.ReadPageResponse, error) {
+	tuples, token, err := s.datastore.ReadPage(withExpiryTime(ctx, req.GetExpiryTime()), req.GetStore(), fromTupleFilter(req.GetFilter()), storage.ReadPageOptions{
+		Pagination:  fromPagination(req.GetPagination()),
+		Consistency: consistency(req.GetConsistency()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.ReadPageResponse{Tuples: tuples, ContinuationToken: token}, nil
+}
+
+// ReadUserTuple see [datastorev1.DatastoreServiceServer].ReadUserTuple.
+func (s *Server) ReadUserTuple(ctx context.Context, req *datastorev1.ReadUserTupleRequest) (*datastorev1.ReadUserTupleResponse, error) {
+	var expiresAt time.Time
+	ctx = readTupleExpiry(withExpiryTime(ctx, req.GetExpiryTime()), &expiresAt)
+	t, err := s.datastore.ReadUserTuple(ctx, req.GetStore(), fromTupleFilter(req.GetFilter()), storage.ReadUserTupleOptions{
+		Consistency: consistency(req.GetConsistency()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.ReadUserTupleResponse{Tuple: t, ExpiresAt: toExpiresAt(expiresAt)}, nil
+}
+
+// ReadUsersetTuples see [datastorev1.DatastoreServiceServer].ReadUsersetTuples.
+func (s *Server) ReadUsersetTuples(req *datastorev1.ReadUsersetTuplesRequest, stream grpc.ServerStreamingServer[datastorev1.ReadUsersetTuplesResponse]) error {
+	ctx := withExpiryTime(stream.Context(), req.GetExpiryTime())
+	iter, err := s.datastore.ReadUsersetTuples(ctx, req.GetStore(), storage.ReadUsersetTuplesFilter{
+		Object:                      req.GetObject(),
+		Relation:                    req.GetRelation(),
+		AllowedUserTypeRestrictions: req.GetAllowedUserTypeRestrictions(),
+		Conditions:                  req.GetConditions(),
+	}, storage.ReadUsersetTuplesOptions{
+		Consistency: consistency(req.GetConsistency()),
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	return sendTuples(ctx, iter, stream, func(t *openfgav1.Tuple, expiresAt *timestamppb.Timestamp) *datastorev1.ReadUsersetTuplesResponse {
+		return &datastorev1.ReadUsersetTuplesResponse{Tuple: t, ExpiresAt: expiresAt}
+	})
+}
+
+// ReadStartingWithUser see [datastorev1.DatastoreServiceServer].ReadStartingWithUser.
+func (s *Server) ReadStartingWithUser(req *datastorev1.ReadStartingWithUserRequest, stream grpc.ServerStreamingServer[datastorev1.ReadStartingWithUserResponse]) error {
+	ctx := withExpiryTime(stream.Context(), req.GetExpiryTime())
+	filter := storage.ReadStartingWithUserFilter{
+		ObjectType:    req.GetObjectType(),
+		Relation:      req.GetRelation(),
+		UserFilter:    req.GetUserFilter(),
+		Conditions:    req.GetConditions(),
+		AfterObjectID: req.GetAfterObjectId(),
+	}
+	if req.GetObjectIds() != nil {
+		filter.ObjectIDs = storage.NewSortedSet(req.GetObjectIds().GetValues()...)
+	}
+
+	iter, err := s.datastore.ReadStartingWithUser(ctx, req.GetStore(), filter, storage.ReadStartingWithUserOptions{
+		Consistency:                consistency(req.GetConsistency()),
+		WithResultsSortedAscending: req.GetWithResultsSortedAscending(),
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	return sendTuples(ctx, iter, stream, func(t *openfgav1.Tuple, expiresAt *timestamppb.Timestamp) *datastorev1.ReadStartingWithUserResponse {
+		return &datastorev1.ReadStartingWithUserResponse{Tuple: t, ExpiresAt: expiresAt}
+	})
+}
+
+// Write see [datastorev1.DatastoreServiceServer].Write. It fails with
+// ERROR_REASON_TUPLE_EXPIRY_NOT_SUPPORTED when writing expiring tuples to a datastore that does not
+// implement [storage.TupleExpirer], as they would never be deleted.
+func (s *Server) Write(ctx context.Context, req *datastorev1.WriteRequest) (*datastorev1.WriteResponse, error) {
+	opts := []storage.TupleWriteOption{
+		storage.WithOnMissingDelete(storage.OnMissingDelete(req.GetOnMissingDelete())),
+		storage.WithOnDuplicateInsert(storage.OnDuplicateInsert(req.GetOnDuplicateInsert())),
+	}
+	if req.GetNonTransactional() {
+		opts = append(opts, storage.WithNonTransactionalWrite())
+	}
+	if req.GetExpiresAt() != nil {
+		if !supportsTupleExpiry(s.datastore) {
+			return nil, toStatus(storage.ErrTupleExpiryNotSupported)
+		}
+		opts = append(opts, storage.WithExpiresAt(req.GetExpiresAt().AsTime()))
+	}
+
+	if err := s.datastore.Write(ctx, req.GetStore(), req.GetDeletes(), req.GetWrites(), opts...); err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.WriteResponse{}, nil
+}
+
+// ReadAuthorizationModel see [datastorev1.DatastoreServiceServer].ReadAuthorizationModel.
+func (s *Server) ReadAuthorizationModel(ctx context.Context, req *datastorev1.ReadAuthorizationModelRequest) (*datastorev1.ReadAuthorizationModelResponse, error) {
+	model, err := s.datastore.ReadAuthorizationModel(ctx, req.GetStore(), req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.ReadAuthorizationModelResponse{AuthorizationModel: model}, nil
+}
+
+// ReadAuthorizationModels see [datastorev1.DatastoreServiceServer].ReadAuthorizationModels.
+func (s *Server) ReadAuthorizationModels(ctx context.Context, req *datastorev1.ReadAuthorizationModelsRequest) (*datastorev1.ReadAuthorizationModelsResponse, error) {
+	models, token, err := s.datastore.ReadAuthorizationModels(ctx, req.GetStore(), storage.ReadAuthorizationModelsOptions{
+		Pagination: fromPagination(req.GetPagination()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.ReadAuthorizationModelsResponse{AuthorizationModels: models, ContinuationToken: token}, nil
+}
+
+// FindLatestAuthorizationModel see [datastorev1.DatastoreServiceServer].FindLatestAuthorizationModel.
+func (s *Server) FindLatestAuthorizationModel(ctx context.Context, req *datastorev1.FindLatestAuthorizationModelRequest) (*datastorev1.FindLatestAuthorizationModelResponse, error) {
+	model, err := s.datastore.FindLatestAuthorizationModel(ctx, req.GetStore())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.FindLatestAuthorizationModelResponse{AuthorizationModel: model}, nil
+}
+
+// WriteAuthorizationModel see [datastorev1.DatastoreServiceServer].WriteAuthorizationModel.
+func (s *Server) WriteAuthorizationModel(ctx context.Context, req *datastorev1.WriteAuthorizationModelRequest) (*datastorev1.WriteAuthorizationModelResponse, error) {
+	if err := s.datastore.WriteAuthorizationModel(ctx, req.GetStore(), req.GetAuthorizationModel()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.WriteAuthorizationModelResponse{}, nil
+}
+
+// CreateStore see [datastorev1.DatastoreServiceServer].CreateStore.
+func (s *Server) CreateStore(ctx context.Context, req *datastorev1.CreateStoreRequest) (*datastorev1.CreateStoreResponse, error) {
+	store, err := s.datastore.CreateStore(ctx, req.GetStore())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.CreateStoreResponse{Store: store}, nil
+}
+
+// DeleteStore see [datastorev1.DatastoreServiceServer].DeleteStore.
+func (s *Server) DeleteStore(ctx context.Context, req *datastorev1.DeleteStoreRequest) (*datastorev1.DeleteStoreResponse, error) {
+	if err := s.datastore.DeleteStore(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.DeleteStoreResponse{}, nil
+}
+
+// GetStore see [datastorev1.DatastoreServiceServer].GetStore.
+func (s *Server) GetStore(ctx context.Context, req *datastorev1.GetStoreRequest) (*datastorev1.GetStoreResponse, error) {
+	store, err := s.datastore.GetStore(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.GetStoreResponse{Store: store}, nil
+}
+
+// ListStores see [datastorev1.DatastoreServiceServer].ListStores.
+func (s *Server) ListStores(ctx context.Context, req *datastorev1.ListStoresRequest) (*datastorev1.ListStoresResponse, error) {
+	stores, token, err := s.datastore.ListStores(ctx, storage.ListStoresOptions{
+		IDs:        req.GetIds(),
+		Name:       req.GetName(),
+		Pagination: fromPagination(req.GetPagination()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.ListStoresResponse{Stores: stores, ContinuationToken: token}, nil
+}
+
+// WriteAssertions see [datastorev1.DatastoreServiceServer].WriteAssertions.
+func (s *Server) WriteAssertions(ctx context.Context, req *datastorev1.WriteAssertionsRequest) (*datastorev1.WriteAssertionsResponse, error) {
+	if err := s.datastore.WriteAssertions(ctx, req.GetStore(), req.GetAuthorizationModelId(), req.GetAssertions()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.WriteAssertionsResponse{}, nil
+}
+
+// ReadAssertions see [datastorev1.DatastoreServiceServer].ReadAssertions.
+func (s *Server) ReadAssertions(ctx context.Context, req *datastorev1.ReadAssertionsRequest) (*datastorev1.ReadAssertionsResponse, error) {
+	assertions, err := s.datastore.ReadAssertions(ctx, req.GetStore(), req.GetAuthorizationModelId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.ReadAssertionsResponse{Assertions: assertions}, nil
+}
+
+// ReadChanges see [datastorev1.DatastoreServiceServer].ReadChanges.
+func (s *Server) ReadChanges(ctx context.Context, req *datastorev1.ReadChangesRequest) (*datastorev1.ReadChangesResponse, error) {
+	expiries := map[*openfgav1.TupleChange]time.Time{}
+	ctx = storage.ContextWithChangeExpiryObserver(ctx, func(change *openfgav1.TupleChange, expiresAt time.Time) {
+		expiries[change] = expiresAt
+	})
+	changes, token, err := s.datastore.ReadChanges(ctx, req.GetStore(), storage.ReadChangesFilter{
+		ObjectType:    req.GetObjectType(),
+		HorizonOffset: req.GetHorizonOffset().AsDuration(),
+	}, storage.ReadChangesOptions{
+		Pagination:   fromPagination(req.GetPagination()),
+		SortDesc:     req.GetSortDesc(),
+		CheckHorizon: req.GetCheckHorizon(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	changeExpiries := make(map[int32]*timestamppb.Timestamp, len(expiries))
+	for i, change := range changes {
+		if expiresAt, ok := expiries[change]; ok {
+			changeExpiries[int32(i)] = timestamppb.New(expiresAt)
+		}
+	}
+	return &datastorev1.ReadChangesResponse{Changes: changes, ContinuationToken: token, ExpiresAt: changeExpiries}, nil
+}
+
+// PruneChanges see [datastorev1.DatastoreServiceServer].PruneChanges. It returns an Unimplemented
+// error if the datastore does not implement [storage.ChangelogPruner].
+func (s *Server) PruneChanges(ctx context.Context, req *datastorev1.PruneChangesRequest) (*datastorev1.PruneChangesResponse, error) {
+	pruner, ok := s.datastore.(storage.ChangelogPruner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the datastore does not support pruning the changelog")
+	}
+
+	deleted, err := pruner.PruneChanges(ctx, req.GetStore(), storage.PruneChangesOptions{
+		MaxAge:     req.GetMaxAge().AsDuration(),
+		MaxChanges: int(req.GetMaxChanges()),
+		BatchSize:  int(req.GetBatchSize()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.PruneChangesResponse{Deleted: int32(deleted)}, nil
+}
+
+// DeleteExpiredTuples see [datastorev1.DatastoreServiceServer].DeleteExpiredTuples. It returns an
+// Unimplemented error if the datastore does not implement [storage.TupleExpirer].
+func (s *Server) DeleteExpiredTuples(ctx context.Context, req *datastorev1.DeleteExpiredTuplesRequest) (*datastorev1.DeleteExpiredTuplesResponse, error) {
+	expirer, ok := s.datastore.(storage.TupleExpirer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, storage.ErrTupleExpiryNotSupported.Error())
+	}
+
+	deleted, err := expirer.DeleteExpiredTuples(ctx, req.GetNow().AsTime(), int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.DeleteExpiredTuplesResponse{Deleted: int32(deleted)}, nil
+}
+
+// CloneStore see [datastorev1.DatastoreServiceServer].CloneStore. It returns an Unimplemented
+// error if the datastore does not implement [storage.StoreCloner].
+func (s *Server) CloneStore(req *datastorev1.CloneStoreRequest, stream grpc.ServerStreamingServer[datastorev1.CloneStoreResponse]) error {
+	cloner, ok := s.datastore.(storage.StoreCloner)
+	if !ok {
+		return status.Error(codes.Unimplemented, "the datastore does not support cloning stores")
+	}
+
+	// A failure to report the progress cancels the copy.
+	ctx, cancel := context.WithCancelCause(stream.Context())
+	defer cancel(nil)
+
+	store, err := cloner.CloneStore(ctx, req.GetSource(), req.GetTarget(), storage.CloneStoreOptions{
+		BatchSize: int(req.GetBatchSize()),
+		OnProgress: func(copiedTuples int) {
+			if err := stream.Send(&datastorev1.CloneStoreResponse{CopiedTuples: int64(copiedTuples)}); err != nil {
+				cancel(err)
+			}
+		},
+	})
+	if err != nil {
+		if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.Canceled) {
+			return toStatus(cause)
+		}
+		return toStatus(err)
+	}
+	return stream.Send(&datastorev1.CloneStoreResponse{Store: store})
+}
+
+// BulkLoad see [datastorev1.DatastoreServiceServer].BulkLoad. It returns an Unimplemented error if
+// the datastore does not implement [storage.BulkLoader].
+func (s *Server) BulkLoad(ctx context.Context, req *datastorev1.BulkLoadRequest) (*datastorev1.BulkLoadResponse, error) {
+	loader, ok := s.datastore.(storage.BulkLoader)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the datastore does not support bulk loading tuples")
+	}
+
+	written, err := loader.BulkLoad(ctx, req.GetStore(), req.GetTuples(), storage.BulkLoadOptions{
+		SkipChangelog: req.GetSkipChangelog(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &datastorev1.BulkLoadResponse{Written: int64(written)}, nil
+}
+
+// supportsTupleExpiry returns whether datastore deletes the tuples written with an expiry.
+func supportsTupleExpiry(datastore storage.OpenFGADatastore) bool {
+	_, ok := datastore.(storage.TupleExpirer)
+	return ok
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,7 +23,6 @@ import (
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
-	"github.com/openfga/openfga/internal/build"
 	"github.com/openfga/openfga/pkg/encoder"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/storage"
@@ -30,6 +31,51 @@ import (
 
 var tracer = otel.Tracer("pkg/storage/sqlcommon")
 
+const (
+	DefaultOCCRetryInitialInterval = 10 * time.Millisecond
+	DefaultOCCRetryMaxInterval     = 1 * time.Second
+	DefaultOCCRetryMaxElapsedTime  = 5 * time.Second
+	DefaultOCCRetryMaxAttempts     = 0 // 0 means attempts are only bounded by MaxElapsedTime
+	DefaultOCCRetryJitter          = 0.5
+
+	// DefaultDSQLMaxRowsPerTransaction is the Aurora DSQL quota on the number of rows,
+	// including secondary index entries, that a single transaction may modify.
+	DefaultDSQLMaxRowsPerTransaction = 3000
+)
+
+// OCCRetryConfig defines the retry policy applied to transactions that fail
+// with an optimistic concurrency control conflict. It is only used by engines
+// with optimistic concurrency control (e.g. Aurora DSQL).
+type OCCRetryConfig struct {
+	// InitialInterval is the wait time before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the wait time between two retries.
+	MaxInterval time.Duration
+
+	// MaxElapsedTime is the total time after which retries stop.
+	MaxElapsedTime time.Duration
+
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// 0 means attempts are only bounded by MaxElapsedTime.
+	MaxAttempts int
+
+	// Jitter is the randomization factor applied to each retry interval, between 0 and 1.
+	Jitter float64
+}
+
+// DefaultOCCRetryConfig returns the default retry policy
+// for optimistic concurrency control conflicts.
+func DefaultOCCRetryConfig() OCCRetryConfig {
+	return OCCRetryConfig{
+		InitialInterval: DefaultOCCRetryInitialInterval,
+		MaxInterval:     DefaultOCCRetryMaxInterval,
+		MaxElapsedTime:  DefaultOCCRetryMaxElapsedTime,
+		MaxAttempts:     DefaultOCCRetryMaxAttempts,
+		Jitter:          DefaultOCCRetryJitter,
+	}
+}
+
 // Config defines the configuration parameters
 // for setting up and managing a sql connection.
 type Config struct {
@@ -50,6 +96,11 @@ type Config struct {
 	ConnMaxLifetime time.Duration
 
 	ExportMetrics bool
+
+	OCCRetry OCCRetryConfig
+
+	// DSQLMaxRowsPerTransaction is the mutation budget of a single Aurora DSQL transaction.
+	DSQLMaxRowsPerTransaction int
 }
 
 // DatastoreOption defines a function type
@@ -174,6 +225,22 @@ func WithMetrics() DatastoreOption {
 	}
 }
 
+// WithOCCRetry returns a DatastoreOption that sets the retry policy
+// for optimistic concurrency control conflicts in the Config.
+func WithOCCRetry(c OCCRetryConfig) DatastoreOption {
+	return func(cfg *Config) {
+		cfg.OCCRetry = c
+	}
+}
+
+// WithDSQLMaxRowsPerTransaction returns a DatastoreOption that sets the
+// maximum number of rows a single Aurora DSQL transaction may modify in the Config.
+func WithDSQLMaxRowsPerTransaction(maxRows int) DatastoreOption {
+	return func(cfg *Config) {
+		cfg.DSQLMaxRowsPerTransaction = maxRows
+	}
+}
+
 // NewConfig creates a new Config instance with default values
 // and applies any provided DatastoreOption modifications.
 func NewConfig(opts ...DatastoreOption) *Config {
@@ -195,6 +262,14 @@ func NewConfig(opts ...DatastoreOption) *Config {
 		cfg.MaxTypesPerModelField = storage.DefaultMaxTypesPerAuthorizationModel
 	}
 
+	if cfg.OCCRetry == (OCCRetryConfig{}) {
+		cfg.OCCRetry = DefaultOCCRetryConfig()
+	}
+
+	if cfg.DSQLMaxRowsPerTransaction == 0 {
+		cfg.DSQLMaxRowsPerTransaction = DefaultDSQLMaxRowsPerTransaction
+	}
+
 	return cfg
 }
 
@@ -304,6 +379,7 @@ var sqlIteratorColumns = []string{
 	"condition_context",
 	"ulid",
 	"inserted_at",
+	"expires_at",
 }
 
 // SQLIteratorColumns returns the columns used in the SQL tuple iterator.
@@ -371,6 +447,7 @@ func (t *SQLTupleIterator) next(ctx context.Context) (*storage.TupleRecord, erro
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 	err := t.rows.Scan(
 		&record.Store,
@@ -382,6 +459,7 @@ func (t *SQLTupleIterator) next(ctx context.Context) (*storage.TupleRecord, erro
 		&conditionContext,
 		&record.Ulid,
 		&record.InsertedAt,
+		&expiresAt,
 	)
 	t.mu.Unlock()
 
@@ -390,6 +468,7 @@ func (t *SQLTupleIterator) next(ctx context.Context) (*storage.TupleRecord, erro
 	}
 
 	record.ConditionName = conditionName.String
+	record.ExpiresAt = expiresAt.Time
 
 	if conditionContext != nil {
 		var conditionContextStruct structpb.Struct
@@ -435,6 +514,7 @@ func (t *SQLTupleIterator) head(ctx context.Context) (*storage.TupleRecord, erro
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 	err := t.rows.Scan(
 		&record.Store,
@@ -446,12 +526,14 @@ func (t *SQLTupleIterator) head(ctx context.Context) (*storage.TupleRecord, erro
 		&conditionContext,
 		&record.Ulid,
 		&record.InsertedAt,
+		&expiresAt,
 	)
 	if err != nil {
 		return nil, t.handleSQLError(err)
 	}
 
 	record.ConditionName = conditionName.String
+	record.ExpiresAt = expiresAt.Time
 
 	if conditionContext != nil {
 		var conditionContextStruct structpb.Struct
@@ -507,6 +589,7 @@ func (t *SQLTupleIterator) Next(ctx context.Context) (*openfgav1.Tuple, error) {
 		return nil, err
 	}
 
+	storage.ObserveTupleExpiry(ctx, record.ExpiresAt)
 	return record.AsTuple(), nil
 }
 
@@ -521,6 +604,7 @@ func (t *SQLTupleIterator) Head(ctx context.Context) (*openfgav1.Tuple, error) {
 		return nil, err
 	}
 
+	storage.ObserveTupleExpiry(ctx, record.ExpiresAt)
 	return record.AsTuple(), nil
 }
 
@@ -709,45 +793,144 @@ func BuildDSQLDeleteUsing(store string, deleteConditions sq.Or) (string, []inter
 }
 
 // selectExistingRowsForWrite selects existing rows for the given keys and locks them FOR UPDATE.
-// The existing rows are added to the existing map.
-func selectExistingRowsForWrite(ctx context.Context, dbInfo *DBInfo, store string, keys []TupleLockKey, txn *sql.Tx, existing map[string]*openfgav1.Tuple) error {
+// The existing rows are added to the existing map, or to the expired map if they expired at now.
+func selectExistingRowsForWrite(ctx context.Context, dbInfo *DBInfo, store string, keys []TupleLockKey, txn *sql.Tx, now time.Time, existing, expired map[string]*openfgav1.Tuple) error {
 	inExpr, args := BuildRowConstructorIN(keys)
 
-	selectBuilder := dbInfo.stbl.
-		Select(SQLIteratorColumns()...).
+	rows, err := dbInfo.stbl.
+		Select(ExistingRowsColumns()...).
 		From("tuple").
 		Where(sq.Eq{"store": store}).
 		// Row-constructor IN on full composite key for precise point locks.
 		Where(sq.Expr("(object_type, object_id, relation, _user, user_type) IN "+inExpr, args...)).
 		Suffix("FOR UPDATE").
-		RunWith(txn) // make sure to run in the same transaction
+		RunWith(txn). // make sure to run in the same transaction
+		QueryContext(ctx)
+	if err != nil {
+		return dbInfo.HandleSQLError(err)
+	}
+	defer rows.Close()
+
+	if err := ScanExistingRows(rows, now, existing, expired); err != nil {
+		return dbInfo.HandleSQLError(err)
+	}
+	return nil
+}
+
+// existingRowsColumns are the columns of the rows selected by a write, see [ScanExistingRows].
+var existingRowsColumns = []string{
+	"object_type",
+	"object_id",
+	"relation",
+	"_user",
+	"condition_name",
+	"condition_context",
+	"expires_at",
+}
 
-	iter := NewSQLTupleIterator(NewSBIteratorQuery(selectBuilder), dbInfo.HandleSQLError)
-	defer iter.Stop()
+// ExistingRowsColumns returns the columns to select the existing rows of a write with, so that
+// they can be read by [ScanExistingRows].
+func ExistingRowsColumns() []string {
+	return existingRowsColumns
+}
 
-	items, _, err := iter.ToArray(ctx, storage.PaginationOptions{PageSize: len(keys)})
+// ScanExistingRows reads the existing rows of a write, selected with [ExistingRowsColumns], into
+// the existing map, or into the expired map for those that expired at now. Both maps are keyed by
+// [tupleUtils.TupleKeyToString].
+func ScanExistingRows(rows Rows, now time.Time, existing, expired map[string]*openfgav1.Tuple) error {
+	for rows.Next() {
+		var (
+			objectType, objectID, relation, user string
+			conditionName                        sql.NullString
+			conditionContext                     []byte
+			expiresAt                            sql.NullTime
+		)
+		err := rows.Scan(&objectType, &objectID, &relation, &user, &conditionName, &conditionContext, &expiresAt)
+		if err != nil {
+			return err
+		}
 
-	if err != nil {
-		return err
+		var conditionContextStruct *structpb.Struct
+		if conditionContext != nil {
+			conditionContextStruct = &structpb.Struct{}
+			if err := proto.Unmarshal(conditionContext, conditionContextStruct); err != nil {
+				return err
+			}
+		}
+
+		tuple := &openfgav1.Tuple{Key: tupleUtils.NewTupleKeyWithCondition(
+			tupleUtils.BuildObject(objectType, objectID), relation, user, conditionName.String, conditionContextStruct,
+		)}
+		if expiresAt.Valid && storage.IsExpired(expiresAt.Time, now) {
+			expired[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+		} else {
+			existing[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+		}
 	}
-	for _, tuple := range items {
-		existing[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+	return rows.Err()
+}
+
+// NotExpired returns the condition selecting the tuples that did not expire at now.
+func NotExpired(now time.Time) sq.Sqlizer {
+	return sq.Or{sq.Eq{"expires_at": nil}, sq.Gt{"expires_at": now.UTC()}}
+}
+
+// ExpiresAtValue returns the value of the expires_at column of the tuples written with opts.
+func ExpiresAtValue(opts storage.TupleWriteOptions) interface{} {
+	if opts.ExpiresAt.IsZero() {
+		return nil
 	}
-	return nil
+	return opts.ExpiresAt.UTC()
 }
 
 // GetDeleteWriteChangelogItems constructs the delete conditions, write items, and changelog items.
+// The expired rows, which behave as if they were deleted, are deleted first, so that the write
+// can replace them.
 func GetDeleteWriteChangelogItems(
 	store string,
 	existing map[string]*openfgav1.Tuple,
+	expired map[string]*openfgav1.Tuple,
 	writeData WriteData) (sq.Or, [][]interface{}, [][]interface{}, error) {
-	changeLogItems := make([][]interface{}, 0, len(writeData.Deletes)+len(writeData.Writes))
+	changeLogItems := make([][]interface{}, 0, len(expired)+len(writeData.Deletes)+len(writeData.Writes))
 
 	// ensures increasingly unique values within a single thread
 	entropy := ulid.DefaultEntropy()
 
 	deleteConditions := sq.Or{}
 
+	expiredKeys := make([]string, 0, len(expired))
+	for key := range expired {
+		expiredKeys = append(expiredKeys, key)
+	}
+	sort.Strings(expiredKeys)
+	for _, key := range expiredKeys {
+		tk := expired[key].GetKey()
+		id := ulid.MustNew(ulid.Timestamp(writeData.Now), entropy).String()
+		objectType, objectID := tupleUtils.SplitObject(tk.GetObject())
+
+		deleteConditions = append(deleteConditions, sq.Eq{
+			"object_type": objectType,
+			"object_id":   objectID,
+			"relation":    tk.GetRelation(),
+			"_user":       tk.GetUser(),
+			"user_type":   tupleUtils.GetUserTypeFromUser(tk.GetUser()),
+		})
+
+		changeLogItems = append(changeLogItems, []interface{}{
+			store,
+			objectType,
+			objectID,
+			tk.GetRelation(),
+			tk.GetUser(),
+			"",
+			nil,
+			openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+			id,
+			sq.Expr("NOW()"),
+			nil,
+		})
+	}
+
 	// 1. For Deletes
 	// a. If on_missing: error ( default behavior ):
 	// - Execute DELETEs as a single statement.
@@ -796,6 +979,7 @@ func GetDeleteWriteChangelogItems(
 			openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
 			id,
 			sq.Expr("NOW()"),
+			nil,
 		})
 	}
 
@@ -852,6 +1036,7 @@ func GetDeleteWriteChangelogItems(
 			conditionContext,
 			id,
 			sq.Expr("NOW()"),
+			ExpiresAtValue(writeData.Opts),
 		})
 
 		changeLogItems = append(changeLogItems, []interface{}{
@@ -865,6 +1050,7 @@ func GetDeleteWriteChangelogItems(
 			openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
 			id,
 			sq.Expr("NOW()"),
+			ExpiresAtValue(writeData.Opts),
 		})
 	}
 	return deleteConditions, writeItems, changeLogItems, nil
@@ -902,6 +1088,7 @@ func Write(
 	}
 
 	existing := make(map[string]*openfgav1.Tuple, total)
+	expired := make(map[string]*openfgav1.Tuple)
 
 	// 3. If list compiled in step 2 is not empty, execute SELECT … FOR UPDATE statement
 
@@ -912,13 +1099,13 @@ func Write(
 		}
 		keys := lockKeys[start:end]
 
-		if err := selectExistingRowsForWrite(ctx, dbInfo, store, keys, txn, existing); err != nil {
+		if err := selectExistingRowsForWrite(ctx, dbInfo, store, keys, txn, writeData.Now, existing, expired); err != nil {
 			return err
 		}
 	}
 
 	// 4. Construct the deleteConditions, write and changelog items to be written
-	deleteConditions, writeItems, changeLogItems, err := GetDeleteWriteChangelogItems(store, existing, writeData)
+	deleteConditions, writeItems, changeLogItems, err := GetDeleteWriteChangelogItems(store, existing, expired, writeData)
 	if err != nil {
 		return err
 	}
@@ -971,6 +1158,7 @@ func Write(
 				"condition_context",
 				"ulid",
 				"inserted_at",
+				"expires_at",
 			)
 
 		for _, item := range writesBatch {
@@ -991,45 +1179,256 @@ func Write(
 	}
 
 	// 5. Execute INSERT changelog statements
-	for start, totalItems := 0, len(changeLogItems); start < totalItems; start += storage.DefaultMaxTuplesPerWrite {
-		end := start + storage.DefaultMaxTuplesPerWrite
-		if end > totalItems {
-			end = totalItems
-		}
+	if err := insertChanges(ctx, dbInfo, txn, changeLogItems); err != nil {
+		return err
+	}
 
-		changeLogBatch := changeLogItems[start:end]
+	// 6. Commit Transaction
+	if err := txn.Commit(); err != nil {
+		return dbInfo.HandleSQLError(err)
+	}
 
+	return nil
+}
+
+// insertChanges inserts the changelog items in txn.
+func insertChanges(ctx context.Context, dbInfo *DBInfo, txn *sql.Tx, changeLogItems [][]interface{}) error {
+	return insertChangelogRows(ctx, dbInfo, txn, []string{"_user"}, changeLogItems, storage.DefaultMaxTuplesPerWrite)
+}
+
+// insertChangelogRows inserts the changelog items in txn, whose user is held by userColumns, in
+// batches of rowsPerStatement.
+func insertChangelogRows(ctx context.Context, dbInfo *DBInfo, txn *sql.Tx, userColumns []string, changeLogItems [][]interface{}, rowsPerStatement int) error {
+	columns := slices.Concat(
+		[]string{"store", "object_type", "object_id", "relation"},
+		userColumns,
+		[]string{"condition_name", "condition_context", "operation", "ulid", "inserted_at", "expires_at"},
+	)
+	for start := 0; start < len(changeLogItems); start += rowsPerStatement {
 		changelogBuilder := dbInfo.stbl.
 			Insert("changelog").
-			Columns(
-				"store",
-				"object_type",
-				"object_id",
-				"relation",
-				"_user",
-				"condition_name",
-				"condition_context",
-				"operation",
-				"ulid",
-				"inserted_at",
-			)
+			Columns(columns...)
 
-		for _, item := range changeLogBatch {
+		for _, item := range changeLogItems[start:min(start+rowsPerStatement, len(changeLogItems))] {
 			changelogBuilder = changelogBuilder.Values(item...)
 		}
 
-		_, err = changelogBuilder.RunWith(txn).ExecContext(ctx) // Part of a txn.
+		_, err := changelogBuilder.RunWith(txn).ExecContext(ctx) // Part of a txn.
 		if err != nil {
 			return dbInfo.HandleSQLError(err)
 		}
 	}
+	return nil
+}
 
-	// 6. Commit Transaction
-	if err := txn.Commit(); err != nil {
-		return dbInfo.HandleSQLError(err)
+// BulkLoadRowsPerStatement is the maximum number of rows selected or inserted by each statement
+// of a bulk load, which keeps the statements below the placeholder limits of the SQL engines.
+const BulkLoadRowsPerStatement = 1000
+
+// BulkLoadSchema holds the parts of [BulkLoad] that differ between engines. Its zero value suits
+// the engines whose tables hold the user of a tuple in the _user column.
+type BulkLoadSchema struct {
+	// UserColumns are the columns of the tuple and changelog tables holding the user of a tuple,
+	// and UserValues returns their values for a user. Default to _user.
+	UserColumns []string
+	UserValues  func(user string) []interface{}
+	// Now is the expression of the time of the load in the inserted_at column. Defaults to NOW().
+	Now string
+	// SelectExistingRows selects the rows of tuples in store into existing, or into expired for
+	// those that expired at now, within txn, see [ScanExistingRows]. Defaults to selecting them
+	// with SELECT ... FOR UPDATE.
+	SelectExistingRows func(ctx context.Context, txn *sql.Tx, store string, tuples []*openfgav1.TupleKey, now time.Time, existing, expired map[string]*openfgav1.Tuple) error
+	// Retry runs fn, which begins or commits the transaction of the load, until it succeeds or
+	// fails for good. Defaults to running fn once.
+	Retry func(fn func() error) error
+}
+
+// withDefaults returns the schema with its unset fields set to their defaults.
+func (schema BulkLoadSchema) withDefaults(dbInfo *DBInfo) BulkLoadSchema {
+	if schema.UserColumns == nil {
+		schema.UserColumns = []string{"_user"}
+		schema.UserValues = func(user string) []interface{} {
+			return []interface{}{user}
+		}
+	}
+	if schema.Now == "" {
+		schema.Now = "NOW()"
+	}
+	if schema.SelectExistingRows == nil {
+		schema.SelectExistingRows = func(ctx context.Context, txn *sql.Tx, store string, tuples []*openfgav1.TupleKey, now time.Time, existing, expired map[string]*openfgav1.Tuple) error {
+			lockKeys := MakeTupleLockKeys(nil, tuples)
+			for start := 0; start < len(lockKeys); start += BulkLoadRowsPerStatement {
+				keys := lockKeys[start:min(start+BulkLoadRowsPerStatement, len(lockKeys))]
+				if err := selectExistingRowsForWrite(ctx, dbInfo, store, keys, txn, now, existing, expired); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if schema.Retry == nil {
+		schema.Retry = func(fn func() error) error {
+			return fn()
+		}
 	}
+	return schema
+}
 
-	return nil
+// BulkLoad provides the common method for bulk loading tuples across sql storage, see
+// [storage.BulkLoader]. The tuples are written in a single transaction, with multi-row inserts.
+// Like a write, the load deletes the expired tuples that it loads before inserting them again.
+func BulkLoad(
+	ctx context.Context,
+	dbInfo *DBInfo,
+	db *sql.DB,
+	schema BulkLoadSchema,
+	store string,
+	tuples []*openfgav1.TupleKey,
+	options storage.BulkLoadOptions,
+) (int, error) {
+	schema = schema.withDefaults(dbInfo)
+
+	var txn *sql.Tx
+	err := schema.Retry(func() error {
+		var err error
+		txn, err = db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+		return err
+	})
+	if err != nil {
+		return 0, dbInfo.HandleSQLError(err)
+	}
+	defer func() { _ = txn.Rollback() }()
+
+	now := time.Now().UTC()
+	existing := make(map[string]*openfgav1.Tuple)
+	expired := make(map[string]*openfgav1.Tuple)
+	if err := schema.SelectExistingRows(ctx, txn, store, tuples, now, existing, expired); err != nil {
+		return 0, err
+	}
+
+	// The tuples and their changes start with the same columns, the key of the tuple.
+	keyColumns := slices.Concat([]string{"store", "object_type", "object_id", "relation"}, schema.UserColumns)
+	tupleKey := func(tk *openfgav1.TupleKey) []interface{} {
+		objectType, objectID := tupleUtils.SplitObject(tk.GetObject())
+		return slices.Concat([]interface{}{store, objectType, objectID, tk.GetRelation()}, schema.UserValues(tk.GetUser()))
+	}
+
+	entropy := ulid.DefaultEntropy()
+	deleteConditions := sq.Or{}
+	changeLogItems := make([][]interface{}, 0, len(expired)+len(tuples))
+	for _, key := range slices.Sorted(maps.Keys(expired)) {
+		tk := expired[key].GetKey()
+		deleteCondition := sq.Eq{"user_type": tupleUtils.GetUserTypeFromUser(tk.GetUser())}
+		for i, value := range tupleKey(tk) {
+			deleteCondition[keyColumns[i]] = value
+		}
+		deleteConditions = append(deleteConditions, deleteCondition)
+
+		if !options.SkipChangelog {
+			id := ulid.MustNew(ulid.Timestamp(now), entropy).String()
+			changeLogItems = append(changeLogItems, slices.Concat(tupleKey(tk), []interface{}{
+				"",
+				nil, // Redact condition info for Deletes since we only need the base triplet (object, relation, user).
+				openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+				id,
+				sq.Expr(schema.Now),
+				nil,
+			}))
+		}
+	}
+
+	writeItems := make([][]interface{}, 0, len(tuples))
+	for _, tk := range tuples {
+		key := tupleUtils.TupleKeyToString(tk)
+		if _, ok := existing[key]; ok {
+			continue
+		}
+		existing[key] = &openfgav1.Tuple{Key: tk}
+
+		id := ulid.MustNew(ulid.Timestamp(now), entropy).String()
+		conditionName, conditionContext, err := MarshalRelationshipCondition(tk.GetCondition())
+		if err != nil {
+			return 0, err
+		}
+
+		writeItems = append(writeItems, slices.Concat(tupleKey(tk), []interface{}{
+			tupleUtils.GetUserTypeFromUser(tk.GetUser()),
+			conditionName,
+			conditionContext,
+			id,
+			sq.Expr(schema.Now),
+		}))
+		if !options.SkipChangelog {
+			changeLogItems = append(changeLogItems, slices.Concat(tupleKey(tk), []interface{}{
+				conditionName,
+				conditionContext,
+				openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
+				id,
+				sq.Expr(schema.Now),
+				nil, // The loaded tuples never expire.
+			}))
+		}
+	}
+
+	for start := 0; start < len(deleteConditions); start += BulkLoadRowsPerStatement {
+		deleteConditionsBatch := deleteConditions[start:min(start+BulkLoadRowsPerStatement, len(deleteConditions))]
+		res, err := dbInfo.stbl.Delete("tuple").Where(sq.Eq{"store": store}).
+			Where(deleteConditionsBatch).
+			RunWith(txn). // Part of a txn.
+			ExecContext(ctx)
+		if err != nil {
+			return 0, dbInfo.HandleSQLError(err)
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return 0, dbInfo.HandleSQLError(err)
+		}
+		if rowsAffected != int64(len(deleteConditionsBatch)) {
+			// Someone else deleted the same row(s) since they were selected.
+			return 0, storage.ErrWriteConflictOnDelete
+		}
+	}
+
+	tupleColumns := slices.Concat(keyColumns, []string{"user_type", "condition_name", "condition_context", "ulid", "inserted_at"})
+	for start := 0; start < len(writeItems); start += BulkLoadRowsPerStatement {
+		insertBuilder := dbInfo.stbl.
+			Insert("tuple").
+			Columns(tupleColumns...)
+		for _, item := range writeItems[start:min(start+BulkLoadRowsPerStatement, len(writeItems))] {
+			insertBuilder = insertBuilder.Values(item...)
+		}
+
+		_, err = insertBuilder.RunWith(txn).ExecContext(ctx) // Part of a txn.
+		if err != nil {
+			dberr := dbInfo.HandleSQLError(err)
+			if errors.Is(dberr, storage.ErrCollision) {
+				// Someone else inserted the same row(s) since they were selected.
+				return 0, storage.ErrWriteConflictOnInsert
+			}
+			return 0, dberr
+		}
+	}
+
+	if err := insertChangelogRows(ctx, dbInfo, txn, schema.UserColumns, changeLogItems, BulkLoadRowsPerStatement); err != nil {
+		return 0, err
+	}
+
+	if options.SkipChangelog && (len(writeItems) > 0 || len(deleteConditions) > 0) {
+		// The changelog misses the load, so the changes before it no longer tell the state of the store.
+		horizon := ulid.MustNew(ulid.Timestamp(now), entropy).String()
+		if err := AdvanceChangelogHorizon(ctx, dbInfo, txn, store, horizon); err != nil {
+			return 0, err
+		}
+	}
+
+	err = schema.Retry(func() error {
+		return txn.Commit()
+	})
+	if err != nil {
+		return 0, dbInfo.HandleSQLError(err)
+	}
+	return len(writeItems), nil
 }
 
 // WriteAuthorizationModel writes an authorization model for the given store in one row.
@@ -1183,26 +1582,39 @@ func ReadAuthorizationModel(
 	return ret, nil
 }
 
-// IsVersionReady checks if the database schema revision is at least the minimum supported revision.
-// The passed in context should have a timeout.
-func IsVersionReady(ctx context.Context, skipVersionCheck bool, db *sql.DB) (storage.ReadinessStatus, error) {
+// IsVersionReady checks if the database schema revision is at least minRevision, the minimum
+// supported revision of the engine. The passed in context should have a timeout.
+func IsVersionReady(ctx context.Context, skipVersionCheck bool, db *sql.DB, minRevision int64) (storage.ReadinessStatus, error) {
+	return IsSchemaRevisionReady(ctx, skipVersionCheck, db, minRevision, func(version int64) (int64, error) {
+		return version, nil
+	})
+}
+
+// IsSchemaRevisionReady is like [IsVersionReady] for engines whose migration versions do not
+// match the schema revisions; revisionOf converts the goose version into the schema revision.
+func IsSchemaRevisionReady(ctx context.Context, skipVersionCheck bool, db *sql.DB, minRevision int64, revisionOf func(version int64) (int64, error)) (storage.ReadinessStatus, error) {
 	if skipVersionCheck {
 		return storage.ReadinessStatus{
 			IsReady: true,
 		}, nil
 	}
 
-	revision, err := goose.GetDBVersionContext(ctx, db)
+	version, err := goose.GetDBVersionContext(ctx, db)
 	if err != nil {
 		return storage.ReadinessStatus{}, err
 	}
 
-	if revision < build.MinimumSupportedDatastoreSchemaRevision {
+	revision, err := revisionOf(version)
+	if err != nil {
+		return storage.ReadinessStatus{}, err
+	}
+
+	if revision < minRevision {
 		return storage.ReadinessStatus{
 			Message: "datastore requires migrations: at revision '" +
 				strconv.FormatInt(revision, 10) +
 				"', but requires '" +
-				strconv.FormatInt(build.MinimumSupportedDatastoreSchemaRevision, 10) +
+				strconv.FormatInt(minRevision, 10) +
 				"'. Run 'openfga migrate'.",
 			IsReady: false,
 		}, nil
@@ -1213,8 +1625,8 @@ func IsVersionReady(ctx context.Context, skipVersionCheck bool, db *sql.DB) (sto
 }
 
 // IsReady returns true if connection to datastore is successful AND
-// (the datastore has the latest migration applied OR skipVersionCheck).
-func IsReady(ctx context.Context, skipVersionCheck bool, db *sql.DB) (storage.ReadinessStatus, error) {
+// (the datastore is at least at the schema revision minRevision OR skipVersionCheck).
+func IsReady(ctx context.Context, skipVersionCheck bool, db *sql.DB, minRevision int64) (storage.ReadinessStatus, error) {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
 
@@ -1223,7 +1635,7 @@ func IsReady(ctx context.Context, skipVersionCheck bool, db *sql.DB) (storage.Re
 	if pingErr := db.PingContext(ctx); pingErr != nil {
 		return storage.ReadinessStatus{}, pingErr
 	}
-	return IsVersionReady(ctx, skipVersionCheck, db)
+	return IsVersionReady(ctx, skipVersionCheck, db, minRevision)
 }
 
 func AddFromUlid(sb sq.SelectBuilder, fromUlid string, sortDescending bool) sq.SelectBuilder {
@@ -1232,3 +1644,418 @@ func AddFromUlid(sb sq.SelectBuilder, fromUlid string, sortDescending bool) sq.S
 	}
 	return sb.Where(sq.Gt{"ulid": fromUlid})
 }
+
+// ReadChangelogHorizon returns the retention horizon of the changelog of store, or an empty string
+// if it was never pruned, see [storage.ChangelogPruner].
+func ReadChangelogHorizon(ctx context.Context, dbInfo *DBInfo, store string) (string, error) {
+	var horizon sql.NullString
+	err := dbInfo.stbl.
+		Select("changelog_horizon").
+		From("store").
+		Where(sq.Eq{"id": store}).
+		QueryRowContext(ctx).
+		Scan(&horizon)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", dbInfo.HandleSQLError(err)
+	}
+	return horizon.String, nil
+}
+
+// ChangelogHorizonUpdate returns the statement, built with builder, setting the retention horizon
+// of the changelog of store to horizon unless it is already at or after it. Reading changes from
+// before horizon then fails with [storage.ErrChangelogTruncated].
+func ChangelogHorizonUpdate(builder sq.StatementBuilderType, store, horizon string) sq.UpdateBuilder {
+	return builder.
+		Update("store").
+		Set("changelog_horizon", horizon).
+		Where(sq.Eq{"id": store}).
+		Where(sq.Or{sq.Eq{"changelog_horizon": nil}, sq.Lt{"changelog_horizon": horizon}})
+}
+
+// AdvanceChangelogHorizon executes the [ChangelogHorizonUpdate] of store and horizon in txn, or on
+// its own if txn is nil.
+func AdvanceChangelogHorizon(ctx context.Context, dbInfo *DBInfo, txn *sql.Tx, store, horizon string) error {
+	ub := ChangelogHorizonUpdate(dbInfo.stbl, store, horizon)
+	if txn != nil {
+		ub = ub.RunWith(txn)
+	}
+	if _, err := ub.ExecContext(ctx); err != nil {
+		return dbInfo.HandleSQLError(err)
+	}
+	return nil
+}
+
+// PruneChanges deletes the changes of store beyond the retention policy of options, in batches of
+// options.BatchSize changes, see [storage.ChangelogPruner].PruneChanges.
+func PruneChanges(ctx context.Context, dbInfo *DBInfo, store string, options storage.PruneChangesOptions) (int, error) {
+	horizon, err := changelogPruneHorizon(ctx, dbInfo, store, options)
+	if err != nil || horizon == "" {
+		return 0, err
+	}
+
+	// Record the horizon first, so that readers never miss pruned changes without an error.
+	if err := AdvanceChangelogHorizon(ctx, dbInfo, nil, store, horizon); err != nil {
+		return 0, err
+	}
+
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = storage.DefaultPruneChangesBatchSize
+	}
+
+	deleted := 0
+	for {
+		var bound string
+		err := dbInfo.stbl.
+			Select("ulid").
+			From("changelog").
+			Where(sq.Eq{"store": store}).
+			Where(sq.LtOrEq{"ulid": horizon}).
+			OrderBy("ulid asc").
+			Limit(1).
+			Offset(uint64(batchSize - 1)).
+			QueryRowContext(ctx).
+			Scan(&bound)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return deleted, dbInfo.HandleSQLError(err)
+			}
+			bound = horizon
+		}
+
+		res, err := dbInfo.stbl.
+			Delete("changelog").
+			Where(sq.Eq{"store": store}).
+			Where(sq.LtOrEq{"ulid": bound}).
+			ExecContext(ctx)
+		if err != nil {
+			return deleted, dbInfo.HandleSQLError(err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return deleted, dbInfo.HandleSQLError(err)
+		}
+		deleted += int(rowsAffected)
+
+		if bound == horizon {
+			return deleted, nil
+		}
+	}
+}
+
+// changelogPruneHorizon returns the ULID of the latest change of store beyond the retention policy
+// of options, or an empty string if there is none.
+func changelogPruneHorizon(ctx context.Context, dbInfo *DBInfo, store string, options storage.PruneChangesOptions) (string, error) {
+	var horizon string
+	if cutoff := storage.ChangelogPruneCutoff(time.Now(), options.MaxAge); cutoff != "" {
+		err := dbInfo.stbl.
+			Select("ulid").
+			From("changelog").
+			Where(sq.Eq{"store": store}).
+			Where(sq.Lt{"ulid": cutoff}).
+			OrderBy("ulid desc").
+			Limit(1).
+			QueryRowContext(ctx).
+			Scan(&horizon)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", dbInfo.HandleSQLError(err)
+		}
+	}
+
+	if options.MaxChanges > 0 {
+		var beyondMaxChanges string
+		err := dbInfo.stbl.
+			Select("ulid").
+			From("changelog").
+			Where(sq.Eq{"store": store}).
+			OrderBy("ulid desc").
+			Limit(1).
+			Offset(uint64(options.MaxChanges)).
+			QueryRowContext(ctx).
+			Scan(&beyondMaxChanges)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", dbInfo.HandleSQLError(err)
+		}
+		horizon = max(horizon, beyondMaxChanges)
+	}
+	return horizon, nil
+}
+
+// DeleteExpiredTuples deletes up to limit tuples, of any store, that expired at or before now, and
+// records their deletion in the changelog, see [storage.TupleExpirer].DeleteExpiredTuples.
+func DeleteExpiredTuples(ctx context.Context, dbInfo *DBInfo, db *sql.DB, now time.Time, limit int) (int, error) {
+	if limit <= 0 {
+		limit = storage.DefaultDeleteExpiredTuplesBatchSize
+	}
+
+	txn, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return 0, dbInfo.HandleSQLError(err)
+	}
+	defer func() { _ = txn.Rollback() }()
+
+	rows, err := dbInfo.stbl.
+		Select("store", "object_type", "object_id", "relation", "_user").
+		From("tuple").
+		Where(sq.LtOrEq{"expires_at": now.UTC()}).
+		OrderBy("expires_at").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE").
+		RunWith(txn).
+		QueryContext(ctx)
+	if err != nil {
+		return 0, dbInfo.HandleSQLError(err)
+	}
+
+	entropy := ulid.DefaultEntropy()
+	var (
+		deleteConditions sq.Or
+		changeLogItems   [][]interface{}
+	)
+	for rows.Next() {
+		var store, objectType, objectID, relation, user string
+		if err := rows.Scan(&store, &objectType, &objectID, &relation, &user); err != nil {
+			_ = rows.Close()
+			return 0, dbInfo.HandleSQLError(err)
+		}
+
+		deleteConditions = append(deleteConditions, sq.Eq{
+			"store":       store,
+			"object_type": objectType,
+			"object_id":   objectID,
+			"relation":    relation,
+			"_user":       user,
+			"user_type":   tupleUtils.GetUserTypeFromUser(user),
+		})
+		changeLogItems = append(changeLogItems, []interface{}{
+			store,
+			objectType,
+			objectID,
+			relation,
+			user,
+			"",
+			nil,
+			openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+			ulid.MustNew(ulid.Timestamp(time.Now()), entropy).String(),
+			sq.Expr("NOW()"),
+			nil,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return 0, dbInfo.HandleSQLError(err)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, dbInfo.HandleSQLError(err)
+	}
+	if len(deleteConditions) == 0 {
+		return 0, nil
+	}
+
+	for start := 0; start < len(deleteConditions); start += storage.DefaultMaxTuplesPerWrite {
+		end := min(start+storage.DefaultMaxTuplesPerWrite, len(deleteConditions))
+
+		_, err := dbInfo.stbl.
+			Delete("tuple").
+			Where(deleteConditions[start:end]).
+			RunWith(txn).
+			ExecContext(ctx)
+		if err != nil {
+			return 0, dbInfo.HandleSQLError(err)
+		}
+	}
+
+	if err := insertChanges(ctx, dbInfo, txn, changeLogItems); err != nil {
+		return 0, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, dbInfo.HandleSQLError(err)
+	}
+	return len(deleteConditions), nil
+}
+
+// CopyStoreSchema holds the parts of the statements of [CopyStore] that differ between engines.
+type CopyStoreSchema struct {
+	// AuthorizationModelColumns are the columns of the authorization_model table copied as they
+	// are, every column but store and authorization_model_id.
+	AuthorizationModelColumns []string
+	// TupleColumns are the columns of the tuple table copied as they are, every column but store
+	// and ulid.
+	TupleColumns []string
+	// TupleUlid is the expression of the ULID of a copied tuple. Its two arguments are the prefix
+	// of the ULIDs of the copy and the number of tuples copied before the batch, to which it adds
+	// the position of the tuple in the batch (ROW_NUMBER() OVER (ORDER BY ulid)) zero-padded to
+	// ten digits.
+	TupleUlid string
+	// TargetStore is the expression of the store of a copied row, whose argument is the ID of the
+	// target store. Defaults to "?".
+	TargetStore string
+}
+
+// CopyStoreExecutor executes the statements of [CopyStoreWith], built with the placeholder format
+// of the engine.
+type CopyStoreExecutor struct {
+	// Builder builds the statements.
+	Builder sq.StatementBuilderType
+	// SelectKey executes sb and returns the key it selects, or an empty string if it selects no row.
+	SelectKey func(ctx context.Context, sb sq.SelectBuilder) (string, error)
+	// InsertRows executes the INSERT ... SELECT ib copying a batch into the store target, and
+	// returns the number of rows it inserted.
+	InsertRows func(ctx context.Context, target string, ib sq.InsertBuilder) (int, error)
+	// UpdateStore executes ub updating the store target.
+	UpdateStore func(ctx context.Context, target string, ub sq.UpdateBuilder) error
+}
+
+// copyStoreExecutor returns the [CopyStoreExecutor] of the engines using database/sql.
+func (dbInfo *DBInfo) copyStoreExecutor() CopyStoreExecutor {
+	return CopyStoreExecutor{
+		Builder: dbInfo.stbl,
+		SelectKey: func(ctx context.Context, sb sq.SelectBuilder) (string, error) {
+			var key string
+			err := sb.QueryRowContext(ctx).Scan(&key)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return "", dbInfo.HandleSQLError(err)
+			}
+			return key, nil
+		},
+		InsertRows: func(ctx context.Context, _ string, ib sq.InsertBuilder) (int, error) {
+			res, err := ib.ExecContext(ctx)
+			if err != nil {
+				return 0, dbInfo.HandleSQLError(err)
+			}
+			rowsAffected, err := res.RowsAffected()
+			if err != nil {
+				return 0, dbInfo.HandleSQLError(err)
+			}
+			return int(rowsAffected), nil
+		},
+		UpdateStore: func(ctx context.Context, _ string, ub sq.UpdateBuilder) error {
+			if _, err := ub.ExecContext(ctx); err != nil {
+				return dbInfo.HandleSQLError(err)
+			}
+			return nil
+		},
+	}
+}
+
+// CopyStore copies the authorization models, the assertions and the tuples of store source into
+// store target, in batches of options.BatchSize rows, see [storage.StoreCloner].CloneStore. Each
+// batch is copied by an INSERT ... SELECT statement. The retention horizon of the changelog of
+// target is then set to the end of the copy.
+func CopyStore(ctx context.Context, dbInfo *DBInfo, schema CopyStoreSchema, source, target string, options storage.CloneStoreOptions) error {
+	return CopyStoreWith(ctx, dbInfo.copyStoreExecutor(), schema, source, target, options)
+}
+
+// CopyStoreWith is [CopyStore] for the engines not using database/sql, whose statements are
+// executed by exec.
+func CopyStoreWith(ctx context.Context, exec CopyStoreExecutor, schema CopyStoreSchema, source, target string, options storage.CloneStoreOptions) error {
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = storage.DefaultCloneStoreBatchSize
+	}
+
+	c := &storeCopy{
+		exec:        exec,
+		source:      source,
+		target:      target,
+		targetStore: schema.TargetStore,
+		batchSize:   batchSize,
+	}
+	if c.targetStore == "" {
+		c.targetStore = "?"
+	}
+
+	_, err := c.copyRows(ctx, "authorization_model", "authorization_model_id", nil, schema.AuthorizationModelColumns, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.copyRows(ctx, "assertion", "authorization_model_id", nil, []string{"assertions"}, nil)
+	if err != nil {
+		return err
+	}
+
+	// The copied tuples share a ULID prefix, unique to the copy, and are numbered in the order of
+	// the ULIDs of source so that they are paginated in the same order.
+	prefix := ulid.Make().String()[:16]
+	_, err = c.copyRows(ctx, "tuple", "ulid", func(copied int) sq.Sqlizer {
+		return sq.Expr(schema.TupleUlid, prefix, copied)
+	}, schema.TupleColumns, options.OnProgress)
+	if err != nil {
+		return err
+	}
+
+	// target has no changes from before the copy, so its state back then cannot be reconstructed.
+	return exec.UpdateStore(ctx, target, ChangelogHorizonUpdate(exec.Builder, target, ulid.Make().String()))
+}
+
+// storeCopy copies the rows of store source to store target.
+type storeCopy struct {
+	exec           CopyStoreExecutor
+	source, target string
+	targetStore    string
+	batchSize      int
+}
+
+// copyRows copies the rows of table, in batches of about batchSize rows ordered by key. The rows
+// sharing a key are copied in the same batch. The key of a copied row is the expression returned
+// by keyExpr, given the number of rows copied before its batch, or the key of the original row if
+// keyExpr is nil. It calls onProgress, if set, with the number of rows copied so far after every
+// batch, and returns that number.
+func (c *storeCopy) copyRows(
+	ctx context.Context,
+	table, key string,
+	keyExpr func(copied int) sq.Sqlizer,
+	columns []string,
+	onProgress func(int),
+) (int, error) {
+	copied := 0
+	from := ""
+	for {
+		bound, err := c.exec.SelectKey(ctx, c.exec.Builder.
+			Select(key).
+			From(table).
+			Where(sq.Eq{"store": c.source}).
+			Where(sq.Gt{key: from}).
+			OrderBy(key+" asc").
+			Limit(1).
+			Offset(uint64(c.batchSize-1)))
+		if err != nil {
+			return copied, err
+		}
+
+		var copiedKey sq.Sqlizer = sq.Expr(key)
+		if keyExpr != nil {
+			copiedKey = keyExpr(copied)
+		}
+		// The nested select keeps the default placeholders, which the insert replaces.
+		sb := sq.Select().
+			Column(sq.Expr(c.targetStore, c.target)).
+			Column(copiedKey).
+			Columns(columns...).
+			From(table).
+			Where(sq.Eq{"store": c.source}).
+			Where(sq.Gt{key: from})
+		if bound != "" {
+			sb = sb.Where(sq.LtOrEq{key: bound})
+		}
+
+		inserted, err := c.exec.InsertRows(ctx, c.target, c.exec.Builder.
+			Insert(table).
+			Columns(append([]string{"store", key}, columns...)...).
+			Select(sb))
+		if err != nil {
+			return copied, err
+		}
+		copied += inserted
+		if onProgress != nil {
+			onProgress(copied)
+		}
+
+		if bound == "" {
+			return copied, nil
+		}
+		from = bound
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -24,6 +26,7 @@ import (
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
+	"github.com/openfga/openfga/internal/build"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/storage/sqlcommon"
@@ -36,12 +39,6 @@ func startTrace(ctx context.Context, name string) (context.Context, trace.Span)
 	return tracer.Start(ctx, "sqlite."+name)
 }
 
-var tupleColumns = []string{
-	"store", "object_type", "object_id", "relation",
-	"user_object_type", "user_object_id", "user_relation",
-	"condition_name", "condition_context", "ulid", "inserted_at",
-}
-
 // Datastore provides a SQLite based implementation of [storage.OpenFGADatastore].
 type Datastore struct {
 	stbl                   sq.StatementBuilderType
@@ -57,6 +54,25 @@ type Datastore struct {
 // Ensures that SQLite implements the OpenFGADatastore interface.
 var _ storage.OpenFGADatastore = (*Datastore)(nil)
 
+// Ensures that Datastore implements the ChangelogPruner interface.
+var _ storage.ChangelogPruner = (*Datastore)(nil)
+
+// Ensures that Datastore implements the TupleExpirer interface.
+var _ storage.TupleExpirer = (*Datastore)(nil)
+
+// Ensures that Datastore implements the StoreCloner interface.
+var _ storage.StoreCloner = (*Datastore)(nil)
+
+// Ensures that Datastore implements the BulkLoader interface.
+var _ storage.BulkLoader = (*Datastore)(nil)
+
+// copyStoreSchema see [sqlcommon.CopyStoreSchema].
+var copyStoreSchema = sqlcommon.CopyStoreSchema{
+	AuthorizationModelColumns: []string{"schema_version", "serialized_protobuf"},
+	TupleColumns:              []string{"object_type", "object_id", "relation", "user_object_type", "user_object_id", "user_relation", "user_type", "inserted_at", "condition_name", "condition_context", "expires_at"},
+	TupleUlid:                 "? || printf('%010d', ? + ROW_NUMBER() OVER (ORDER BY ulid))",
+}
+
 // PrepareDSN Prepare a raw DSN from config for use with SQLite, specifying defaults for journal mode and busy timeout.
 func PrepareDSN(uri string) (string, error) {
 	// Set journal mode and busy timeout pragmas if not specified.
@@ -182,10 +198,11 @@ func (s *Datastore) read(ctx context.Context, store string, filter storage.ReadF
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"user_object_type", "user_object_id", "user_relation",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
-		Where(sq.Eq{"store": store})
+		Where(sq.Eq{"store": store}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 	if options != nil {
 		sb = sb.OrderBy("ulid")
 	}
@@ -357,28 +374,67 @@ func buildRowConstructorIN(keys []tupleLockKey) (string, []interface{}) {
 }
 
 // selectExistingRowsForWrite selects existing rows for the given keys and locks them FOR UPDATE.
-// The existing rows are added to the existing map.
-func (s *Datastore) selectExistingRowsForWrite(ctx context.Context, store string, keys []tupleLockKey, txn *sql.Tx, existing map[string]*openfgav1.Tuple) error {
+// The existing rows are added to the existing map, or to the expired map if they expired at now.
+func (s *Datastore) selectExistingRowsForWrite(ctx context.Context, store string, keys []tupleLockKey, txn *sql.Tx, now time.Time, existing, expired map[string]*openfgav1.Tuple) error {
 	inExpr, args := buildRowConstructorIN(keys)
 
-	selectBuilder := s.stbl.
-		Select(tupleColumns...).
+	rows, err := s.stbl.
+		Select(
+			"object_type", "object_id", "relation",
+			"user_object_type", "user_object_id", "user_relation",
+			"condition_name", "condition_context", "expires_at",
+		).
 		Where(sq.Eq{"store": store}).
 		From("tuple").
 		// Row-constructor IN on full composite key for precise point locks.
 		Where(sq.Expr("(object_type, object_id, relation, user_object_type, user_object_id, user_relation, user_type) IN "+inExpr, args...)).
-		RunWith(txn) // make sure to run in the same transaction
+		RunWith(txn). // make sure to run in the same transaction
+		QueryContext(ctx)
+	if err != nil {
+		return HandleSQLError(err)
+	}
+	defer rows.Close()
 
-	iter := NewSQLTupleIterator(selectBuilder, HandleSQLError)
-	defer iter.Stop()
+	for rows.Next() {
+		var (
+			record           storage.TupleRecord
+			conditionName    sql.NullString
+			conditionContext []byte
+			expiresAt        sql.NullTime
+		)
+		err := rows.Scan(
+			&record.ObjectType,
+			&record.ObjectID,
+			&record.Relation,
+			&record.UserObjectType,
+			&record.UserObjectID,
+			&record.UserRelation,
+			&conditionName,
+			&conditionContext,
+			&expiresAt,
+		)
+		if err != nil {
+			return HandleSQLError(err)
+		}
 
-	items, _, err := iter.ToArray(ctx, storage.PaginationOptions{PageSize: len(keys)})
+		record.ConditionName = conditionName.String
+		if conditionContext != nil {
+			var conditionContextStruct structpb.Struct
+			if err := proto.Unmarshal(conditionContext, &conditionContextStruct); err != nil {
+				return err
+			}
+			record.ConditionContext = &conditionContextStruct
+		}
 
-	if err != nil {
-		return err
+		tuple := record.AsTuple()
+		if expiresAt.Valid && storage.IsExpired(expiresAt.Time, now) {
+			expired[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+		} else {
+			existing[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+		}
 	}
-	for _, tuple := range items {
-		existing[tupleUtils.TupleKeyToString(tuple.GetKey())] = tuple
+	if err := rows.Err(); err != nil {
+		return HandleSQLError(err)
 	}
 	return nil
 }
@@ -416,6 +472,7 @@ func (s *Datastore) write(
 	}
 
 	existing := make(map[string]*openfgav1.Tuple, total)
+	expired := make(map[string]*openfgav1.Tuple)
 
 	// 3. If list compiled in step 2 is not empty, execute SELECT … FOR UPDATE statement
 
@@ -426,18 +483,28 @@ func (s *Datastore) write(
 		}
 		keys := lockKeys[start:end]
 
-		if err = s.selectExistingRowsForWrite(ctx, store, keys, txn, existing); err != nil {
+		if err = s.selectExistingRowsForWrite(ctx, store, keys, txn, now, existing, expired); err != nil {
 			return err
 		}
 	}
 
-	changeLogItems := make([][]interface{}, 0, len(deletes)+len(writes))
+	changeLogItems := make([][]interface{}, 0, len(expired)+len(deletes)+len(writes))
 
 	// ensures increasingly unique values within a single thread
 	entropy := ulid.DefaultEntropy()
 
 	deleteConditions := sq.Or{}
 
+	// The expired rows behave as if they were deleted, so they are deleted first, so that the
+	// write can replace them.
+	for _, key := range slices.Sorted(maps.Keys(expired)) {
+		tk := expired[key].GetKey()
+		id := ulid.MustNew(ulid.Timestamp(now), entropy).String()
+		deleteCondition, changeLogItem := expiredTupleItems(store, tk, id)
+		deleteConditions = append(deleteConditions, deleteCondition)
+		changeLogItems = append(changeLogItems, changeLogItem)
+	}
+
 	// 4. For deletes
 	// a. If on_missing: error ( default behavior ):
 	// - Execute DELETEs as a single statement.
@@ -491,6 +558,7 @@ func (s *Datastore) write(
 			openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
 			id,
 			sq.Expr("datetime('subsec')"),
+			nil,
 		})
 	}
 
@@ -550,6 +618,7 @@ func (s *Datastore) write(
 			conditionContext,
 			id,
 			sq.Expr("datetime('subsec')"),
+			sqlcommon.ExpiresAtValue(opts),
 		})
 
 		changeLogItems = append(changeLogItems, []interface{}{
@@ -565,6 +634,7 @@ func (s *Datastore) write(
 			openfgav1.TupleOperation_TUPLE_OPERATION_WRITE,
 			id,
 			sq.Expr("datetime('subsec')"),
+			sqlcommon.ExpiresAtValue(opts),
 		})
 	}
 
@@ -618,6 +688,7 @@ func (s *Datastore) write(
 				"condition_context",
 				"ulid",
 				"inserted_at",
+				"expires_at",
 			)
 
 		for _, item := range writesBatch {
@@ -638,6 +709,22 @@ func (s *Datastore) write(
 	}
 
 	// 6. Execute INSERT changelog statements
+	if err := s.insertChanges(ctx, txn, changeLogItems); err != nil {
+		return err
+	}
+
+	err = busyRetry(func() error {
+		return txn.Commit()
+	})
+	if err != nil {
+		return HandleSQLError(err)
+	}
+
+	return nil
+}
+
+// insertChanges inserts the changelog items in txn.
+func (s *Datastore) insertChanges(ctx context.Context, txn *sql.Tx, changeLogItems [][]interface{}) error {
 	for start, totalItems := 0, len(changeLogItems); start < totalItems; start += storage.DefaultMaxTuplesPerWrite {
 		end := start + storage.DefaultMaxTuplesPerWrite
 		if end > totalItems {
@@ -661,28 +748,55 @@ func (s *Datastore) write(
 				"operation",
 				"ulid",
 				"inserted_at",
+				"expires_at",
 			)
 
 		for _, item := range changeLogBatch {
 			changelogBuilder = changelogBuilder.Values(item...)
 		}
 
-		_, err = changelogBuilder.RunWith(txn).ExecContext(ctx) // Part of a txn.
+		_, err := changelogBuilder.RunWith(txn).ExecContext(ctx) // Part of a txn.
 		if err != nil {
 			return HandleSQLError(err)
 		}
 	}
-
-	err = busyRetry(func() error {
-		return txn.Commit()
-	})
-	if err != nil {
-		return HandleSQLError(err)
-	}
-
 	return nil
 }
 
+// expiredTupleItems returns the condition deleting the expired tuple tk of store, and the
+// changelog item recording its deletion with the given ULID.
+func expiredTupleItems(store string, tk *openfgav1.TupleKey, id string) (sq.Eq, []interface{}) {
+	objectType, objectID := tupleUtils.SplitObject(tk.GetObject())
+	userObjectType, userObjectID, userRelation := tupleUtils.ToUserParts(tk.GetUser())
+
+	deleteCondition := sq.Eq{
+		"store":            store,
+		"object_type":      objectType,
+		"object_id":        objectID,
+		"relation":         tk.GetRelation(),
+		"user_object_type": userObjectType,
+		"user_object_id":   userObjectID,
+		"user_relation":    userRelation,
+		"user_type":        tupleUtils.GetUserTypeFromUser(tk.GetUser()),
+	}
+	changeLogItem := []interface{}{
+		store,
+		objectType,
+		objectID,
+		tk.GetRelation(),
+		userObjectType,
+		userObjectID,
+		userRelation,
+		"",
+		nil, // Redact condition info for deletes since we only need the base triplet (object, relation, user).
+		openfgav1.TupleOperation_TUPLE_OPERATION_DELETE,
+		id,
+		sq.Expr("datetime('subsec')"),
+		nil,
+	}
+	return deleteCondition, changeLogItem
+}
+
 // ReadUserTuple see [storage.RelationshipTupleReader].ReadUserTuple.
 func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter storage.ReadUserTupleFilter, _ storage.ReadUserTupleOptions) (*openfgav1.Tuple, error) {
 	ctx, span := startTrace(ctx, "ReadUserTuple")
@@ -694,13 +808,14 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 
 	sb := s.stbl.
 		Select(
 			"object_type", "object_id", "relation",
 			"user_object_type", "user_object_id", "user_relation",
-			"condition_name", "condition_context",
+			"condition_name", "condition_context", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{
@@ -712,7 +827,8 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 			"user_object_id":   userObjectID,
 			"user_relation":    userRelation,
 			"user_type":        userType,
-		})
+		}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 
 	if len(filter.Conditions) > 0 {
 		sb = sb.Where(sq.Eq{"COALESCE(condition_name, '')": filter.Conditions})
@@ -728,6 +844,7 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 			&record.UserRelation,
 			&conditionName,
 			&conditionContext,
+			&expiresAt,
 		)
 	if err != nil {
 		return nil, HandleSQLError(err)
@@ -745,6 +862,7 @@ func (s *Datastore) ReadUserTuple(ctx context.Context, store string, filter stor
 		}
 	}
 
+	storage.ObserveTupleExpiry(ctx, expiresAt.Time)
 	return record.AsTuple(), nil
 }
 
@@ -762,11 +880,12 @@ func (s *Datastore) ReadUsersetTuples(
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"user_object_type", "user_object_id", "user_relation",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{"store": store}).
-		Where(sq.Eq{"user_type": tupleUtils.UserSet})
+		Where(sq.Eq{"user_type": tupleUtils.UserSet}).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now())))
 
 	objectType, objectID := tupleUtils.SplitObject(filter.Object)
 	if objectType != "" {
@@ -831,7 +950,7 @@ func (s *Datastore) ReadStartingWithUser(
 		Select(
 			"store", "object_type", "object_id", "relation",
 			"user_object_type", "user_object_id", "user_relation",
-			"condition_name", "condition_context", "ulid", "inserted_at",
+			"condition_name", "condition_context", "ulid", "inserted_at", "expires_at",
 		).
 		From("tuple").
 		Where(sq.Eq{
@@ -839,11 +958,16 @@ func (s *Datastore) ReadStartingWithUser(
 			"object_type": filter.ObjectType,
 			"relation":    filter.Relation,
 		}).
-		Where(targetUsersArg).OrderBy("object_id")
+		Where(targetUsersArg).
+		Where(sqlcommon.NotExpired(storage.TupleExpiryTime(ctx, time.Now()))).
+		OrderBy("object_id")
 
 	if filter.ObjectIDs != nil && filter.ObjectIDs.Size() > 0 {
 		builder = builder.Where(sq.Eq{"object_id": filter.ObjectIDs.Values()})
 	}
+	if filter.AfterObjectID != "" {
+		builder = builder.Where(sq.Gt{"object_id": filter.AfterObjectID})
+	}
 
 	if len(filter.Conditions) > 0 {
 		builder = builder.Where(sq.Eq{"COALESCE(condition_name, '')": filter.Conditions})
@@ -1223,6 +1347,16 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 	ctx, span := startTrace(ctx, "ReadChanges")
 	defer span.End()
 
+	if storage.ChecksChangelogHorizon(options) {
+		horizon, err := sqlcommon.ReadChangelogHorizon(ctx, s.dbInfo, store)
+		if err != nil {
+			return nil, "", err
+		}
+		if storage.IsBeforeChangelogHorizon(options, horizon) {
+			return nil, "", storage.ErrChangelogTruncated
+		}
+	}
+
 	objectTypeFilter := filter.ObjectType
 	horizonOffset := filter.HorizonOffset
 
@@ -1236,7 +1370,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			"ulid", "object_type", "object_id", "relation",
 			"user_object_type", "user_object_id", "user_relation",
 			"operation",
-			"condition_name", "condition_context", "inserted_at",
+			"condition_name", "condition_context", "inserted_at", "expires_at",
 		).
 		From("changelog").
 		Where(sq.Eq{"store": store}).
@@ -1265,6 +1399,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 		var objectType, objectID, relation, userObjectType, userObjectID, userRelation string
 		var operation int
 		var insertedAt time.Time
+		var expiresAt sql.NullTime
 		var conditionName sql.NullString
 		var conditionContext []byte
 
@@ -1280,6 +1415,7 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			&conditionName,
 			&conditionContext,
 			&insertedAt,
+			&expiresAt,
 		)
 		if err != nil {
 			return nil, "", HandleSQLError(err)
@@ -1302,11 +1438,13 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 			&conditionContextStruct,
 		)
 
-		changes = append(changes, &openfgav1.TupleChange{
+		change := &openfgav1.TupleChange{
 			TupleKey:  tk,
 			Operation: openfgav1.TupleOperation(operation),
 			Timestamp: timestamppb.New(insertedAt.UTC()),
-		})
+		}
+		storage.ObserveChangeExpiry(ctx, change, expiresAt.Time)
+		changes = append(changes, change)
 	}
 
 	if len(changes) == 0 {
@@ -1316,9 +1454,171 @@ func (s *Datastore) ReadChanges(ctx context.Context, store string, filter storag
 	return changes, ulid, nil
 }
 
+// PruneChanges see [storage.ChangelogPruner].PruneChanges.
+func (s *Datastore) PruneChanges(ctx context.Context, store string, options storage.PruneChangesOptions) (int, error) {
+	ctx, span := startTrace(ctx, "PruneChanges")
+	defer span.End()
+
+	var deleted int
+	err := busyRetry(func() error {
+		n, err := sqlcommon.PruneChanges(ctx, s.dbInfo, store, options)
+		deleted += n
+		return err
+	})
+	return deleted, err
+}
+
+// CloneStore see [storage.StoreCloner].CloneStore.
+func (s *Datastore) CloneStore(ctx context.Context, source string, target *openfgav1.Store, options storage.CloneStoreOptions) (*openfgav1.Store, error) {
+	ctx, span := startTrace(ctx, "CloneStore")
+	defer span.End()
+
+	if _, err := s.GetStore(ctx, source); err != nil {
+		return nil, err
+	}
+	store, err := s.CreateStore(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sqlcommon.CopyStore(ctx, s.dbInfo, copyStoreSchema, source, store.GetId(), options); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// BulkLoad see [storage.BulkLoader].BulkLoad. The tuples are written in a single transaction, with
+// multi-row inserts.
+func (s *Datastore) BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options storage.BulkLoadOptions) (int, error) {
+	ctx, span := startTrace(ctx, "BulkLoad")
+	defer span.End()
+
+	return sqlcommon.BulkLoad(ctx, s.dbInfo, s.db, sqlcommon.BulkLoadSchema{
+		UserColumns: []string{"user_object_type", "user_object_id", "user_relation"},
+		UserValues: func(user string) []interface{} {
+			userObjectType, userObjectID, userRelation := tupleUtils.ToUserParts(user)
+			return []interface{}{userObjectType, userObjectID, userRelation}
+		},
+		Now: "datetime('subsec')",
+		SelectExistingRows: func(ctx context.Context, txn *sql.Tx, store string, tuples []*openfgav1.TupleKey, now time.Time, existing, expired map[string]*openfgav1.Tuple) error {
+			lockKeys := makeTupleLockKeys(nil, tuples)
+			for start := 0; start < len(lockKeys); start += sqlcommon.BulkLoadRowsPerStatement {
+				keys := lockKeys[start:min(start+sqlcommon.BulkLoadRowsPerStatement, len(lockKeys))]
+				if err := s.selectExistingRowsForWrite(ctx, store, keys, txn, now, existing, expired); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Retry: busyRetry,
+	}, store, tuples, options)
+}
+
+// DeleteExpiredTuples see [storage.TupleExpirer].DeleteExpiredTuples.
+func (s *Datastore) DeleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	ctx, span := startTrace(ctx, "DeleteExpiredTuples")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = storage.DefaultDeleteExpiredTuplesBatchSize
+	}
+
+	var deleted int
+	err := busyRetry(func() error {
+		var err error
+		deleted, err = s.deleteExpiredTuples(ctx, now, limit)
+		return err
+	})
+	return deleted, err
+}
+
+func (s *Datastore) deleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error) {
+	txn, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+	defer func() {
+		_ = txn.Rollback()
+	}()
+
+	rows, err := s.stbl.
+		Select(
+			"store", "object_type", "object_id", "relation",
+			"user_object_type", "user_object_id", "user_relation",
+		).
+		From("tuple").
+		Where(sq.LtOrEq{"expires_at": now.UTC()}).
+		OrderBy("expires_at").
+		Limit(uint64(limit)).
+		RunWith(txn).
+		QueryContext(ctx)
+	if err != nil {
+		return 0, HandleSQLError(err)
+	}
+
+	entropy := ulid.DefaultEntropy()
+	var (
+		deleteConditions sq.Or
+		changeLogItems   [][]interface{}
+	)
+	for rows.Next() {
+		var record storage.TupleRecord
+		err := rows.Scan(
+			&record.Store,
+			&record.ObjectType,
+			&record.ObjectID,
+			&record.Relation,
+			&record.UserObjectType,
+			&record.UserObjectID,
+			&record.UserRelation,
+		)
+		if err != nil {
+			_ = rows.Close()
+			return 0, HandleSQLError(err)
+		}
+
+		id := ulid.MustNew(ulid.Timestamp(time.Now()), entropy).String()
+		deleteCondition, changeLogItem := expiredTupleItems(record.Store, record.AsTuple().GetKey(), id)
+		deleteConditions = append(deleteConditions, deleteCondition)
+		changeLogItems = append(changeLogItems, changeLogItem)
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return 0, HandleSQLError(err)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	if len(deleteConditions) == 0 {
+		return 0, nil
+	}
+
+	for start := 0; start < len(deleteConditions); start += storage.DefaultMaxTuplesPerWrite {
+		end := min(start+storage.DefaultMaxTuplesPerWrite, len(deleteConditions))
+
+		_, err := s.stbl.
+			Delete("tuple").
+			Where(deleteConditions[start:end]).
+			RunWith(txn).
+			ExecContext(ctx)
+		if err != nil {
+			return 0, HandleSQLError(err)
+		}
+	}
+
+	if err := s.insertChanges(ctx, txn, changeLogItems); err != nil {
+		return 0, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, HandleSQLError(err)
+	}
+	return len(deleteConditions), nil
+}
+
 // IsReady see [sqlcommon.IsReady].
 func (s *Datastore) IsReady(ctx context.Context) (storage.ReadinessStatus, error) {
-	versionReady, err := sqlcommon.IsReady(ctx, s.versionReady, s.db)
+	versionReady, err := sqlcommon.IsReady(ctx, s.versionReady, s.db, build.MinimumSupportedSQLiteSchemaRevision)
 	if err != nil {
 		return versionReady, err
 	}
@@ -95,6 +95,7 @@ func (t *SQLTupleIterator) next(ctx context.Context) (*storage.TupleRecord, erro
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 	err := t.rows.Scan(
 		&record.Store,
@@ -108,6 +109,7 @@ func (t *SQLTupleIterator) next(ctx context.Context) (*storage.TupleRecord, erro
 		&conditionContext,
 		&record.Ulid,
 		&record.InsertedAt,
+		&expiresAt,
 	)
 	t.mu.Unlock()
 
@@ -116,6 +118,7 @@ func (t *SQLTupleIterator) next(ctx context.Context) (*storage.TupleRecord, erro
 	}
 
 	record.ConditionName = conditionName.String
+	record.ExpiresAt = expiresAt.Time
 
 	if conditionContext != nil {
 		var conditionContextStruct structpb.Struct
@@ -161,6 +164,7 @@ func (t *SQLTupleIterator) head(ctx context.Context) (*storage.TupleRecord, erro
 
 	var conditionName sql.NullString
 	var conditionContext []byte
+	var expiresAt sql.NullTime
 	var record storage.TupleRecord
 	err := t.rows.Scan(
 		&record.Store,
@@ -174,12 +178,14 @@ func (t *SQLTupleIterator) head(ctx context.Context) (*storage.TupleRecord, erro
 		&conditionContext,
 		&record.Ulid,
 		&record.InsertedAt,
+		&expiresAt,
 	)
 	if err != nil {
 		return nil, t.handleSQLError(err)
 	}
 
 	record.ConditionName = conditionName.String
+	record.ExpiresAt = expiresAt.Time
 
 	if conditionContext != nil {
 		var conditionContextStruct structpb.Struct
@@ -236,6 +242,7 @@ func (t *SQLTupleIterator) Next(ctx context.Context) (*openfgav1.Tuple, error) {
 		return nil, err
 	}
 
+	storage.ObserveTupleExpiry(ctx, record.ExpiresAt)
 	return record.AsTuple(), nil
 }
 
@@ -250,6 +257,7 @@ func (t *SQLTupleIterator) Head(ctx context.Context) (*openfgav1.Tuple, error) {
 		return nil, err
 	}
 
+	storage.ObserveTupleExpiry(ctx, record.ExpiresAt)
 	return record.AsTuple(), nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/oklog/ulid/v2"
+
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 )
 
@@ -28,6 +30,18 @@ const (
 	// value is set to 50, balancing detail per page with the overall number of pages.
 	DefaultPageSize = 50
 
+	// DefaultPruneChangesBatchSize sets the default maximum number of changes deleted at once by
+	// [ChangelogPruner.PruneChanges], so that pruning a large changelog does not hold long locks.
+	DefaultPruneChangesBatchSize = 1000
+
+	// DefaultDeleteExpiredTuplesBatchSize sets the default maximum number of tuples deleted at once
+	// by [TupleExpirer.DeleteExpiredTuples].
+	DefaultDeleteExpiredTuplesBatchSize = 1000
+
+	// DefaultCloneStoreBatchSize sets the default maximum number of rows copied at once by
+	// [StoreCloner.CloneStore].
+	DefaultCloneStoreBatchSize = 1000
+
 	relationshipTupleReaderCtxKey ctxKey = "relationship-tuple-reader-context-key"
 )
 
@@ -95,6 +109,10 @@ type ListStoresOptions struct {
 type ReadChangesOptions struct {
 	Pagination PaginationOptions
 	SortDesc   bool
+	// CheckHorizon makes ReadChanges fail with ErrChangelogTruncated when reading from before the
+	// retention horizon of the store, at the cost of reading the horizon. It is only needed when
+	// the changelog is pruned, see [ChangelogPruner].
+	CheckHorizon bool
 }
 
 // ReadPageOptions represents the options that can
@@ -241,6 +259,19 @@ const (
 type TupleWriteOptions struct {
 	OnMissingDelete   OnMissingDelete
 	OnDuplicateInsert OnDuplicateInsert
+
+	// NonTransactional allows the datastore to split a write that does not fit in a single
+	// transaction of the underlying engine into several consecutive transactions. Deletes are
+	// still applied before writes, but a failure may leave the earlier transactions committed.
+	// It is meant for trusted internal callers (e.g. import tools) that can retry the whole
+	// write, and is ignored by datastores without per-transaction limits.
+	NonTransactional bool
+
+	// ExpiresAt is the time at which the written tuples expire, or the zero time if they never
+	// expire. From then on, reads ignore the tuples as if they were deleted, until they are
+	// deleted for good by [TupleExpirer.DeleteExpiredTuples] or by a later write of the same
+	// tuples, which replaces them.
+	ExpiresAt time.Time
 }
 
 type TupleWriteOption func(*TupleWriteOptions)
@@ -257,6 +288,22 @@ func WithOnDuplicateInsert(onDuplicateInsert OnDuplicateInsert) TupleWriteOption
 	}
 }
 
+// WithNonTransactionalWrite allows the datastore to commit a large write in several transactions.
+// See [TupleWriteOptions.NonTransactional].
+func WithNonTransactionalWrite() TupleWriteOption {
+	return func(opts *TupleWriteOptions) {
+		opts.NonTransactional = true
+	}
+}
+
+// WithExpiresAt sets the time at which the written tuples expire.
+// See [TupleWriteOptions.ExpiresAt].
+func WithExpiresAt(expiresAt time.Time) TupleWriteOption {
+	return func(opts *TupleWriteOptions) {
+		opts.ExpiresAt = expiresAt
+	}
+}
+
 func NewTupleWriteOptions(opts ...TupleWriteOption) TupleWriteOptions {
 	res := TupleWriteOptions{
 		OnMissingDelete:   OnMissingDeleteError,
@@ -300,6 +347,10 @@ type ReadStartingWithUserFilter struct {
 
 	// Optional. It can be nil. If present, it will be used to filter the results. Conditions can hold the empty value
 	Conditions []string
+
+	// Optional. If present, only the tuples with an object ID greater than it are returned, so that
+	// a read sorted by object ID can resume after the last object of a previous one.
+	AfterObjectID string
 }
 
 // ReadFilter specifies the filter options that will be used
@@ -404,6 +455,114 @@ type ChangelogBackend interface {
 	ReadChanges(ctx context.Context, store string, filter ReadChangesFilter, options ReadChangesOptions) ([]*openfgav1.TupleChange, string, error)
 }
 
+// PruneChangesOptions represents the retention policy applied by [ChangelogPruner.PruneChanges].
+// The changes beyond any of its limits are pruned.
+type PruneChangesOptions struct {
+	// MaxAge prunes the changes that occurred longer than MaxAge ago, if positive.
+	MaxAge time.Duration
+	// MaxChanges prunes the changes older than the latest MaxChanges ones, if positive.
+	MaxChanges int
+	// BatchSize is the maximum number of changes deleted at once. If not positive,
+	// DefaultPruneChangesBatchSize is used.
+	BatchSize int
+}
+
+// ChangelogPruner is implemented by the datastores whose changelog can be pruned.
+type ChangelogPruner interface {
+	// PruneChanges deletes the changes of a store beyond the retention policy of options, and
+	// returns the number of deleted changes.
+	// The ULID of the latest deleted change becomes the retention horizon of the store, which is
+	// recorded before deleting any change. From then on, ReadChanges must return ErrChangelogTruncated
+	// when reading with CheckHorizon in ascending order from a ULID before the horizon, see
+	// [IsBeforeChangelogHorizon].
+	PruneChanges(ctx context.Context, store string, options PruneChangesOptions) (int, error)
+}
+
+// ChecksChangelogHorizon reports whether reading changes with options requires the retention horizon
+// of the store, see [IsBeforeChangelogHorizon].
+func ChecksChangelogHorizon(options ReadChangesOptions) bool {
+	return options.CheckHorizon && options.Pagination.From != "" && !options.SortDesc
+}
+
+// IsBeforeChangelogHorizon reports whether reading changes with options would skip changes pruned
+// up to horizon, the retention horizon of the store, if any.
+func IsBeforeChangelogHorizon(options ReadChangesOptions, horizon string) bool {
+	return horizon != "" && ChecksChangelogHorizon(options) && options.Pagination.From < horizon
+}
+
+// ChangelogPruneCutoff returns the ULID before which changes are beyond maxAge at now, or an empty
+// string if no change can be, e.g. when maxAge is not positive.
+func ChangelogPruneCutoff(now time.Time, maxAge time.Duration) string {
+	if maxAge <= 0 {
+		return ""
+	}
+	cutoff, err := ulid.New(ulid.Timestamp(now.Add(-maxAge)), nil)
+	if err != nil {
+		return ""
+	}
+	return cutoff.String()
+}
+
+// TupleExpirer is implemented by the datastores that delete the tuples written with an expiry, see
+// [WithExpiresAt].
+type TupleExpirer interface {
+	// DeleteExpiredTuples deletes up to limit tuples, of any store, that expired at or before now,
+	// and returns the number of deleted tuples. Each deleted tuple is recorded in the changelog of
+	// its store as a TUPLE_OPERATION_DELETE change. If limit is not positive,
+	// DefaultDeleteExpiredTuplesBatchSize is used. Fewer than limit tuples may be deleted even
+	// though more expired, so callers deleting all of them call it until it deletes none.
+	DeleteExpiredTuples(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+// CloneStoreOptions represents the options of [StoreCloner.CloneStore].
+type CloneStoreOptions struct {
+	// BatchSize is the maximum number of rows copied at once. If not positive,
+	// DefaultCloneStoreBatchSize is used.
+	BatchSize int
+	// OnProgress, if set, is called with the number of tuples copied so far after every batch.
+	OnProgress func(copiedTuples int)
+}
+
+// StoreCloner is implemented by the datastores that copy a store without reading it into OpenFGA.
+type StoreCloner interface {
+	// CloneStore creates the store target, copies into it the authorization models, the assertions
+	// and the tuples of the store source, and returns the created store. It must return ErrNotFound
+	// if source does not exist, and ErrCollision if target already exists.
+	//
+	// The copied tuples keep their condition and expiry, but get new ULIDs. The changelog of source
+	// is not copied, so that target starts with an empty changelog whose retention horizon is the end
+	// of the copy, see [ChangelogPruner]. The copy is made in batches, so writes to source during the
+	// copy may or may not be copied, and target holds the part of source copied so far if it fails.
+	CloneStore(ctx context.Context, source string, target *openfgav1.Store, options CloneStoreOptions) (*openfgav1.Store, error)
+}
+
+// BulkLoadOptions represents the options of [BulkLoader.BulkLoad].
+type BulkLoadOptions struct {
+	// SkipChangelog loads the tuples without recording their writes in the changelog, so that
+	// ReadChanges, Watch and the caches following the changelog do not see them. The changelog
+	// horizon of the store then advances to the load, in the same transaction, since the changes
+	// before it no longer tell the state of the store.
+	SkipChangelog bool
+}
+
+// BulkLoader is implemented by the datastores that load large numbers of tuples faster than Write.
+type BulkLoader interface {
+	// BulkLoad writes tuples to store regardless of MaxTuplesPerWrite, and returns the number of
+	// tuples written. The tuples that already exist in store are skipped, and so are the repeated
+	// tuples, so that loading the same tuples again is a no-op, but the expired tuples are deleted and
+	// written again as a write does. The tuples are not validated, and they never expire.
+	//
+	// The tuples may be written in several transactions, in which case the tuples written before an
+	// error are kept.
+	BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options BulkLoadOptions) (int, error)
+}
+
+// IsExpired reports whether a tuple expiring at expiresAt, the zero time if it never expires, has
+// expired at now.
+func IsExpired(expiresAt, now time.Time) bool {
+	return !expiresAt.IsZero() && !expiresAt.After(now)
+}
+
 // OpenFGADatastore is an interface that defines a set of methods for interacting
 // with and managing data in an OpenFGA (Fine-Grained Authorization) system.
 type OpenFGADatastore interface {
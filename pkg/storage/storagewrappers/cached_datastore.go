@@ -249,21 +249,15 @@ func (c *CachedDatastore) Read(
 		tupleKey.GetRelation())
 }
 
-func isInvalidAt(cache storage.InMemoryCache[any], ts time.Time, invalidStore string, invalidEntityKeys []string) bool {
-	if res := cache.Get(invalidStore); res != nil {
-		invalidEntry, ok := res.(*storage.InvalidEntityCacheEntry)
-		// if the invalid entity is not valid, do not discard
-		if ok && ts.Before(invalidEntry.LastModified) {
-			return true
-		}
-	}
-
-	for _, invalidEntityKey := range invalidEntityKeys {
-		if res := cache.Get(invalidEntityKey); res != nil {
-			invalidEntry, ok := res.(*storage.InvalidEntityCacheEntry)
-			// if the invalid entity is not valid, do not discard
-			if ok && ts.Before(invalidEntry.LastModified) {
-				return true
+func isInvalidAt(cache storage.InMemoryCache[any], ts time.Time, invalidStoreKeys []string, invalidEntityKeys []string) bool {
+	for _, invalidKeys := range [...][]string{invalidStoreKeys, invalidEntityKeys} {
+		for _, invalidKey := range invalidKeys {
+			if res := cache.Get(invalidKey); res != nil {
+				invalidEntry, ok := res.(*storage.InvalidEntityCacheEntry)
+				// if the invalid entity is not valid, do not discard
+				if ok && ts.Before(invalidEntry.LastModified) {
+					return true
+				}
 			}
 		}
 	}
@@ -273,9 +267,9 @@ func isInvalidAt(cache storage.InMemoryCache[any], ts time.Time, invalidStore st
 // findInCache tries to find a key in the cache.
 // It returns true if and only if:
 // the key is present, and
-// the cache key satisfies TS(key) >= TS(store), and
+// the cache key satisfies TS(key) >= TS(store) for all of the storeKeys, and
 // all of the invalidEntityKeys satisfy TS(key) >= TS(invalid).
-func findInCache(cache storage.InMemoryCache[any], key, storeKey string, invalidEntityKeys []string) (*storage.TupleIteratorCacheEntry, bool) {
+func findInCache(cache storage.InMemoryCache[any], key string, storeKeys []string, invalidEntityKeys []string) (*storage.TupleIteratorCacheEntry, bool) {
 	var tupleEntry *storage.TupleIteratorCacheEntry
 	var ok bool
 
@@ -288,7 +282,7 @@ func findInCache(cache storage.InMemoryCache[any], key, storeKey string, invalid
 		return nil, false
 	}
 
-	invalid := isInvalidAt(cache, tupleEntry.LastModified, storeKey, invalidEntityKeys)
+	invalid := isInvalidAt(cache, tupleEntry.LastModified, storeKeys, invalidEntityKeys)
 	if invalid {
 		cache.Delete(key)
 		return nil, false
@@ -354,14 +348,22 @@ func (c *CachedDatastore) newCachedIterator(
 	span.SetAttributes(attribute.String("cache_key", cacheKey))
 	tuplesCacheTotalCounter.WithLabelValues(operation, c.method).Inc()
 
-	invalidStoreKey := storage.GetInvalidIteratorCacheKey(store)
-	if cacheEntry, ok := findInCache(c.cache, cacheKey, invalidStoreKey, invalidEntityKeys); ok {
+	// Unlike the invalidEntityKeys, these keys are not cleared when the iterator is cached, as they
+	// invalidate other iterators too.
+	invalidStoreKeys := []string{
+		storage.GetInvalidIteratorCacheKey(store),
+		storage.GetInvalidIteratorByObjectTypeCacheKey(store, objectType),
+	}
+	if cacheEntry, ok := findInCache(c.cache, cacheKey, invalidStoreKeys, invalidEntityKeys); ok {
 		tuplesCacheHitCounter.WithLabelValues(operation, c.method).Inc()
 		span.SetAttributes(attribute.Bool("cached", true))
 
 		staticIter := storage.NewStaticIterator[*storage.TupleRecord](cacheEntry.Tuples)
 		currentIteratorCacheCount.WithLabelValues("true").Inc()
 
+		// The expiries of the cached tuples are not kept, the earliest of them stands for them all.
+		storage.ObserveTupleExpiry(ctx, cacheEntry.ExpiresAt)
+
 		return &cachedTupleIterator{
 			objectID:   objectID,
 			objectType: objectType,
@@ -377,8 +379,7 @@ func (c *CachedDatastore) newCachedIterator(
 	}
 
 	currentIteratorCacheCount.WithLabelValues("false").Inc()
-	return &cachedIterator{
-		ctx:       c.ctx,
+	cachedIter := &cachedIterator{
 		iter:      iter,
 		store:     store,
 		operation: operation,
@@ -386,7 +387,7 @@ func (c *CachedDatastore) newCachedIterator(
 		// set an initial fraction capacity to balance constant reallocation and memory usage
 		tuples:            make([]*openfgav1.Tuple, 0, c.maxResultSize/2),
 		cacheKey:          cacheKey,
-		invalidStoreKey:   invalidStoreKey,
+		invalidStoreKeys:  invalidStoreKeys,
 		invalidEntityKeys: invalidEntityKeys,
 		cache:             c.cache,
 		maxResultSize:     c.maxResultSize,
@@ -399,7 +400,9 @@ func (c *CachedDatastore) newCachedIterator(
 		userType:          userType,
 		wg:                c.wg,
 		logger:            c.logger,
-	}, nil
+	}
+	cachedIter.ctx = storage.ContextWithTupleExpiryObserver(c.ctx, cachedIter.expiry.Observe)
+	return cachedIter, nil
 }
 
 type cachedIterator struct {
@@ -409,12 +412,15 @@ type cachedIterator struct {
 	operation         string
 	method            string
 	cacheKey          string
-	invalidStoreKey   string
+	invalidStoreKeys  []string
 	invalidEntityKeys []string
 	cache             storage.InMemoryCache[any]
 	ttl               time.Duration
 	initializedAt     time.Time
 
+	// expiry records the earliest expiry of the tuples read, which the results are not cached past.
+	expiry storage.TupleExpiryRecorder
+
 	objectID   string
 	objectType string
 	relation   string
@@ -460,7 +466,7 @@ func (c *cachedIterator) Next(ctx context.Context) (*openfgav1.Tuple, error) {
 		return nil, storage.ErrIteratorDone
 	}
 
-	t, err := c.iter.Next(ctx)
+	t, err := c.iter.Next(storage.ContextWithTupleExpiryObserver(ctx, c.expiry.Observe))
 	if err != nil {
 		if !storage.IterIsDoneOrCancelled(err) {
 			c.tuples = nil // don't store results that are incomplete
@@ -510,7 +516,7 @@ func (c *cachedIterator) Stop() {
 		defer c.iter.Stop()
 
 		// if cache is already set by another instance, we don't need to drain the iterator
-		_, ok := findInCache(c.cache, c.cacheKey, c.invalidStoreKey, c.invalidEntityKeys)
+		_, ok := findInCache(c.cache, c.cacheKey, c.invalidStoreKeys, c.invalidEntityKeys)
 		if ok {
 			c.iter.Stop()
 			c.tuples = nil
@@ -518,7 +524,7 @@ func (c *cachedIterator) Stop() {
 		}
 
 		// if there was an invalidation _after_ the initialization, it shouldn't be stored
-		if isInvalidAt(c.cache, c.initializedAt, c.invalidStoreKey, c.invalidEntityKeys) {
+		if isInvalidAt(c.cache, c.initializedAt, c.invalidStoreKeys, c.invalidEntityKeys) {
 			c.iter.Stop()
 			c.tuples = nil
 			return
@@ -566,7 +572,7 @@ func (c *cachedIterator) Head(ctx context.Context) (*openfgav1.Tuple, error) {
 		return nil, storage.ErrIteratorDone
 	}
 
-	return c.iter.Head(ctx)
+	return c.iter.Head(storage.ContextWithTupleExpiryObserver(ctx, c.expiry.Observe))
 }
 
 // addToBuffer converts a proto tuple into a simpler storage.TupleRecord, removes
@@ -641,8 +647,15 @@ func (c *cachedIterator) flush() {
 	c.tuples = nil
 	c.records = nil
 
+	expiresAt := c.expiry.Earliest()
+	ttl := storage.TTLUntilExpiry(c.ttl, expiresAt)
+	if ttl <= 0 {
+		c.logger.Debug("cachedIterator flush noop due to expired tuples", zap.String("key", c.cacheKey))
+		return
+	}
+
 	c.logger.Debug("cachedIterator flush and update cache for ", zap.String("cacheKey", c.cacheKey))
-	c.cache.Set(c.cacheKey, &storage.TupleIteratorCacheEntry{Tuples: records, LastModified: time.Now()}, c.ttl)
+	c.cache.Set(c.cacheKey, &storage.TupleIteratorCacheEntry{Tuples: records, LastModified: time.Now(), ExpiresAt: expiresAt}, ttl)
 	for _, k := range c.invalidEntityKeys {
 		c.cache.Delete(k)
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/openfga/openfga/internal/mocks"
 	"github.com/openfga/openfga/pkg/logger"
 	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
 	"github.com/openfga/openfga/pkg/storage/storagewrappers/storagewrappersutil"
 	"github.com/openfga/openfga/pkg/testutils"
 	"github.com/openfga/openfga/pkg/tuple"
@@ -54,7 +55,7 @@ func TestFindInCache(t *testing.T) {
 		gomock.InOrder(
 			mockCache.EXPECT().Get(key).Return(nil),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.False(t, ok)
 	})
 	t.Run("cache_hit_no_invalid", func(t *testing.T) {
@@ -64,14 +65,14 @@ func TestFindInCache(t *testing.T) {
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
 			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.True(t, ok)
 	})
 	t.Run("cache_hit_bad_result", func(t *testing.T) {
 		gomock.InOrder(
 			mockCache.EXPECT().Get(key).Return("invalid"),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.False(t, ok)
 	})
 	t.Run("cache_hit_invalid", func(t *testing.T) {
@@ -82,7 +83,7 @@ func TestFindInCache(t *testing.T) {
 				Return(&storage.InvalidEntityCacheEntry{LastModified: time.Now().Add(5 * time.Second)}),
 			mockCache.EXPECT().Delete(key),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.False(t, ok)
 	})
 	t.Run("cache_hit_stale_invalid", func(t *testing.T) {
@@ -93,7 +94,7 @@ func TestFindInCache(t *testing.T) {
 				Return(&storage.InvalidEntityCacheEntry{LastModified: time.Now().Add(-5 * time.Second)}),
 			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.True(t, ok)
 	})
 	t.Run("cache_hit_invalidation_incorrect_type", func(t *testing.T) {
@@ -104,7 +105,7 @@ func TestFindInCache(t *testing.T) {
 				Return("invalid"),
 			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.True(t, ok)
 	})
 	t.Run("cache_hit_invalid_entity", func(t *testing.T) {
@@ -116,7 +117,7 @@ func TestFindInCache(t *testing.T) {
 				Return(&storage.InvalidEntityCacheEntry{LastModified: time.Now().Add(5 * time.Second)}),
 			mockCache.EXPECT().Delete(key),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.False(t, ok)
 	})
 	t.Run("cache_hit_invalid_entity_stale", func(t *testing.T) {
@@ -127,7 +128,7 @@ func TestFindInCache(t *testing.T) {
 			mockCache.EXPECT().Get(invalidEntityKeys[0]).
 				Return(&storage.InvalidEntityCacheEntry{LastModified: time.Now().Add(-5 * time.Second)}),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.True(t, ok)
 	})
 	t.Run("cache_hit_invalid_entity_stale_invalid", func(t *testing.T) {
@@ -138,7 +139,7 @@ func TestFindInCache(t *testing.T) {
 			mockCache.EXPECT().Get(invalidEntityKeys[0]).
 				Return("invalid"),
 		)
-		_, ok := findInCache(ds.cache, key, storage.GetInvalidIteratorCacheKey(storeID), invalidEntityKeys)
+		_, ok := findInCache(ds.cache, key, []string{storage.GetInvalidIteratorCacheKey(storeID)}, invalidEntityKeys)
 		require.True(t, ok)
 	})
 }
@@ -215,8 +216,9 @@ func TestReadStartingWithUser(t *testing.T) {
 				Return(storage.NewStaticTupleIterator(tuples), nil),
 			mockCache.EXPECT().Get(cacheKey).Return(nil),                                    // find while stopping
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil), // check if store invalidated before writing
-			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil),                        // check if entity invalidated before writing
-			mockCache.EXPECT().Get(invalidEntityKeys[1]).Return(nil),                        // check if entity invalidated before writing
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
+			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil), // check if entity invalidated before writing
+			mockCache.EXPECT().Get(invalidEntityKeys[1]).Return(nil), // check if entity invalidated before writing
 			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), ttl).DoAndReturn(func(k string, entry *storage.TupleIteratorCacheEntry, ttl time.Duration) {
 				if diff := cmp.Diff(cachedTuples, entry.Tuples, cmpOpts...); diff != "" {
 					t.Fatalf("mismatch (-want +got):\n%s", diff)
@@ -259,6 +261,7 @@ func TestReadStartingWithUser(t *testing.T) {
 			gomock.InOrder(
 				mockCache.EXPECT().Get(gomock.Any()).Return(&storage.TupleIteratorCacheEntry{Tuples: cachedTuples}),
 				mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+				mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
 				mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil),
 				mockCache.EXPECT().Get(invalidEntityKeys[1]).Return(nil),
 			)
@@ -301,6 +304,7 @@ func TestReadStartingWithUser(t *testing.T) {
 			gomock.InOrder(
 				mockCache.EXPECT().Get(gomock.Any()).Return(&storage.TupleIteratorCacheEntry{Tuples: cachedTuples}),
 				mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+				mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
 
 				// These should not be found, the cache_controller does not include relations
 				// in the invalidation record keys when calling invalidateIteratorCacheByUserAndObjectType
@@ -341,8 +345,9 @@ func TestReadStartingWithUser(t *testing.T) {
 				Return(storage.NewStaticTupleIterator([]*openfgav1.Tuple{}), nil),
 			mockCache.EXPECT().Get(cacheKey).Return(nil),                                    // find while stopping
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil), // check if store invalidated before writing
-			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil),                        // check if entity invalidated before writing
-			mockCache.EXPECT().Get(invalidEntityKeys[1]).Return(nil),                        // check if entity invalidated before writing
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
+			mockCache.EXPECT().Get(invalidEntityKeys[0]).Return(nil), // check if entity invalidated before writing
+			mockCache.EXPECT().Get(invalidEntityKeys[1]).Return(nil), // check if entity invalidated before writing
 			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), ttl).DoAndReturn(func(k string, entry *storage.TupleIteratorCacheEntry, ttl time.Duration) {
 				require.Empty(t, entry.Tuples)
 			}),
@@ -481,7 +486,8 @@ func TestReadUsersetTuples(t *testing.T) {
 				Return(storage.NewStaticTupleIterator(tuples), nil),
 			mockCache.EXPECT().Get(cacheKey).Return(nil),                                    // find while stopping
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil), // check if store invalidated before writing
-			mockCache.EXPECT().Get(invalidEntityKey).Return(nil),                            // check if entity invalidated before writing
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
+			mockCache.EXPECT().Get(invalidEntityKey).Return(nil), // check if entity invalidated before writing
 			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), ttl).DoAndReturn(func(k string, entry *storage.TupleIteratorCacheEntry, ttl time.Duration) {
 				if diff := cmp.Diff(cachedTuples, entry.Tuples, cmpOpts...); diff != "" {
 					t.Fatalf("mismatch (-want +got):\n%s", diff)
@@ -522,6 +528,7 @@ func TestReadUsersetTuples(t *testing.T) {
 		gomock.InOrder(
 			mockCache.EXPECT().Get(cacheKey).Return(&storage.TupleIteratorCacheEntry{Tuples: cachedTuples}),
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
 			mockCache.EXPECT().Get(invalidEntityKey).Return(nil),
 		)
 
@@ -557,6 +564,7 @@ func TestReadUsersetTuples(t *testing.T) {
 				Return(storage.NewStaticTupleIterator([]*openfgav1.Tuple{}), nil),
 			mockCache.EXPECT().Get(cacheKey).Return(nil),
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "document")).Return(nil),
 			mockCache.EXPECT().Get(invalidEntityKey).Return(nil),
 			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), ttl).DoAndReturn(func(k string, entry *storage.TupleIteratorCacheEntry, ttl time.Duration) {
 				require.Empty(t, entry.Tuples)
@@ -689,6 +697,7 @@ func TestRead(t *testing.T) {
 				Return(storage.NewStaticTupleIterator(tuples), nil),
 			mockCache.EXPECT().Get(cacheKey).Return(nil),
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "license")).Return(nil),
 			mockCache.EXPECT().Get(invalidEntityKey).Return(nil),
 			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), ttl).DoAndReturn(func(k string, entry *storage.TupleIteratorCacheEntry, ttl time.Duration) {
 				if diff := cmp.Diff(cachedTuples, entry.Tuples, cmpOpts...); diff != "" {
@@ -730,6 +739,7 @@ func TestRead(t *testing.T) {
 		gomock.InOrder(
 			mockCache.EXPECT().Get(cacheKey).Return(&storage.TupleIteratorCacheEntry{Tuples: cachedTuples}),
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "license")).Return(nil),
 			mockCache.EXPECT().Get(invalidEntityKey).Return(nil),
 		)
 
@@ -765,6 +775,7 @@ func TestRead(t *testing.T) {
 				Return(storage.NewStaticTupleIterator([]*openfgav1.Tuple{}), nil),
 			mockCache.EXPECT().Get(cacheKey),
 			mockCache.EXPECT().Get(storage.GetInvalidIteratorCacheKey(storeID)).Return(nil),
+			mockCache.EXPECT().Get(storage.GetInvalidIteratorByObjectTypeCacheKey(storeID, "license")).Return(nil),
 			mockCache.EXPECT().Get(invalidEntityKey).Return(nil),
 			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), ttl).DoAndReturn(func(k string, entry *storage.TupleIteratorCacheEntry, ttl time.Duration) {
 				require.Empty(t, entry.Tuples)
@@ -873,6 +884,80 @@ func TestRead(t *testing.T) {
 	})
 }
 
+func TestCachedDatastoreTupleExpiry(t *testing.T) {
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+	ctx := context.Background()
+
+	backend := memory.New()
+	t.Cleanup(backend.Close)
+
+	cache, err := storage.NewInMemoryLRUCache([]storage.InMemoryLRUCacheOpt[any]{
+		storage.WithMaxCacheSize[any](int64(100)),
+	}...)
+	require.NoError(t, err)
+	t.Cleanup(cache.Stop)
+
+	wg := &sync.WaitGroup{}
+	ds := NewCachedDatastore(ctx, backend, cache, 10, 5*time.Hour, &singleflight.Group{}, wg)
+
+	readAll := func(ctx context.Context, storeID string) {
+		iter, err := ds.Read(ctx, storeID, storage.ReadFilter{Object: "document:1", Relation: "viewer"}, storage.ReadOptions{})
+		require.NoError(t, err)
+		for {
+			_, err := iter.Next(ctx)
+			if err != nil {
+				require.ErrorIs(t, err, storage.ErrIteratorDone)
+				break
+			}
+		}
+		iter.Stop()
+		wg.Wait()
+	}
+	cacheKey := func(storeID string) string {
+		return storagewrappersutil.ReadKey(storeID, tuple.NewTupleKey("document:1", "viewer", ""))
+	}
+
+	t.Run("caches_until_the_earliest_expiry", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		expiresAt := time.Now().Add(time.Hour)
+		require.NoError(t, backend.Write(ctx, storeID, nil, []*openfgav1.TupleKey{tuple.NewTupleKey("document:1", "viewer", "user:jon")}))
+		require.NoError(t, backend.Write(ctx, storeID, nil, []*openfgav1.TupleKey{tuple.NewTupleKey("document:1", "viewer", "user:bob")}, storage.WithExpiresAt(expiresAt)))
+		require.NoError(t, backend.Write(ctx, storeID, nil, []*openfgav1.TupleKey{tuple.NewTupleKey("document:1", "viewer", "user:ann")}, storage.WithExpiresAt(expiresAt.Add(time.Hour))))
+
+		readAll(ctx, storeID)
+		entry, ok := cache.Get(cacheKey(storeID)).(*storage.TupleIteratorCacheEntry)
+		require.True(t, ok)
+		require.Len(t, entry.Tuples, 3)
+		require.Equal(t, expiresAt, entry.ExpiresAt)
+
+		// A cache hit reports the expiry too.
+		var recorder storage.TupleExpiryRecorder
+		readAll(storage.ContextWithTupleExpiryObserver(ctx, recorder.Observe), storeID)
+		require.Equal(t, expiresAt, recorder.Earliest())
+	})
+
+	t.Run("does_not_cache_expired_tuples", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		require.NoError(t, backend.Write(ctx, storeID, nil, []*openfgav1.TupleKey{tuple.NewTupleKey("document:1", "viewer", "user:jon")}, storage.WithExpiresAt(time.Now().Add(100*time.Millisecond))))
+
+		iter, err := ds.Read(ctx, storeID, storage.ReadFilter{Object: "document:1", Relation: "viewer"}, storage.ReadOptions{})
+		require.NoError(t, err)
+		_, err = iter.Next(ctx)
+		require.NoError(t, err)
+
+		// The tuple expires before the results are cached.
+		time.Sleep(150 * time.Millisecond)
+		_, err = iter.Next(ctx)
+		require.ErrorIs(t, err, storage.ErrIteratorDone)
+		iter.Stop()
+		wg.Wait()
+
+		require.Nil(t, cache.Get(cacheKey(storeID)))
+	})
+}
+
 func TestDatastoreIteratorError(t *testing.T) {
 	ctx := context.Background()
 	t.Cleanup(func() {
@@ -1311,7 +1396,7 @@ func TestCachedIterator(t *testing.T) {
 			operation:         "operation",
 			tuples:            make([]*openfgav1.Tuple, 0, maxCacheSize),
 			cacheKey:          cacheKey,
-			invalidStoreKey:   storage.GetInvalidIteratorCacheKey(store),
+			invalidStoreKeys:  []string{storage.GetInvalidIteratorCacheKey(store)},
 			invalidEntityKeys: []string{},
 			cache:             mockCache,
 			maxResultSize:     maxCacheSize,
@@ -1371,7 +1456,7 @@ func TestCachedIterator(t *testing.T) {
 			operation:         "operation",
 			tuples:            make([]*openfgav1.Tuple, 0, maxCacheSize),
 			cacheKey:          cacheKey,
-			invalidStoreKey:   storage.GetInvalidIteratorCacheKey(store),
+			invalidStoreKeys:  []string{storage.GetInvalidIteratorCacheKey(store)},
 			invalidEntityKeys: []string{},
 			cache:             mockCache,
 			maxResultSize:     maxCacheSize,
@@ -1432,7 +1517,7 @@ func TestCachedIterator(t *testing.T) {
 				operation:         "operation",
 				tuples:            make([]*openfgav1.Tuple, 0, maxCacheSize),
 				cacheKey:          cacheKey,
-				invalidStoreKey:   storage.GetInvalidIteratorCacheKey(store),
+				invalidStoreKeys:  []string{storage.GetInvalidIteratorCacheKey(store)},
 				invalidEntityKeys: []string{},
 				cache:             mockCache,
 				maxResultSize:     maxCacheSize,
@@ -1456,7 +1541,7 @@ func TestCachedIterator(t *testing.T) {
 				operation:         "operation",
 				tuples:            make([]*openfgav1.Tuple, 0, maxCacheSize),
 				cacheKey:          cacheKey,
-				invalidStoreKey:   storage.GetInvalidIteratorCacheKey(store),
+				invalidStoreKeys:  []string{storage.GetInvalidIteratorCacheKey(store)},
 				invalidEntityKeys: []string{},
 				cache:             mockCache,
 				maxResultSize:     maxCacheSize,
@@ -173,7 +173,11 @@ func (c *CombinedTupleReader) ReadStartingWithUser(
 
 	filteredTuples := make([]*openfgav1.Tuple, 0, len(c.contextualTuplesOrderedByObjectID))
 	for _, t := range filterTuples(c.contextualTuplesOrderedByObjectID, "", filter.Relation, userFilters) {
-		if tuple.GetType(t.GetKey().GetObject()) != filter.ObjectType {
+		objectType, objectID := tuple.SplitObject(t.GetKey().GetObject())
+		if objectType != filter.ObjectType {
+			continue
+		}
+		if filter.AfterObjectID != "" && objectID <= filter.AfterObjectID {
 			continue
 		}
 		filteredTuples = append(filteredTuples, t)
@@ -0,0 +1,443 @@
+package storagewrappers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"golang.org/x/sync/singleflight"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+// historicalChangesPageSize is the number of changes read from the changelog at once.
+const historicalChangesPageSize = 100
+
+// HistoricalTupleReader is a [storage.RelationshipTupleReader] reading the tuples as they were at a
+// point in time. It reads the current tuples from the wrapped reader, and reconstructs their state at
+// that time by undoing the changes that followed it in the changelog.
+//
+// The changes of an object type are read from the changelog on the first read of the type, and
+// writes following that read are not undone. That first read scans every change of the type after
+// the point in time, and, if a tuple was deleted since, the changes of the type before it back to
+// the latest write of every deleted tuple, so that its cost grows with the number of changes rather
+// than with the number of tuples read. Reading far in the past, or a type with many changes, is to
+// be bounded by the deadline of the request.
+//
+// A tuple deleted after the point in time is restored with the condition and the expiry of its
+// latest write before it. Reads fail with [storage.ErrHistoryPruned] if that write is not in the
+// changelog, or if any change after the point in time was pruned from it. The expiry of the tuples,
+// restored or read from the wrapped reader, is evaluated at the point in time, so that a tuple that
+// expired since is read, but not one that had already expired then.
+type HistoricalTupleReader struct {
+	storage.RelationshipTupleReader
+	changelog storage.ChangelogBackend
+
+	// asOf is the point in time.
+	asOf time.Time
+
+	// from is the ULID after which the changes occurred after asOf.
+	from string
+
+	// group deduplicates the concurrent reads of the changes of an object type.
+	group singleflight.Group
+
+	mu      sync.Mutex
+	changes map[string]map[string]*openfgav1.TupleKey // GUARDED_BY(mu), keyed by store and object type
+}
+
+var _ storage.RelationshipTupleReader = (*HistoricalTupleReader)(nil)
+
+// NewHistoricalTupleReader returns a [HistoricalTupleReader] reading the tuples of store from ds as
+// they were at asOf, using the changes read from changelog. It returns [storage.ErrHistoryPruned]
+// if the changes following asOf were pruned from the changelog of store.
+func NewHistoricalTupleReader(
+	ctx context.Context,
+	ds storage.RelationshipTupleReader,
+	changelog storage.ChangelogBackend,
+	store string,
+	asOf time.Time,
+) (*HistoricalTupleReader, error) {
+	// The greatest ULID of the millisecond of asOf, so that the changes of that millisecond count as
+	// occurred at or before asOf.
+	from, err := ulid.New(ulid.Timestamp(asOf), bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", storage.ErrInvalidStartTime, err)
+	}
+
+	h := &HistoricalTupleReader{
+		RelationshipTupleReader: ds,
+		changelog:               changelog,
+		asOf:                    asOf,
+		from:                    from.String(),
+		changes:                 make(map[string]map[string]*openfgav1.TupleKey),
+	}
+
+	_, _, err = changelog.ReadChanges(ctx, store, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+		Pagination:   storage.NewPaginationOptions(1, h.from),
+		CheckHorizon: true,
+	})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, historyError(err)
+	}
+	return h, nil
+}
+
+// historyError returns err, or [storage.ErrHistoryPruned] if it is [storage.ErrChangelogTruncated].
+func historyError(err error) error {
+	if errors.Is(err, storage.ErrChangelogTruncated) {
+		return fmt.Errorf("%w: %w", storage.ErrHistoryPruned, err)
+	}
+	return err
+}
+
+// changedTuples returns the tuples of objectType, or of every type if empty, changed after the
+// point in time.
+//
+// Only the tuples read successfully are kept. Concurrent reads share the changes read for the first
+// of them, which does not stop reading when that request is canceled, so that no request fails
+// because another one was canceled.
+func (h *HistoricalTupleReader) changedTuples(ctx context.Context, store, objectType string) (map[string]*openfgav1.TupleKey, error) {
+	key := store + "/" + objectType
+
+	h.mu.Lock()
+	tuples, ok := h.changes[key]
+	h.mu.Unlock()
+	if ok {
+		return tuples, nil
+	}
+
+	resultCh := h.group.DoChan(key, func() (interface{}, error) {
+		tuples, err := h.readChangedTuples(context.WithoutCancel(ctx), store, objectType)
+		if err != nil {
+			return nil, historyError(err)
+		}
+
+		h.mu.Lock()
+		h.changes[key] = tuples
+		h.mu.Unlock()
+		return tuples, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-resultCh:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(map[string]*openfgav1.TupleKey), nil
+	}
+}
+
+// readChangedTuples reads the tuples of objectType changed after the point in time, see
+// [HistoricalTupleReader] for the changes it reads.
+func (h *HistoricalTupleReader) readChangedTuples(ctx context.Context, store, objectType string) (map[string]*openfgav1.TupleKey, error) {
+	tuples := make(map[string]*openfgav1.TupleKey)
+	restored := make(map[string]struct{})
+
+	// The first change of a tuple after the point in time tells whether it existed then.
+	err := h.readChanges(ctx, store, objectType, false, func(change *openfgav1.TupleChange) bool {
+		tk := change.GetTupleKey()
+		key := tuple.TupleKeyToString(tk)
+		if _, ok := tuples[key]; ok {
+			return true
+		}
+		if change.GetOperation() == openfgav1.TupleOperation_TUPLE_OPERATION_DELETE {
+			// The changelog does not record the condition of deleted tuples.
+			tuples[key] = tuple.NewTupleKey(tk.GetObject(), tk.GetRelation(), tk.GetUser())
+			restored[key] = struct{}{}
+		} else {
+			tuples[key] = nil
+		}
+		return true
+	})
+	if err != nil || len(restored) == 0 {
+		return tuples, err
+	}
+
+	// The latest write of a restored tuple before the point in time holds its condition and expiry.
+	expiries := make(map[*openfgav1.TupleChange]time.Time)
+	ctx = storage.ContextWithChangeExpiryObserver(ctx, func(change *openfgav1.TupleChange, expiresAt time.Time) {
+		expiries[change] = expiresAt
+	})
+	err = h.readChanges(ctx, store, objectType, true, func(change *openfgav1.TupleChange) bool {
+		expiresAt := expiries[change]
+		delete(expiries, change)
+
+		key := tuple.TupleKeyToString(change.GetTupleKey())
+		if _, ok := restored[key]; !ok {
+			return true
+		}
+		if change.GetOperation() == openfgav1.TupleOperation_TUPLE_OPERATION_WRITE {
+			if storage.IsExpired(expiresAt, h.asOf) {
+				// The tuple was deleted after the point in time, but had already expired then.
+				tuples[key] = nil
+			} else {
+				tuples[key] = change.GetTupleKey()
+			}
+		}
+		delete(restored, key)
+		return len(restored) > 0
+	})
+	if err != nil || len(restored) == 0 {
+		return tuples, err
+	}
+
+	// The latest write of the remaining tuples is not in the changelog, so their condition is
+	// unknown. Restoring them without one could grant access that the condition denied.
+	return nil, fmt.Errorf("%w: the latest write of %d deleted tuples of type %q is not in the changelog", storage.ErrHistoryPruned, len(restored), objectType)
+}
+
+// readChanges calls fn with every change of objectType after the point in time, in the order they
+// occurred, or with every change before it in reverse order if before is true, until fn returns false.
+func (h *HistoricalTupleReader) readChanges(ctx context.Context, store, objectType string, before bool, fn func(*openfgav1.TupleChange) bool) error {
+	from := h.from
+	for {
+		changes, token, err := h.changelog.ReadChanges(ctx, store, storage.ReadChangesFilter{ObjectType: objectType}, storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(historicalChangesPageSize, from),
+			SortDesc:     before,
+			CheckHorizon: true,
+		})
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if !fn(change) {
+				return nil
+			}
+		}
+		if token == "" || len(changes) < historicalChangesPageSize {
+			return nil
+		}
+		from = token
+	}
+}
+
+// wrappedContext returns the context of the reads of the wrapped reader, which evaluate the expiry
+// of the tuples at the point in time.
+func (h *HistoricalTupleReader) wrappedContext(ctx context.Context) context.Context {
+	return storage.ContextWithTupleExpiryTime(ctx, h.asOf)
+}
+
+// historicalIterator is a [storage.TupleIterator] skipping the tuples changed after the point in time.
+type historicalIterator struct {
+	storage.TupleIterator
+	changed map[string]*openfgav1.TupleKey
+}
+
+var _ storage.TupleIterator = (*historicalIterator)(nil)
+
+// Next see [storage.Iterator].Next.
+func (i *historicalIterator) Next(ctx context.Context) (*openfgav1.Tuple, error) {
+	for {
+		t, err := i.TupleIterator.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := i.changed[tuple.TupleKeyToString(t.GetKey())]; !ok {
+			return t, nil
+		}
+	}
+}
+
+// Head see [storage.Iterator].Head.
+func (i *historicalIterator) Head(ctx context.Context) (*openfgav1.Tuple, error) {
+	for {
+		t, err := i.TupleIterator.Head(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := i.changed[tuple.TupleKeyToString(t.GetKey())]; !ok {
+			return t, nil
+		}
+		if _, err := i.TupleIterator.Next(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// restoredTuples returns the tuples of changed that existed at the point in time and match, sorted by object.
+func restoredTuples(changed map[string]*openfgav1.TupleKey, match func(*openfgav1.TupleKey) bool) []*openfgav1.Tuple {
+	var restored []*openfgav1.Tuple
+	for _, tk := range changed {
+		if tk != nil && match(tk) {
+			restored = append(restored, &openfgav1.Tuple{Key: tk})
+		}
+	}
+	slices.SortFunc(restored, func(a, b *openfgav1.Tuple) int {
+		if c := strings.Compare(a.GetKey().GetObject(), b.GetKey().GetObject()); c != 0 {
+			return c
+		}
+		return strings.Compare(tuple.TupleKeyToString(a.GetKey()), tuple.TupleKeyToString(b.GetKey()))
+	})
+	return restored
+}
+
+// matchesConditions reports whether the condition of tk is one of conditions, if any.
+func matchesConditions(tk *openfgav1.TupleKey, conditions []string) bool {
+	return len(conditions) == 0 || slices.Contains(conditions, tk.GetCondition().GetName())
+}
+
+// matchesReadFilter reports whether tk matches filter, whose object and user may be a type only.
+func matchesReadFilter(tk *openfgav1.TupleKey, filter storage.ReadFilter) bool {
+	if filter.Object != "" {
+		objectType, objectID := tuple.SplitObject(filter.Object)
+		if tuple.GetType(tk.GetObject()) != objectType || (objectID != "" && tk.GetObject() != filter.Object) {
+			return false
+		}
+	}
+	if filter.Relation != "" && tk.GetRelation() != filter.Relation {
+		return false
+	}
+	if filter.User != "" {
+		userType, userID, _ := tuple.ToUserParts(filter.User)
+		if userID != "" && tk.GetUser() != filter.User {
+			return false
+		} else if userID == "" && !strings.HasPrefix(tk.GetUser(), userType+":") {
+			return false
+		}
+	}
+	return matchesConditions(tk, filter.Conditions)
+}
+
+// Read see [storage.RelationshipTupleReader].Read.
+func (h *HistoricalTupleReader) Read(ctx context.Context, store string, filter storage.ReadFilter, options storage.ReadOptions) (storage.TupleIterator, error) {
+	changed, err := h.changedTuples(ctx, store, tuple.GetType(filter.Object))
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := h.RelationshipTupleReader.Read(h.wrappedContext(ctx), store, filter, options)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := restoredTuples(changed, func(tk *openfgav1.TupleKey) bool {
+		return matchesReadFilter(tk, filter)
+	})
+	return storage.NewCombinedIterator(&historicalIterator{TupleIterator: iter, changed: changed}, storage.NewStaticTupleIterator(restored)), nil
+}
+
+// ReadPage see [storage.RelationshipTupleReader].ReadPage. The tuples deleted after the point in
+// time are returned with the last page, so that pages may hold more or fewer tuples than the page size.
+func (h *HistoricalTupleReader) ReadPage(ctx context.Context, store string, filter storage.ReadFilter, options storage.ReadPageOptions) ([]*openfgav1.Tuple, string, error) {
+	changed, err := h.changedTuples(ctx, store, tuple.GetType(filter.Object))
+	if err != nil {
+		return nil, "", err
+	}
+
+	tuples, token, err := h.RelationshipTupleReader.ReadPage(h.wrappedContext(ctx), store, filter, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	page := make([]*openfgav1.Tuple, 0, len(tuples))
+	for _, t := range tuples {
+		if _, ok := changed[tuple.TupleKeyToString(t.GetKey())]; !ok {
+			page = append(page, t)
+		}
+	}
+	if token == "" {
+		page = append(page, restoredTuples(changed, func(tk *openfgav1.TupleKey) bool {
+			return matchesReadFilter(tk, filter)
+		})...)
+	}
+	return page, token, nil
+}
+
+// ReadUserTuple see [storage.RelationshipTupleReader].ReadUserTuple.
+func (h *HistoricalTupleReader) ReadUserTuple(ctx context.Context, store string, filter storage.ReadUserTupleFilter, options storage.ReadUserTupleOptions) (*openfgav1.Tuple, error) {
+	changed, err := h.changedTuples(ctx, store, tuple.GetType(filter.Object))
+	if err != nil {
+		return nil, err
+	}
+
+	if tk, ok := changed[tuple.TupleKeyToString(tuple.NewTupleKey(filter.Object, filter.Relation, filter.User))]; ok {
+		if tk == nil {
+			return nil, storage.ErrNotFound
+		}
+		return &openfgav1.Tuple{Key: tk}, nil
+	}
+	return h.RelationshipTupleReader.ReadUserTuple(h.wrappedContext(ctx), store, filter, options)
+}
+
+// ReadUsersetTuples see [storage.RelationshipTupleReader].ReadUsersetTuples.
+func (h *HistoricalTupleReader) ReadUsersetTuples(ctx context.Context, store string, filter storage.ReadUsersetTuplesFilter, options storage.ReadUsersetTuplesOptions) (storage.TupleIterator, error) {
+	changed, err := h.changedTuples(ctx, store, tuple.GetType(filter.Object))
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := h.RelationshipTupleReader.ReadUsersetTuples(h.wrappedContext(ctx), store, filter, options)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := restoredTuples(changed, func(tk *openfgav1.TupleKey) bool {
+		if tk.GetObject() != filter.Object || tk.GetRelation() != filter.Relation ||
+			tuple.GetUserTypeFromUser(tk.GetUser()) != tuple.UserSet || !matchesConditions(tk, filter.Conditions) {
+			return false
+		}
+		if len(filter.AllowedUserTypeRestrictions) == 0 {
+			return true
+		}
+		userType := tuple.GetType(tk.GetUser())
+		_, userRelation := tuple.SplitObjectRelation(tk.GetUser())
+		for _, allowedType := range filter.AllowedUserTypeRestrictions {
+			if allowedType.GetType() == userType && allowedType.GetRelation() == userRelation {
+				return true
+			}
+		}
+		return false
+	})
+	return storage.NewCombinedIterator(&historicalIterator{TupleIterator: iter, changed: changed}, storage.NewStaticTupleIterator(restored)), nil
+}
+
+// ReadStartingWithUser see [storage.RelationshipTupleReader].ReadStartingWithUser.
+func (h *HistoricalTupleReader) ReadStartingWithUser(ctx context.Context, store string, filter storage.ReadStartingWithUserFilter, options storage.ReadStartingWithUserOptions) (storage.TupleIterator, error) {
+	changed, err := h.changedTuples(ctx, store, filter.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := h.RelationshipTupleReader.ReadStartingWithUser(h.wrappedContext(ctx), store, filter, options)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]string, 0, len(filter.UserFilter))
+	for _, u := range filter.UserFilter {
+		user := u.GetObject()
+		if u.GetRelation() != "" {
+			user = tuple.GetObjectRelationAsString(u)
+		}
+		users = append(users, user)
+	}
+	restored := restoredTuples(changed, func(tk *openfgav1.TupleKey) bool {
+		objectType, objectID := tuple.SplitObject(tk.GetObject())
+		return objectType == filter.ObjectType && tk.GetRelation() == filter.Relation &&
+			slices.Contains(users, tk.GetUser()) &&
+			(filter.ObjectIDs == nil || filter.ObjectIDs.Exists(objectID)) &&
+			(filter.AfterObjectID == "" || objectID > filter.AfterObjectID) &&
+			matchesConditions(tk, filter.Conditions)
+	})
+
+	filtered := &historicalIterator{TupleIterator: iter, changed: changed}
+	if options.WithResultsSortedAscending {
+		return storage.NewOrderedCombinedIterator(storage.ObjectMapper(), filtered, storage.NewStaticTupleIterator(restored)), nil
+	}
+	return storage.NewCombinedIterator[*openfgav1.Tuple](filtered, storage.NewStaticTupleIterator(restored)), nil
+}
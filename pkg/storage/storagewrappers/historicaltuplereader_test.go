@@ -0,0 +1,298 @@
+package storagewrappers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+// writeHistory writes tuples to a new store, changes them after a point in time, and returns the
+// store and the point in time.
+func writeHistory(t *testing.T, ds storage.OpenFGADatastore) (string, time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	store := ulid.Make().String()
+
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKeyWithCondition("document:1", "viewer", "user:anne", "in_office", nil),
+		tuple.NewTupleKey("document:2", "viewer", "user:bob"),
+		tuple.NewTupleKey("document:2", "viewer", "group:eng#member"),
+	}))
+
+	time.Sleep(2 * time.Millisecond)
+	asOf := time.Now()
+	time.Sleep(2 * time.Millisecond)
+
+	require.NoError(t, ds.Write(ctx, store, []*openfgav1.TupleKeyWithoutCondition{
+		tuple.TupleKeyToTupleKeyWithoutCondition(tuple.NewTupleKey("document:1", "viewer", "user:anne")),
+		tuple.TupleKeyToTupleKeyWithoutCondition(tuple.NewTupleKey("document:2", "viewer", "user:bob")),
+	}, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:3", "viewer", "user:anne"),
+	}))
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:2", "viewer", "user:bob"),
+	}))
+
+	return store, asOf
+}
+
+func tupleStrings(t *testing.T, iter storage.TupleIterator) []string {
+	t.Helper()
+	defer iter.Stop()
+
+	var tuples []string
+	for {
+		tk, err := iter.Next(context.Background())
+		if err != nil {
+			require.ErrorIs(t, err, storage.ErrIteratorDone)
+			return tuples
+		}
+		tuples = append(tuples, tuple.TupleKeyToString(tk.GetKey()))
+	}
+}
+
+func TestHistoricalTupleReader(t *testing.T) {
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store, asOf := writeHistory(t, ds)
+
+	reader, err := NewHistoricalTupleReader(ctx, ds, ds, store, asOf)
+	require.NoError(t, err)
+
+	t.Run("read_user_tuple", func(t *testing.T) {
+		tk, err := reader.ReadUserTuple(ctx, store, storage.ReadUserTupleFilter{
+			Object:   "document:1",
+			Relation: "viewer",
+			User:     "user:anne",
+		}, storage.ReadUserTupleOptions{})
+		require.NoError(t, err)
+		require.Equal(t, "in_office", tk.GetKey().GetCondition().GetName())
+
+		_, err = reader.ReadUserTuple(ctx, store, storage.ReadUserTupleFilter{
+			Object:   "document:3",
+			Relation: "viewer",
+			User:     "user:anne",
+		}, storage.ReadUserTupleOptions{})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("read", func(t *testing.T) {
+		iter, err := reader.Read(ctx, store, storage.ReadFilter{Object: "document:"}, storage.ReadOptions{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{
+			"document:1#viewer@user:anne",
+			"document:2#viewer@user:bob",
+			"document:2#viewer@group:eng#member",
+		}, tupleStrings(t, iter))
+	})
+
+	t.Run("read_page", func(t *testing.T) {
+		tuples, token, err := reader.ReadPage(ctx, store, storage.ReadFilter{User: "user:anne"}, storage.ReadPageOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, ""),
+		})
+		require.NoError(t, err)
+		require.Empty(t, token)
+		require.Len(t, tuples, 1)
+		require.Equal(t, "document:1#viewer@user:anne", tuple.TupleKeyToString(tuples[0].GetKey()))
+	})
+
+	t.Run("read_userset_tuples", func(t *testing.T) {
+		iter, err := reader.ReadUsersetTuples(ctx, store, storage.ReadUsersetTuplesFilter{
+			Object:   "document:2",
+			Relation: "viewer",
+		}, storage.ReadUsersetTuplesOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"document:2#viewer@group:eng#member"}, tupleStrings(t, iter))
+	})
+
+	t.Run("read_starting_with_user_sorted", func(t *testing.T) {
+		iter, err := reader.ReadStartingWithUser(ctx, store, storage.ReadStartingWithUserFilter{
+			ObjectType: "document",
+			Relation:   "viewer",
+			UserFilter: []*openfgav1.ObjectRelation{{Object: "user:anne"}, {Object: "user:bob"}},
+		}, storage.ReadStartingWithUserOptions{WithResultsSortedAscending: true})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"document:1#viewer@user:anne",
+			"document:2#viewer@user:bob",
+		}, tupleStrings(t, iter))
+	})
+}
+
+func TestHistoricalTupleReaderBeforeRetention(t *testing.T) {
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store, asOf := writeHistory(t, ds)
+
+	_, err := ds.(storage.ChangelogPruner).PruneChanges(ctx, store, storage.PruneChangesOptions{MaxChanges: 1})
+	require.NoError(t, err)
+
+	_, err = NewHistoricalTupleReader(ctx, ds, ds, store, asOf)
+	require.ErrorIs(t, err, storage.ErrHistoryPruned)
+
+	_, err = NewHistoricalTupleReader(ctx, ds, ds, store, time.Now())
+	require.NoError(t, err)
+}
+
+func TestHistoricalTupleReaderRestoredWritePruned(t *testing.T) {
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store := ulid.Make().String()
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKeyWithCondition("document:1", "viewer", "user:anne", "in_office", nil),
+	}))
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:2", "viewer", "user:bob"),
+	}))
+
+	time.Sleep(2 * time.Millisecond)
+	asOf := time.Now()
+	time.Sleep(2 * time.Millisecond)
+
+	require.NoError(t, ds.Write(ctx, store, []*openfgav1.TupleKeyWithoutCondition{
+		tuple.TupleKeyToTupleKeyWithoutCondition(tuple.NewTupleKey("document:1", "viewer", "user:anne")),
+	}, nil))
+
+	// The write of document:1 is pruned, but not the changes following the point in time.
+	_, err := ds.(storage.ChangelogPruner).PruneChanges(ctx, store, storage.PruneChangesOptions{MaxChanges: 2})
+	require.NoError(t, err)
+
+	reader, err := NewHistoricalTupleReader(ctx, ds, ds, store, asOf)
+	require.NoError(t, err)
+
+	_, err = reader.ReadUserTuple(ctx, store, storage.ReadUserTupleFilter{
+		Object:   "document:1",
+		Relation: "viewer",
+		User:     "user:anne",
+	}, storage.ReadUserTupleOptions{})
+	require.ErrorIs(t, err, storage.ErrHistoryPruned)
+
+	// The failure is not kept, so that the reads keep failing rather than succeeding from a
+	// partial reconstruction.
+	_, err = reader.Read(ctx, store, storage.ReadFilter{Object: "document:"}, storage.ReadOptions{})
+	require.ErrorIs(t, err, storage.ErrHistoryPruned)
+}
+
+// withoutWrites is a changelog missing the writes of a tuple, as if it was written without
+// recording them.
+type withoutWrites struct {
+	storage.ChangelogBackend
+	tuple string
+}
+
+func (w withoutWrites) ReadChanges(ctx context.Context, store string, filter storage.ReadChangesFilter, options storage.ReadChangesOptions) ([]*openfgav1.TupleChange, string, error) {
+	changes, token, err := w.ChangelogBackend.ReadChanges(ctx, store, filter, options)
+	kept := changes[:0:0]
+	for _, change := range changes {
+		if change.GetOperation() != openfgav1.TupleOperation_TUPLE_OPERATION_WRITE || tuple.TupleKeyToString(change.GetTupleKey()) != w.tuple {
+			kept = append(kept, change)
+		}
+	}
+	return kept, token, err
+}
+
+func TestHistoricalTupleReaderRestoredWriteMissing(t *testing.T) {
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store, asOf := writeHistory(t, ds)
+
+	// The condition of document:1 is unknown, so it cannot be restored without granting access
+	// that the condition denied.
+	reader, err := NewHistoricalTupleReader(ctx, ds, withoutWrites{ds, "document:1#viewer@user:anne"}, store, asOf)
+	require.NoError(t, err)
+
+	_, err = reader.ReadUserTuple(ctx, store, storage.ReadUserTupleFilter{
+		Object:   "document:1",
+		Relation: "viewer",
+		User:     "user:anne",
+	}, storage.ReadUserTupleOptions{})
+	require.ErrorIs(t, err, storage.ErrHistoryPruned)
+}
+
+func TestHistoricalTupleReaderExpiry(t *testing.T) {
+	ctx := context.Background()
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store := ulid.Make().String()
+	now := time.Now()
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:1", "viewer", "user:anne"),
+	}, storage.WithExpiresAt(now.Add(time.Second))))
+	require.NoError(t, ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+		tuple.NewTupleKey("document:2", "viewer", "user:bob"),
+	}, storage.WithExpiresAt(now.Add(5*time.Millisecond))))
+
+	time.Sleep(10 * time.Millisecond)
+	asOf := time.Now()
+	time.Sleep(time.Until(now.Add(time.Second + time.Millisecond)))
+
+	readDocuments := func(t *testing.T) []string {
+		t.Helper()
+		reader, err := NewHistoricalTupleReader(ctx, ds, ds, store, asOf)
+		require.NoError(t, err)
+
+		iter, err := reader.Read(ctx, store, storage.ReadFilter{Object: "document:"}, storage.ReadOptions{})
+		require.NoError(t, err)
+		return tupleStrings(t, iter)
+	}
+
+	t.Run("expired_since", func(t *testing.T) {
+		require.Equal(t, []string{"document:1#viewer@user:anne"}, readDocuments(t))
+	})
+
+	t.Run("reaped_since", func(t *testing.T) {
+		deleted, err := ds.(storage.TupleExpirer).DeleteExpiredTuples(ctx, time.Now(), 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, deleted)
+
+		// Both tuples are restored from the changelog, but document:2 had already expired then.
+		require.Equal(t, []string{"document:1#viewer@user:anne"}, readDocuments(t))
+	})
+}
+
+func TestHistoricalTupleReaderCanceledRead(t *testing.T) {
+	ds := memory.New()
+	t.Cleanup(ds.Close)
+
+	store, asOf := writeHistory(t, ds)
+
+	reader, err := NewHistoricalTupleReader(context.Background(), ds, ds, store, asOf)
+	require.NoError(t, err)
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = reader.ReadUserTuple(canceledCtx, store, storage.ReadUserTupleFilter{
+		Object:   "document:1",
+		Relation: "viewer",
+		User:     "user:anne",
+	}, storage.ReadUserTupleOptions{})
+	if err != nil {
+		require.ErrorIs(t, err, context.Canceled)
+	}
+
+	tk, err := reader.ReadUserTuple(context.Background(), store, storage.ReadUserTupleFilter{
+		Object:   "document:1",
+		Relation: "viewer",
+		User:     "user:anne",
+	}, storage.ReadUserTupleOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "in_office", tk.GetKey().GetCondition().GetName())
+}
@@ -0,0 +1,105 @@
+package storagewrappers
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+// InvalidationNotifyingDatastore is a wrapper for a datastore that broadcasts an invalidation of the
+// object types and relations written after every successful write of tuples.
+type InvalidationNotifyingDatastore struct {
+	storage.OpenFGADatastore
+	notifier storage.InvalidationNotifier
+	logger   logger.Logger
+}
+
+var _ storage.OpenFGADatastore = (*InvalidationNotifyingDatastore)(nil)
+
+// NewInvalidationNotifyingDatastore creates a new instance of [InvalidationNotifyingDatastore],
+// wrapping the specified datastore and notifying writes to notifier.
+func NewInvalidationNotifyingDatastore(inner storage.OpenFGADatastore, notifier storage.InvalidationNotifier, logger logger.Logger) *InvalidationNotifyingDatastore {
+	return &InvalidationNotifyingDatastore{
+		OpenFGADatastore: inner,
+		notifier:         notifier,
+		logger:           logger,
+	}
+}
+
+// Write see [storage.RelationshipTupleWriter].Write. A failure to notify the write is logged
+// rather than returned, as the write already happened and the cache controller polling will
+// eventually invalidate the caches.
+func (n *InvalidationNotifyingDatastore) Write(ctx context.Context, store string, deletes storage.Deletes, writes storage.Writes, opts ...storage.TupleWriteOption) error {
+	if err := n.OpenFGADatastore.Write(ctx, store, deletes, writes, opts...); err != nil {
+		return err
+	}
+
+	objectRelations := make([]string, 0, len(deletes)+len(writes))
+	for _, tk := range deletes {
+		objectRelations = append(objectRelations, tuple.ToObjectRelationString(tuple.GetType(tk.GetObject()), tk.GetRelation()))
+	}
+	for _, tk := range writes {
+		objectRelations = append(objectRelations, tuple.ToObjectRelationString(tuple.GetType(tk.GetObject()), tk.GetRelation()))
+	}
+	notifyInvalidation(ctx, n.notifier, n.logger, store, objectRelations)
+	return nil
+}
+
+// InvalidationNotifyingBulkLoader is a wrapper for a bulk loader that broadcasts an invalidation
+// of the object types and relations loaded after every load writing tuples, as the
+// [InvalidationNotifyingDatastore] does for writes.
+type InvalidationNotifyingBulkLoader struct {
+	storage.BulkLoader
+	notifier storage.InvalidationNotifier
+	logger   logger.Logger
+}
+
+var _ storage.BulkLoader = (*InvalidationNotifyingBulkLoader)(nil)
+
+// NewInvalidationNotifyingBulkLoader creates a new instance of [InvalidationNotifyingBulkLoader],
+// wrapping the specified bulk loader and notifying loads to notifier.
+func NewInvalidationNotifyingBulkLoader(inner storage.BulkLoader, notifier storage.InvalidationNotifier, logger logger.Logger) *InvalidationNotifyingBulkLoader {
+	return &InvalidationNotifyingBulkLoader{
+		BulkLoader: inner,
+		notifier:   notifier,
+		logger:     logger,
+	}
+}
+
+// BulkLoad see [storage.BulkLoader].BulkLoad. The load is notified even if it fails, as the tuples
+// written before the error are kept.
+func (n *InvalidationNotifyingBulkLoader) BulkLoad(ctx context.Context, store string, tuples []*openfgav1.TupleKey, options storage.BulkLoadOptions) (int, error) {
+	written, err := n.BulkLoader.BulkLoad(ctx, store, tuples, options)
+	if written == 0 {
+		return written, err
+	}
+
+	objectRelations := make([]string, 0, len(tuples))
+	for _, tk := range tuples {
+		objectRelations = append(objectRelations, tuple.ToObjectRelationString(tuple.GetType(tk.GetObject()), tk.GetRelation()))
+	}
+	notifyInvalidation(ctx, n.notifier, n.logger, store, objectRelations)
+	return written, err
+}
+
+// notifyInvalidation notifies the invalidation of objectRelations, which may repeat, in store.
+func notifyInvalidation(ctx context.Context, notifier storage.InvalidationNotifier, logger logger.Logger, store string, objectRelations []string) {
+	if len(objectRelations) == 0 {
+		return
+	}
+	slices.Sort(objectRelations)
+
+	inv := storage.Invalidation{StoreID: store, ObjectRelations: slices.Compact(objectRelations)}
+	if err := notifier.Notify(context.WithoutCancel(ctx), inv); err != nil {
+		logger.WarnWithContext(ctx, "failed to notify cache invalidation",
+			zap.String("store_id", store),
+			zap.Error(err))
+	}
+}
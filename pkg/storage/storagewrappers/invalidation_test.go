@@ -0,0 +1,103 @@
+package storagewrappers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+
+	"github.com/openfga/openfga/pkg/logger"
+	"github.com/openfga/openfga/pkg/storage"
+	"github.com/openfga/openfga/pkg/storage/memory"
+	"github.com/openfga/openfga/pkg/tuple"
+)
+
+func TestInvalidationNotifyingDatastore(t *testing.T) {
+	ctx := context.Background()
+	store := ulid.Make().String()
+
+	notifier := storage.NewInProcessInvalidationNotifier()
+	defer notifier.Close()
+
+	var received []storage.Invalidation
+	notifier.Subscribe(func(inv storage.Invalidation) { received = append(received, inv) })
+
+	ds := NewInvalidationNotifyingDatastore(memory.New(), notifier, logger.NewNoopLogger())
+	defer ds.Close()
+
+	t.Run("write_notifies_distinct_object_relations", func(t *testing.T) {
+		received = nil
+		err := ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("folder:1", "viewer", "user:jon"),
+			tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+			tuple.NewTupleKey("document:2", "viewer", "user:jon"),
+			tuple.NewTupleKey("document:2", "editor", "user:jon"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []storage.Invalidation{{StoreID: store, ObjectRelations: []string{"document#editor", "document#viewer", "folder#viewer"}}}, received)
+	})
+
+	t.Run("delete_notifies_object_relations", func(t *testing.T) {
+		received = nil
+		err := ds.Write(ctx, store, []*openfgav1.TupleKeyWithoutCondition{
+			tuple.TupleKeyToTupleKeyWithoutCondition(tuple.NewTupleKey("folder:1", "viewer", "user:jon")),
+		}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []storage.Invalidation{{StoreID: store, ObjectRelations: []string{"folder#viewer"}}}, received)
+	})
+
+	t.Run("failed_write_does_not_notify", func(t *testing.T) {
+		received = nil
+		err := ds.Write(ctx, store, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+		})
+		require.Error(t, err)
+		require.Empty(t, received)
+	})
+
+	t.Run("empty_write_does_not_notify", func(t *testing.T) {
+		received = nil
+		require.NoError(t, ds.Write(ctx, store, nil, nil))
+		require.Empty(t, received)
+	})
+}
+
+func TestInvalidationNotifyingBulkLoader(t *testing.T) {
+	ctx := context.Background()
+	store := ulid.Make().String()
+
+	notifier := storage.NewInProcessInvalidationNotifier()
+	defer notifier.Close()
+
+	var received []storage.Invalidation
+	notifier.Subscribe(func(inv storage.Invalidation) { received = append(received, inv) })
+
+	ds := memory.New()
+	defer ds.Close()
+	loader := NewInvalidationNotifyingBulkLoader(ds.(storage.BulkLoader), notifier, logger.NewNoopLogger())
+
+	tuples := []*openfgav1.TupleKey{
+		tuple.NewTupleKey("folder:1", "viewer", "user:jon"),
+		tuple.NewTupleKey("document:1", "viewer", "user:jon"),
+		tuple.NewTupleKey("document:2", "viewer", "user:jon"),
+	}
+
+	t.Run("load_notifies_distinct_object_relations", func(t *testing.T) {
+		received = nil
+		written, err := loader.BulkLoad(ctx, store, tuples, storage.BulkLoadOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 3, written)
+		require.Equal(t, []storage.Invalidation{{StoreID: store, ObjectRelations: []string{"document#viewer", "folder#viewer"}}}, received)
+	})
+
+	t.Run("load_writing_nothing_does_not_notify", func(t *testing.T) {
+		received = nil
+		written, err := loader.BulkLoad(ctx, store, tuples, storage.BulkLoadOptions{})
+		require.NoError(t, err)
+		require.Zero(t, written)
+		require.Empty(t, received)
+	})
+}
@@ -2,6 +2,7 @@ package sharediterator
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -540,16 +541,23 @@ type iteratorReader[T any] struct {
 	storage.Iterator[T]
 }
 
-// Read reads items from the iterator into the provided buffer.
+// Read reads items from the iterator into the provided buffer, and their expiries, as reported with
+// storage.ObserveTupleExpiry, into the expiries buffer of the same length.
 // The method will read up to the length of the buffer, and if there are fewer items available,
 // it will return the number of items read and an error if any occurred.
-func (ir *iteratorReader[T]) Read(ctx context.Context, buf []T) (int, error) {
+func (ir *iteratorReader[T]) Read(ctx context.Context, buf []T, expiries []time.Time) (int, error) {
+	var expiresAt time.Time
+	ctx = storage.ContextWithTupleExpiryObserver(ctx, func(t time.Time) {
+		expiresAt = t
+	})
 	for i := range buf {
+		expiresAt = time.Time{}
 		t, err := ir.Next(ctx)
 		if err != nil {
 			return i, err
 		}
 		buf[i] = t
+		expiries[i] = expiresAt
 	}
 	return len(buf), nil
 }
@@ -560,6 +568,10 @@ func (ir *iteratorReader[T]) Read(ctx context.Context, buf []T) (int, error) {
 type iteratorState struct {
 	items []*openfgav1.Tuple
 	err   error
+
+	// expiries are the expiries of the items, which are reported again to every clone reading them.
+	// It is nil as long as none of the items expires.
+	expiries []time.Time
 }
 
 // sharedIterator is a thread-safe iterator that allows multiple goroutines to share the same iterator.
@@ -648,7 +660,8 @@ func (s *sharedIterator) clone() *sharedIterator {
 // If an error occurs during the read operation, it updates the error in the iterator state.
 func (s *sharedIterator) fetchMore() {
 	var buf [bufferSize]*openfgav1.Tuple
-	read, e := s.ir.Read(context.Background(), buf[:])
+	var expiries [bufferSize]time.Time
+	read, e := s.ir.Read(context.Background(), buf[:], expiries[:])
 
 	// Load the current items from the shared items pointer and append the newly fetched items to it.
 	state := s.state.Load()
@@ -661,6 +674,12 @@ func (s *sharedIterator) fetchMore() {
 	copy(newState.items, state.items)
 	copy(newState.items[len(state.items):], buf[:read])
 
+	if state.expiries != nil || slices.ContainsFunc(expiries[:read], func(t time.Time) bool { return !t.IsZero() }) {
+		newState.expiries = make([]time.Time, len(newState.items))
+		copy(newState.expiries, state.expiries)
+		copy(newState.expiries[len(state.items):], expiries[:read])
+	}
+
 	if e != nil {
 		newState.err = e
 	}
@@ -670,13 +689,14 @@ func (s *sharedIterator) fetchMore() {
 
 // fetchAndWait is a method that fetches items from the underlying storage.TupleIterator and waits for new items to be available.
 // It blocks until new items are fetched or an error occurs.
-// The items and err pointers are updated with the fetched items and any error encountered.
-func (s *sharedIterator) fetchAndWait(items *[]*openfgav1.Tuple, err *error) {
+// The items, expiries and err pointers are updated with the fetched items, their expiries and any error encountered.
+func (s *sharedIterator) fetchAndWait(items *[]*openfgav1.Tuple, expiries *[]time.Time, err *error) {
 	for {
 		state := s.state.Load()
 
 		if s.head < len(state.items) || state.err != nil {
 			*items = state.items
+			*expiries = state.expiries
 			*err = state.err
 			return
 		}
@@ -699,9 +719,10 @@ func (s *sharedIterator) currentLocked(ctx context.Context) (*openfgav1.Tuple, e
 	}
 
 	var items []*openfgav1.Tuple
+	var expiries []time.Time
 	var err error
 
-	s.fetchAndWait(&items, &err)
+	s.fetchAndWait(&items, &expiries, &err)
 
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -716,6 +737,9 @@ func (s *sharedIterator) currentLocked(ctx context.Context) (*openfgav1.Tuple, e
 		return nil, storage.ErrIteratorDone
 	}
 
+	if expiries != nil {
+		storage.ObserveTupleExpiry(ctx, expiries[s.head])
+	}
 	return items[s.head], nil
 }
 
@@ -1915,3 +1915,62 @@ func TestSharedIterator_ManyTuples(t *testing.T) {
 		}
 	})
 }
+
+func TestSharedIterator_TupleExpiry(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		goleak.VerifyNone(t)
+	})
+
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockDatastore := mocks.NewMockOpenFGADatastore(mockController)
+	storeID := ulid.Make().String()
+	ds := NewSharedIteratorDatastore(mockDatastore, NewSharedIteratorDatastoreStorage(),
+		WithSharedIteratorDatastoreLogger(logger.NewNoopLogger()),
+		WithMaxIdleTime(50*time.Millisecond))
+
+	filter := storage.ReadFilter{Object: "document:1", Relation: "viewer"}
+	expiresAt := time.Now().Add(time.Hour)
+
+	mockIterator := mocks.NewMockIterator[*openfgav1.Tuple](mockController)
+	gomock.InOrder(
+		mockIterator.EXPECT().Next(gomock.Any()).Return(&openfgav1.Tuple{Key: tuple.NewTupleKey("document:1", "viewer", "user:jon")}, nil),
+		mockIterator.EXPECT().Next(gomock.Any()).DoAndReturn(func(ctx context.Context) (*openfgav1.Tuple, error) {
+			storage.ObserveTupleExpiry(ctx, expiresAt)
+			return &openfgav1.Tuple{Key: tuple.NewTupleKey("document:1", "viewer", "user:bob")}, nil
+		}),
+		mockIterator.EXPECT().Next(gomock.Any()).Return(nil, storage.ErrIteratorDone),
+	)
+	mockIterator.EXPECT().Stop()
+	mockDatastore.EXPECT().
+		Read(gomock.Any(), storeID, filter, storage.ReadOptions{}).
+		Return(mockIterator, nil)
+
+	iter1, err := ds.Read(ctx, storeID, filter, storage.ReadOptions{})
+	require.NoError(t, err)
+	iter2, err := ds.Read(ctx, storeID, filter, storage.ReadOptions{})
+	require.NoError(t, err)
+
+	// Every clone reports the expiries of the tuples that it reads.
+	for _, iter := range []storage.TupleIterator{iter1, iter2} {
+		var recorder storage.TupleExpiryRecorder
+		observingCtx := storage.ContextWithTupleExpiryObserver(ctx, recorder.Observe)
+
+		_, err := iter.Next(observingCtx)
+		require.NoError(t, err)
+		require.Zero(t, recorder.Earliest())
+
+		_, err = iter.Head(observingCtx)
+		require.NoError(t, err)
+		require.Equal(t, expiresAt, recorder.Earliest())
+
+		_, err = iter.Next(observingCtx)
+		require.NoError(t, err)
+		_, err = iter.Next(observingCtx)
+		require.ErrorIs(t, err, storage.ErrIteratorDone)
+	}
+	iter1.Stop()
+	iter2.Stop()
+	time.Sleep(100 * time.Millisecond) // ensure the internal map is cleaned up
+}
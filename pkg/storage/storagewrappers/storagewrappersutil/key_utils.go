@@ -48,6 +48,10 @@ func ReadStartingWithUserKey(
 
 		b.WriteString("/" + strconv.FormatUint(hasher.Sum64(), 10))
 	}
+
+	if filter.AfterObjectID != "" {
+		b.WriteString("/>" + filter.AfterObjectID)
+	}
 	return b.String(), nil
 }
 
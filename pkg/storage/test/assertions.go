@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
@@ -127,3 +129,34 @@ func AssertionsTest(t *testing.T, datastore storage.OpenFGADatastore) {
 		require.Empty(t, gotAssertions)
 	})
 }
+
+func ConcurrentWriteAssertionsTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	ctx := context.Background()
+	store := ulid.Make().String()
+	modelID := ulid.Make().String()
+
+	const numWriters = 10
+	written := make([][]*openfgav1.Assertion, numWriters)
+	for i := range written {
+		written[i] = []*openfgav1.Assertion{
+			{
+				TupleKey:    tupleUtils.NewAssertionTupleKey("doc:readme", "viewer", "user:"+strconv.Itoa(i)),
+				Expectation: true,
+			},
+		}
+	}
+
+	var wg errgroup.Group
+	for _, assertions := range written {
+		wg.Go(func() error {
+			return datastore.WriteAssertions(ctx, store, modelID, assertions)
+		})
+	}
+	require.NoError(t, wg.Wait())
+
+	got, err := datastore.ReadAssertions(ctx, store, modelID)
+	require.NoError(t, err)
+	require.True(t, slices.ContainsFunc(written, func(assertions []*openfgav1.Assertion) bool {
+		return cmp.Diff(assertions, got, cmpOpts...) == ""
+	}), "read assertions do not match any of the concurrent writes")
+}
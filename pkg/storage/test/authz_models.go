@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 	parser "github.com/openfga/language/pkg/go/transformer"
@@ -287,3 +288,34 @@ func FindLatestAuthorizationModelTest(t *testing.T, datastore storage.OpenFGADat
 		}
 	})
 }
+
+func ConcurrentWriteAuthorizationModelTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	ctx := context.Background()
+	storeID := ulid.Make().String()
+
+	const numModels = 10
+	models := make([]*openfgav1.AuthorizationModel, numModels)
+	for i := range models {
+		models[i] = &openfgav1.AuthorizationModel{
+			Id:              ulid.Make().String(),
+			SchemaVersion:   typesystem.SchemaVersion1_1,
+			TypeDefinitions: []*openfgav1.TypeDefinition{{Type: "folder"}},
+		}
+	}
+
+	var wg errgroup.Group
+	for _, model := range models {
+		wg.Go(func() error {
+			return datastore.WriteAuthorizationModel(ctx, storeID, model)
+		})
+	}
+	require.NoError(t, wg.Wait())
+
+	for _, model := range models {
+		got, err := datastore.ReadAuthorizationModel(ctx, storeID, model.GetId())
+		require.NoError(t, err)
+		if diff := cmp.Diff(model, got, cmpOpts...); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
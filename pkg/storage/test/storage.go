@@ -36,6 +36,9 @@ func RunAllTests(t *testing.T, ds storage.OpenFGADatastore) {
 	// Tuples.
 	t.Run("TestTupleWriteAndRead", func(t *testing.T) { TupleWritingAndReadingTest(t, ds) })
 	t.Run("TestReadChanges", func(t *testing.T) { ReadChangesTest(t, ds) })
+	t.Run("TestPruneChanges", func(t *testing.T) { PruneChangesTest(t, ds) })
+	t.Run("TestTupleExpiry", func(t *testing.T) { TupleExpiryTest(t, ds) })
+	t.Run("TestBulkLoad", func(t *testing.T) { BulkLoadTest(t, ds) })
 	t.Run("TestReadStartingWithUser", func(t *testing.T) { ReadStartingWithUserTest(t, ds) })
 	t.Run("TestReadAndReadPages", func(t *testing.T) { ReadAndReadPageTest(t, ds) })
 
@@ -43,12 +46,17 @@ func RunAllTests(t *testing.T, ds storage.OpenFGADatastore) {
 	t.Run("TestWriteAndReadAuthorizationModel", func(t *testing.T) { WriteAndReadAuthorizationModelTest(t, ds) })
 	t.Run("TestReadAuthorizationModels", func(t *testing.T) { ReadAuthorizationModelsTest(t, ds) })
 	t.Run("TestFindLatestAuthorizationModel", func(t *testing.T) { FindLatestAuthorizationModelTest(t, ds) })
+	t.Run("TestConcurrentWriteAuthorizationModel", func(t *testing.T) { ConcurrentWriteAuthorizationModelTest(t, ds) })
 
 	// Assertions.
 	t.Run("TestWriteAndReadAssertions", func(t *testing.T) { AssertionsTest(t, ds) })
+	t.Run("TestConcurrentWriteAssertions", func(t *testing.T) { ConcurrentWriteAssertionsTest(t, ds) })
 
 	// Stores.
 	t.Run("TestStore", func(t *testing.T) { StoreTest(t, ds) })
+	t.Run("TestConcurrentCreateStore", func(t *testing.T) { ConcurrentCreateStoreTest(t, ds) })
+	t.Run("TestConcurrentDeleteStore", func(t *testing.T) { ConcurrentDeleteStoreTest(t, ds) })
+	t.Run("TestCloneStore", func(t *testing.T) { CloneStoreTest(t, ds) })
 }
 
 // BootstrapFGAStore is a utility to write an FGA model and relationship tuples to a datastore.
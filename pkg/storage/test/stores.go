@@ -2,16 +2,24 @@ package test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+	parser "github.com/openfga/language/pkg/go/transformer"
 
 	"github.com/openfga/openfga/pkg/storage"
 	"github.com/openfga/openfga/pkg/testutils"
+	"github.com/openfga/openfga/pkg/tuple"
+	"github.com/openfga/openfga/pkg/typesystem"
 )
 
 func StoreTest(t *testing.T, datastore storage.OpenFGADatastore) {
@@ -213,3 +221,201 @@ func StoreTest(t *testing.T, datastore storage.OpenFGADatastore) {
 		}
 	})
 }
+
+func ConcurrentCreateStoreTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	ctx := context.Background()
+	id := ulid.Make().String()
+
+	// Every writer creates the same store at once, so that their transactions conflict.
+	const numWriters = 10
+	start := make(chan struct{})
+	errs := make([]error, numWriters)
+
+	var wg sync.WaitGroup
+	for i := range numWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[i] = datastore.CreateStore(ctx, &openfgav1.Store{Id: id, Name: "concurrent"})
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	var created int
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		require.ErrorIs(t, err, storage.ErrCollision)
+	}
+	require.Equal(t, 1, created)
+
+	store, err := datastore.GetStore(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, id, store.GetId())
+}
+
+func ConcurrentDeleteStoreTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	ctx := context.Background()
+
+	store, err := datastore.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "concurrent"})
+	require.NoError(t, err)
+
+	const numDeletes = 10
+	var wg errgroup.Group
+	for range numDeletes {
+		wg.Go(func() error {
+			return datastore.DeleteStore(ctx, store.GetId())
+		})
+	}
+	require.NoError(t, wg.Wait())
+
+	_, err = datastore.GetStore(ctx, store.GetId())
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+// CloneStoreTest tests the copy of stores by datastores implementing [storage.StoreCloner].
+func CloneStoreTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	cloner, ok := datastore.(storage.StoreCloner)
+	if !ok {
+		t.Skip("datastore does not implement storage.StoreCloner")
+	}
+	ctx := context.Background()
+
+	source, err := datastore.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "source"})
+	require.NoError(t, err)
+
+	model := &openfgav1.AuthorizationModel{
+		Id:            ulid.Make().String(),
+		SchemaVersion: typesystem.SchemaVersion1_1,
+		TypeDefinitions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			type document
+				relations
+					define viewer: [user, user with in_office]
+
+			condition in_office(office: string) {
+				office == "hq"
+			}`).GetTypeDefinitions(),
+		Conditions: parser.MustTransformDSLToProto(`
+			model
+				schema 1.1
+
+			type user
+
+			condition in_office(office: string) {
+				office == "hq"
+			}`).GetConditions(),
+	}
+	require.NoError(t, datastore.WriteAuthorizationModel(ctx, source.GetId(), model))
+
+	assertions := []*openfgav1.Assertion{{
+		TupleKey:    tuple.NewAssertionTupleKey("document:0", "viewer", "user:jon"),
+		Expectation: true,
+	}}
+	require.NoError(t, datastore.WriteAssertions(ctx, source.GetId(), model.GetId(), assertions))
+
+	tuples := []*openfgav1.TupleKey{
+		tuple.NewTupleKeyWithCondition("document:conditional", "viewer", "user:jon", "in_office", nil),
+	}
+	for i := 0; i < 4; i++ {
+		tuples = append(tuples, tuple.NewTupleKey(fmt.Sprintf("document:%d", i), "viewer", "user:jon"))
+	}
+	require.NoError(t, datastore.Write(ctx, source.GetId(), nil, tuples))
+
+	readTuples := func(t *testing.T, store string) []*openfgav1.TupleKey {
+		t.Helper()
+		page, _, err := datastore.ReadPage(ctx, store, storage.ReadFilter{}, storage.ReadPageOptions{
+			Pagination: storage.NewPaginationOptions(100, ""),
+		})
+		require.NoError(t, err)
+
+		keys := make([]*openfgav1.TupleKey, 0, len(page))
+		for _, tk := range page {
+			keys = append(keys, tk.GetKey())
+		}
+		return keys
+	}
+
+	target := &openfgav1.Store{Id: ulid.Make().String(), Name: "clone"}
+	var progress []int
+	clone, err := cloner.CloneStore(ctx, source.GetId(), target, storage.CloneStoreOptions{
+		BatchSize: 2,
+		OnProgress: func(copiedTuples int) {
+			progress = append(progress, copiedTuples)
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, target.GetId(), clone.GetId())
+	require.Equal(t, "clone", clone.GetName())
+	require.NotEmpty(t, progress)
+	require.Equal(t, len(tuples), progress[len(progress)-1])
+	require.IsNonDecreasing(t, progress)
+
+	t.Run("copies_the_store", func(t *testing.T) {
+		got, err := datastore.GetStore(ctx, target.GetId())
+		require.NoError(t, err)
+		require.Equal(t, "clone", got.GetName())
+
+		gotModel, err := datastore.ReadAuthorizationModel(ctx, target.GetId(), model.GetId())
+		require.NoError(t, err)
+		if diff := cmp.Diff(model, gotModel, protocmp.Transform()); diff != "" {
+			t.Errorf("model mismatch (-want +got):\n%s", diff)
+		}
+
+		gotAssertions, err := datastore.ReadAssertions(ctx, target.GetId(), model.GetId())
+		require.NoError(t, err)
+		if diff := cmp.Diff(assertions, gotAssertions, protocmp.Transform()); diff != "" {
+			t.Errorf("assertions mismatch (-want +got):\n%s", diff)
+		}
+
+		// The copy keeps the order of the tuples.
+		if diff := cmp.Diff(readTuples(t, source.GetId()), readTuples(t, target.GetId()), cmpOpts...); diff != "" {
+			t.Errorf("tuples mismatch (-want +got):\n%s", diff)
+		}
+
+		_, _, err = datastore.ReadChanges(ctx, target.GetId(), storage.ReadChangesFilter{}, storage.ReadChangesOptions{})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		// The state of the copy before the clone cannot be reconstructed from its changelog.
+		beforeClone := ulid.MustNew(ulid.Timestamp(time.Now().Add(-time.Minute)), nil).String()
+		_, _, err = datastore.ReadChanges(ctx, target.GetId(), storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(1, beforeClone),
+			CheckHorizon: true,
+		})
+		require.ErrorIs(t, err, storage.ErrChangelogTruncated)
+	})
+
+	t.Run("the_copy_is_independent", func(t *testing.T) {
+		err := datastore.Write(ctx, target.GetId(), nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:new", "viewer", "user:jon"),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, readTuples(t, target.GetId()), len(tuples)+1)
+		require.Len(t, readTuples(t, source.GetId()), len(tuples))
+	})
+
+	t.Run("cloning_twice", func(t *testing.T) {
+		again, err := cloner.CloneStore(ctx, source.GetId(), &openfgav1.Store{Id: ulid.Make().String(), Name: "again"}, storage.CloneStoreOptions{})
+		require.NoError(t, err)
+		require.Len(t, readTuples(t, again.GetId()), len(tuples))
+	})
+
+	t.Run("target_exists", func(t *testing.T) {
+		_, err := cloner.CloneStore(ctx, source.GetId(), target, storage.CloneStoreOptions{})
+		require.ErrorIs(t, err, storage.ErrCollision)
+	})
+
+	t.Run("source_not_found", func(t *testing.T) {
+		_, err := cloner.CloneStore(ctx, ulid.Make().String(), &openfgav1.Store{Id: ulid.Make().String(), Name: "missing"}, storage.CloneStoreOptions{})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -621,6 +621,406 @@ func ReadChangesTest(t *testing.T, datastore storage.OpenFGADatastore) {
 	})
 }
 
+// PruneChangesTest tests the pruning of the changelog of datastores implementing
+// [storage.ChangelogPruner].
+func PruneChangesTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	pruner, ok := datastore.(storage.ChangelogPruner)
+	if !ok {
+		t.Skip("datastore does not implement storage.ChangelogPruner")
+	}
+	ctx := context.Background()
+
+	// writeChanges creates a store and writes one change per tuple of the given count.
+	writeChanges := func(t *testing.T, count int) string {
+		store, err := datastore.CreateStore(ctx, &openfgav1.Store{Id: ulid.Make().String(), Name: "prune"})
+		require.NoError(t, err)
+
+		for i := 0; i < count; i++ {
+			err := datastore.Write(ctx, store.GetId(), nil, []*openfgav1.TupleKey{
+				tuple.NewTupleKey(fmt.Sprintf("document:%d", i), "viewer", "user:jon"),
+			})
+			require.NoError(t, err)
+		}
+		return store.GetId()
+	}
+
+	t.Run("by_count", func(t *testing.T) {
+		storeID := writeChanges(t, 10)
+		_, beforeToken, err := datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination: storage.NewPaginationOptions(2, ""),
+		})
+		require.NoError(t, err)
+
+		deleted, err := pruner.PruneChanges(ctx, storeID, storage.PruneChangesOptions{MaxChanges: 4, BatchSize: 3})
+		require.NoError(t, err)
+		require.Equal(t, 6, deleted)
+
+		changes := readChangesWithPageSize(t, datastore, storeID, 100, "")
+		require.Len(t, changes, 4)
+		require.Equal(t, "document:6", changes[0].GetTupleKey().GetObject())
+
+		_, _, err = datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(2, beforeToken),
+			CheckHorizon: true,
+		})
+		require.ErrorIs(t, err, storage.ErrChangelogTruncated)
+
+		// Without checking the horizon, the changes left are read.
+		changes, _, err = datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination: storage.NewPaginationOptions(2, beforeToken),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "document:6", changes[0].GetTupleKey().GetObject())
+
+		// Nothing left to prune.
+		deleted, err = pruner.PruneChanges(ctx, storeID, storage.PruneChangesOptions{MaxChanges: 4})
+		require.NoError(t, err)
+		require.Zero(t, deleted)
+	})
+
+	t.Run("by_age", func(t *testing.T) {
+		storeID := writeChanges(t, 3)
+		time.Sleep(50 * time.Millisecond)
+		err := datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:recent", "viewer", "user:jon"),
+		})
+		require.NoError(t, err)
+
+		deleted, err := pruner.PruneChanges(ctx, storeID, storage.PruneChangesOptions{MaxAge: 25 * time.Millisecond})
+		require.NoError(t, err)
+		require.Equal(t, 3, deleted)
+
+		changes, token, err := datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{})
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		require.Equal(t, "document:recent", changes[0].GetTupleKey().GetObject())
+
+		// Reading from the latest change is not affected by the horizon.
+		_, _, err = datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(1, token),
+			CheckHorizon: true,
+		})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("other_stores_are_not_pruned", func(t *testing.T) {
+		storeID := writeChanges(t, 3)
+		otherStoreID := writeChanges(t, 3)
+
+		_, err := pruner.PruneChanges(ctx, storeID, storage.PruneChangesOptions{MaxChanges: 1})
+		require.NoError(t, err)
+
+		require.Len(t, readChangesWithPageSize(t, datastore, otherStoreID, 100, ""), 3)
+	})
+}
+
+// TupleExpiryTest tests that reads ignore expired tuples and report the expiry of the others, and
+// the deletion of expired tuples by datastores implementing [storage.TupleExpirer].
+func TupleExpiryTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	expirer, ok := datastore.(storage.TupleExpirer)
+	if !ok {
+		t.Skip("datastore does not implement storage.TupleExpirer")
+	}
+	ctx := context.Background()
+
+	storeID := ulid.Make().String()
+	expired := tuple.NewTupleKey("document:expired", "viewer", "user:jon")
+	rewritten := tuple.NewTupleKey("document:rewritten", "viewer", "user:jon")
+	live := tuple.NewTupleKey("document:live", "viewer", "user:jon")
+	userset := tuple.NewTupleKey("document:live", "viewer", "group:eng#member")
+	later := tuple.NewTupleKey("document:later", "viewer", "user:jon")
+	laterExpiresAt := time.Now().Add(time.Hour)
+
+	err := datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{expired, rewritten, userset}, storage.WithExpiresAt(time.Now().Add(-time.Second)))
+	require.NoError(t, err)
+	err = datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{later}, storage.WithExpiresAt(laterExpiresAt))
+	require.NoError(t, err)
+	err = datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{live})
+	require.NoError(t, err)
+
+	t.Run("reads_ignore_expired_tuples", func(t *testing.T) {
+		iter, err := datastore.Read(ctx, storeID, storage.ReadFilter{}, storage.ReadOptions{})
+		require.NoError(t, err)
+		defer iter.Stop()
+		if diff := cmp.Diff([]*openfgav1.TupleKey{later, live}, iterateThroughAllTuples(t, iter), cmpSortTupleKeys...); diff != "" {
+			t.Fatalf("mismatch (-want +got):\n%s", diff)
+		}
+
+		_, err = datastore.ReadUserTuple(ctx, storeID, storage.ReadUserTupleFilter{Object: expired.GetObject(), Relation: expired.GetRelation(), User: expired.GetUser()}, storage.ReadUserTupleOptions{})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		_, err = datastore.ReadUserTuple(ctx, storeID, storage.ReadUserTupleFilter{Object: later.GetObject(), Relation: later.GetRelation(), User: later.GetUser()}, storage.ReadUserTupleOptions{})
+		require.NoError(t, err)
+
+		iter, err = datastore.ReadUsersetTuples(ctx, storeID, storage.ReadUsersetTuplesFilter{Object: userset.GetObject(), Relation: userset.GetRelation()}, storage.ReadUsersetTuplesOptions{})
+		require.NoError(t, err)
+		defer iter.Stop()
+		require.Empty(t, iterateThroughAllTuples(t, iter))
+
+		iter, err = datastore.ReadStartingWithUser(ctx, storeID, storage.ReadStartingWithUserFilter{
+			ObjectType: "document",
+			Relation:   "viewer",
+			UserFilter: []*openfgav1.ObjectRelation{{Object: "user:jon"}},
+		}, storage.ReadStartingWithUserOptions{})
+		require.NoError(t, err)
+		defer iter.Stop()
+		if diff := cmp.Diff([]*openfgav1.TupleKey{later, live}, iterateThroughAllTuples(t, iter), cmpSortTupleKeys...); diff != "" {
+			t.Fatalf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("reads_report_expiries", func(t *testing.T) {
+		// The datastores may store the expiries with less precision than time.Time.
+		requireExpiry := func(t *testing.T, expected time.Time, read func(ctx context.Context)) {
+			var recorder storage.TupleExpiryRecorder
+			read(storage.ContextWithTupleExpiryObserver(ctx, recorder.Observe))
+			if expected.IsZero() {
+				require.Zero(t, recorder.Earliest())
+			} else {
+				require.WithinDuration(t, expected, recorder.Earliest(), time.Millisecond)
+			}
+		}
+		countTuples := func(ctx context.Context, iter storage.TupleIterator) int {
+			defer iter.Stop()
+			var n int
+			for {
+				_, err := iter.Next(ctx)
+				if err != nil {
+					require.ErrorIs(t, err, storage.ErrIteratorDone)
+					return n
+				}
+				n++
+			}
+		}
+
+		requireExpiry(t, laterExpiresAt, func(ctx context.Context) {
+			iter, err := datastore.Read(ctx, storeID, storage.ReadFilter{}, storage.ReadOptions{})
+			require.NoError(t, err)
+			require.Equal(t, 2, countTuples(ctx, iter))
+		})
+
+		requireExpiry(t, time.Time{}, func(ctx context.Context) {
+			iter, err := datastore.Read(ctx, storeID, storage.ReadFilter{Object: live.GetObject()}, storage.ReadOptions{})
+			require.NoError(t, err)
+			require.Equal(t, 1, countTuples(ctx, iter))
+		})
+
+		requireExpiry(t, laterExpiresAt, func(ctx context.Context) {
+			_, err := datastore.ReadUserTuple(ctx, storeID, storage.ReadUserTupleFilter{Object: later.GetObject(), Relation: later.GetRelation(), User: later.GetUser()}, storage.ReadUserTupleOptions{})
+			require.NoError(t, err)
+		})
+
+		requireExpiry(t, laterExpiresAt, func(ctx context.Context) {
+			iter, err := datastore.ReadStartingWithUser(ctx, storeID, storage.ReadStartingWithUserFilter{
+				ObjectType: "document",
+				Relation:   "viewer",
+				UserFilter: []*openfgav1.ObjectRelation{{Object: "user:jon"}},
+			}, storage.ReadStartingWithUserOptions{})
+			require.NoError(t, err)
+			require.Equal(t, 2, countTuples(ctx, iter))
+		})
+	})
+
+	t.Run("reads_at_an_expiry_time", func(t *testing.T) {
+		readAt := func(t *testing.T, expiryTime time.Time) []*openfgav1.TupleKey {
+			ctx := storage.ContextWithTupleExpiryTime(ctx, expiryTime)
+			iter, err := datastore.Read(ctx, storeID, storage.ReadFilter{}, storage.ReadOptions{})
+			require.NoError(t, err)
+			defer iter.Stop()
+			return iterateThroughAllTuples(t, iter)
+		}
+
+		if diff := cmp.Diff([]*openfgav1.TupleKey{expired, rewritten, userset, later, live}, readAt(t, time.Now().Add(-time.Minute)), cmpSortTupleKeys...); diff != "" {
+			t.Fatalf("mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]*openfgav1.TupleKey{live}, readAt(t, laterExpiresAt), cmpSortTupleKeys...); diff != "" {
+			t.Fatalf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("changes_report_expiries", func(t *testing.T) {
+		expiries := make(map[string]time.Time)
+		ctx := storage.ContextWithChangeExpiryObserver(ctx, func(change *openfgav1.TupleChange, expiresAt time.Time) {
+			expiries[tuple.TupleKeyToString(change.GetTupleKey())] = expiresAt
+		})
+		changes, _, err := datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination: storage.NewPaginationOptions(100, ""),
+		})
+		require.NoError(t, err)
+		require.Len(t, changes, 5)
+
+		require.Len(t, expiries, 4)
+		require.WithinDuration(t, laterExpiresAt, expiries[tuple.TupleKeyToString(later)], time.Millisecond)
+		require.NotContains(t, expiries, tuple.TupleKeyToString(live))
+	})
+
+	t.Run("deleting_an_expired_tuple_fails", func(t *testing.T) {
+		err := datastore.Write(ctx, storeID, []*openfgav1.TupleKeyWithoutCondition{tuple.TupleKeyToTupleKeyWithoutCondition(expired)}, nil)
+		require.ErrorIs(t, err, storage.ErrInvalidWriteInput)
+	})
+
+	t.Run("writing_over_an_expired_tuple", func(t *testing.T) {
+		err := datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{rewritten})
+		require.NoError(t, err)
+
+		_, err = datastore.ReadUserTuple(ctx, storeID, storage.ReadUserTupleFilter{Object: rewritten.GetObject(), Relation: rewritten.GetRelation(), User: rewritten.GetUser()}, storage.ReadUserTupleOptions{})
+		require.NoError(t, err)
+
+		changes := readChangesWithPageSize(t, datastore, storeID, 100, "")
+		require.Len(t, changes, 7)
+		require.Equal(t, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE, changes[5].GetOperation())
+		require.Equal(t, rewritten.GetObject(), changes[5].GetTupleKey().GetObject())
+		require.Equal(t, openfgav1.TupleOperation_TUPLE_OPERATION_WRITE, changes[6].GetOperation())
+		require.Equal(t, rewritten.GetObject(), changes[6].GetTupleKey().GetObject())
+	})
+
+	t.Run("delete_expired_tuples", func(t *testing.T) {
+		deleted, err := expirer.DeleteExpiredTuples(ctx, time.Now(), 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, deleted)
+
+		deleted, err = expirer.DeleteExpiredTuples(ctx, time.Now(), 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, deleted)
+
+		// Nothing left to delete.
+		deleted, err = expirer.DeleteExpiredTuples(ctx, time.Now(), 0)
+		require.NoError(t, err)
+		require.Zero(t, deleted)
+
+		changes := readChangesWithPageSize(t, datastore, storeID, 100, "")
+		require.Len(t, changes, 9)
+		deletedObjects := make([]string, 0, 2)
+		for _, change := range changes[7:] {
+			require.Equal(t, openfgav1.TupleOperation_TUPLE_OPERATION_DELETE, change.GetOperation())
+			deletedObjects = append(deletedObjects, change.GetTupleKey().GetObject()+"#"+change.GetTupleKey().GetUser())
+		}
+		require.ElementsMatch(t, []string{"document:expired#user:jon", "document:live#group:eng#member"}, deletedObjects)
+
+		// The tuples that expire later are not deleted.
+		_, err = datastore.ReadUserTuple(ctx, storeID, storage.ReadUserTupleFilter{Object: later.GetObject(), Relation: later.GetRelation(), User: later.GetUser()}, storage.ReadUserTupleOptions{})
+		require.NoError(t, err)
+	})
+}
+
+// BulkLoadTest tests the bulk loading of tuples by datastores implementing [storage.BulkLoader].
+func BulkLoadTest(t *testing.T, datastore storage.OpenFGADatastore) {
+	loader, ok := datastore.(storage.BulkLoader)
+	if !ok {
+		t.Skip("datastore does not implement storage.BulkLoader")
+	}
+	ctx := context.Background()
+
+	readAll := func(t *testing.T, storeID string) []*openfgav1.TupleKey {
+		iter, err := datastore.Read(ctx, storeID, storage.ReadFilter{}, storage.ReadOptions{})
+		require.NoError(t, err)
+		defer iter.Stop()
+		return iterateThroughAllTuples(t, iter)
+	}
+
+	// More tuples than a write accepts, so that the limit is shown to not apply.
+	tuples := make([]*openfgav1.TupleKey, 0, datastore.MaxTuplesPerWrite()+1)
+	for i := 0; i < cap(tuples)-1; i++ {
+		tuples = append(tuples, tuple.NewTupleKey(fmt.Sprintf("document:%d", i), "viewer", "user:jon"))
+	}
+	conditional := tuple.NewTupleKeyWithCondition("document:conditional", "viewer", "user:jon", "condition", testutils.MustNewStruct(t, map[string]interface{}{"param": "ok"}))
+	tuples = append(tuples, conditional)
+
+	t.Run("loads_the_tuples", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		written, err := loader.BulkLoad(ctx, storeID, tuples, storage.BulkLoadOptions{})
+		require.NoError(t, err)
+		require.Equal(t, len(tuples), written)
+
+		if diff := cmp.Diff(tuples, readAll(t, storeID), cmpSortTupleKeys...); diff != "" {
+			t.Fatalf("mismatch (-want +got):\n%s", diff)
+		}
+
+		changes := readChangesWithPageSize(t, datastore, storeID, len(tuples)+1, "")
+		require.Len(t, changes, len(tuples))
+		for _, change := range changes {
+			require.Equal(t, openfgav1.TupleOperation_TUPLE_OPERATION_WRITE, change.GetOperation())
+		}
+	})
+
+	t.Run("skips_the_existing_and_repeated_tuples", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		existing := tuple.NewTupleKey("document:existing", "viewer", "user:jon")
+		expired := tuple.NewTupleKey("document:expired", "viewer", "user:jon")
+		require.NoError(t, datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{existing}))
+		require.NoError(t, datastore.Write(ctx, storeID, nil, []*openfgav1.TupleKey{expired}, storage.WithExpiresAt(time.Now().Add(-time.Second))))
+
+		// The expired tuple is replaced, like a write would.
+		loaded := tuple.NewTupleKey("document:loaded", "viewer", "user:jon")
+		written, err := loader.BulkLoad(ctx, storeID, []*openfgav1.TupleKey{existing, expired, loaded, loaded}, storage.BulkLoadOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 2, written)
+
+		// Loading the same tuples again is a no-op.
+		written, err = loader.BulkLoad(ctx, storeID, []*openfgav1.TupleKey{existing, expired, loaded}, storage.BulkLoadOptions{})
+		require.NoError(t, err)
+		require.Zero(t, written)
+
+		if diff := cmp.Diff([]*openfgav1.TupleKey{existing, expired, loaded}, readAll(t, storeID), cmpSortTupleKeys...); diff != "" {
+			t.Fatalf("mismatch (-want +got):\n%s", diff)
+		}
+
+		changes := readChangesWithPageSize(t, datastore, storeID, 100, "")
+		operations := make([]string, 0, len(changes))
+		for _, change := range changes {
+			operations = append(operations, change.GetOperation().String()+" "+tuple.TupleKeyToString(change.GetTupleKey()))
+		}
+		require.ElementsMatch(t, []string{
+			"TUPLE_OPERATION_WRITE document:existing#viewer@user:jon",
+			"TUPLE_OPERATION_WRITE document:expired#viewer@user:jon",
+			"TUPLE_OPERATION_DELETE document:expired#viewer@user:jon",
+			"TUPLE_OPERATION_WRITE document:expired#viewer@user:jon",
+			"TUPLE_OPERATION_WRITE document:loaded#viewer@user:jon",
+		}, operations)
+	})
+
+	t.Run("skip_changelog", func(t *testing.T) {
+		storeID := ulid.Make().String()
+		written, err := loader.BulkLoad(ctx, storeID, tuples, storage.BulkLoadOptions{SkipChangelog: true})
+		require.NoError(t, err)
+		require.Equal(t, len(tuples), written)
+		require.Len(t, readAll(t, storeID), len(tuples))
+
+		_, _, err = datastore.ReadChanges(ctx, storeID, storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination: storage.NewPaginationOptions(storage.DefaultPageSize, ""),
+		})
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("skip_changelog_advances_the_changelog_horizon", func(t *testing.T) {
+		store := &openfgav1.Store{Id: ulid.Make().String(), Name: "bulk-load"}
+		_, err := datastore.CreateStore(ctx, store)
+		require.NoError(t, err)
+		require.NoError(t, datastore.Write(ctx, store.GetId(), nil, []*openfgav1.TupleKey{tuple.NewTupleKey("document:written", "viewer", "user:jon")}))
+		beforeLoad := ulid.MustNew(ulid.Timestamp(time.Now().Add(-time.Minute)), nil).String()
+
+		// Loading nothing leaves the changelog whole.
+		written, err := loader.BulkLoad(ctx, store.GetId(), []*openfgav1.TupleKey{tuple.NewTupleKey("document:written", "viewer", "user:jon")}, storage.BulkLoadOptions{SkipChangelog: true})
+		require.NoError(t, err)
+		require.Zero(t, written)
+		_, _, err = datastore.ReadChanges(ctx, store.GetId(), storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(1, beforeLoad),
+			CheckHorizon: true,
+		})
+		require.NoError(t, err)
+
+		// The state of the store before the load cannot be reconstructed from its changelog.
+		written, err = loader.BulkLoad(ctx, store.GetId(), tuples, storage.BulkLoadOptions{SkipChangelog: true})
+		require.NoError(t, err)
+		require.Equal(t, len(tuples), written)
+		_, _, err = datastore.ReadChanges(ctx, store.GetId(), storage.ReadChangesFilter{}, storage.ReadChangesOptions{
+			Pagination:   storage.NewPaginationOptions(1, beforeLoad),
+			CheckHorizon: true,
+		})
+		require.ErrorIs(t, err, storage.ErrChangelogTruncated)
+	})
+}
+
 func TupleWritingAndReadingTest(t *testing.T, datastore storage.OpenFGADatastore) {
 	ctx := context.Background()
 
@@ -2199,6 +2599,52 @@ func ReadStartingWithUserTest(t *testing.T, datastore storage.OpenFGADatastore)
 		}
 		require.Equal(t, []string{"doc3", "doc4", "doc5", "doc6"}, actualObjectIDs)
 	})
+	t.Run("enforce_order_of_tuples_after_object_id", func(t *testing.T) {
+		storeID := ulid.Make().String()
+
+		var tupleInReverseOrder = []*openfgav1.TupleKey{
+			tuple.NewTupleKey("document:doc5", "viewer", "user:bob"),
+			tuple.NewTupleKey("document:doc4", "viewer", "user:*"),
+			tuple.NewTupleKey("document:doc3", "viewer", "user:bob"),
+			tuple.NewTupleKey("document:doc3", "viewer", "user:*"),
+			tuple.NewTupleKey("document:doc2", "viewer", "user:bob"),
+			tuple.NewTupleKey("document:doc10", "viewer", "user:bob"),
+			tuple.NewTupleKey("document:doc1", "viewer", "user:bob"),
+		}
+
+		err := datastore.Write(ctx, storeID, nil, tupleInReverseOrder)
+		require.NoError(t, err)
+
+		tupleIterator, err := datastore.ReadStartingWithUser(
+			ctx,
+			storeID,
+			storage.ReadStartingWithUserFilter{
+				ObjectType: "document",
+				Relation:   "viewer",
+				UserFilter: []*openfgav1.ObjectRelation{
+					{
+						Object: "user:bob",
+					},
+					{
+						Object: "user:*",
+					},
+				},
+				AfterObjectID: "doc2",
+			},
+			storage.ReadStartingWithUserOptions{
+				WithResultsSortedAscending: true,
+			},
+		)
+		require.NoError(t, err)
+
+		tuples := iterateThroughAllTuples(t, tupleIterator)
+		var actualObjectIDs []string
+		for _, item := range tuples {
+			_, objectID := tuple.SplitObject(item.GetObject())
+			actualObjectIDs = append(actualObjectIDs, objectID)
+		}
+		require.Equal(t, []string{"doc3", "doc3", "doc4", "doc5"}, actualObjectIDs)
+	})
 }
 
 func ReadAndReadPageTest(t *testing.T, datastore storage.OpenFGADatastore) {
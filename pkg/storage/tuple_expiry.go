@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+)
+
+const (
+	tupleExpiryObserverCtxKey  ctxKey = "tuple-expiry-observer-context-key"
+	tupleExpiryTimeCtxKey      ctxKey = "tuple-expiry-time-context-key"
+	changeExpiryObserverCtxKey ctxKey = "change-expiry-observer-context-key"
+)
+
+// ContextWithTupleExpiryObserver returns a context derived from parent with which observe is
+// called with the expiry of every expiring tuple read, see [ObserveTupleExpiry]. The observers of
+// parent keep being called too.
+//
+// The caches holding results derived from the tuples read observe their expiries, so that the
+// results are not served once a tuple they depend on has expired.
+func ContextWithTupleExpiryObserver(parent context.Context, observe func(expiresAt time.Time)) context.Context {
+	if outer, ok := parent.Value(tupleExpiryObserverCtxKey).(func(time.Time)); ok {
+		inner := observe
+		observe = func(expiresAt time.Time) {
+			inner(expiresAt)
+			outer(expiresAt)
+		}
+	}
+	return context.WithValue(parent, tupleExpiryObserverCtxKey, observe)
+}
+
+// ObserveTupleExpiry reports to the observers of ctx, see [ContextWithTupleExpiryObserver], that a
+// tuple expiring at expiresAt was read. It does nothing for the zero time, which is the expiry of
+// the tuples that never expire.
+//
+// The datastores report the expiry of every tuple that they return to the context of the call
+// returning it, and so do the wrappers returning tuples that they read before.
+func ObserveTupleExpiry(ctx context.Context, expiresAt time.Time) {
+	if expiresAt.IsZero() {
+		return
+	}
+	if observe, ok := ctx.Value(tupleExpiryObserverCtxKey).(func(time.Time)); ok {
+		observe(expiresAt)
+	}
+}
+
+// ContextWithTupleExpiryTime returns a context derived from parent with which the datastores
+// evaluate the expiry of the tuples read at t rather than at the current time, see
+// [TupleExpiryTime]. The tuples that expired since t are then read, but not those that were
+// deleted since.
+func ContextWithTupleExpiryTime(parent context.Context, t time.Time) context.Context {
+	return context.WithValue(parent, tupleExpiryTimeCtxKey, t)
+}
+
+// TupleExpiryTime returns the time at which the reads made with ctx evaluate the expiry of the
+// tuples: the time set with [ContextWithTupleExpiryTime], or now.
+func TupleExpiryTime(ctx context.Context, now time.Time) time.Time {
+	if t, ok := ctx.Value(tupleExpiryTimeCtxKey).(time.Time); ok {
+		return t
+	}
+	return now
+}
+
+// ContextWithChangeExpiryObserver returns a context derived from parent with which observe is
+// called with every change read from the changelog that wrote an expiring tuple, along with the
+// expiry that it was written with, see [ObserveChangeExpiry].
+func ContextWithChangeExpiryObserver(parent context.Context, observe func(change *openfgav1.TupleChange, expiresAt time.Time)) context.Context {
+	return context.WithValue(parent, changeExpiryObserverCtxKey, observe)
+}
+
+// ObserveChangeExpiry reports to the observer of ctx, see [ContextWithChangeExpiryObserver], that
+// change, returned by [ChangelogBackend].ReadChanges, wrote a tuple expiring at expiresAt. It does
+// nothing for the zero time.
+//
+// The changes of a write do not hold the expiry of the tuples written, so the datastores report it
+// for every change that they return to the context of the call returning it.
+func ObserveChangeExpiry(ctx context.Context, change *openfgav1.TupleChange, expiresAt time.Time) {
+	if expiresAt.IsZero() {
+		return
+	}
+	if observe, ok := ctx.Value(changeExpiryObserverCtxKey).(func(*openfgav1.TupleChange, time.Time)); ok {
+		observe(change, expiresAt)
+	}
+}
+
+// TupleExpiryRecorder records the earliest expiry that it observes. It is safe for concurrent use,
+// and its zero value is ready to use.
+type TupleExpiryRecorder struct {
+	mu       sync.Mutex
+	earliest time.Time
+}
+
+// Observe records expiresAt if it is earlier than the expiries observed before.
+func (r *TupleExpiryRecorder) Observe(expiresAt time.Time) {
+	if expiresAt.IsZero() {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.earliest.IsZero() || expiresAt.Before(r.earliest) {
+		r.earliest = expiresAt
+	}
+}
+
+// Earliest returns the earliest expiry observed, or the zero time if none was.
+func (r *TupleExpiryRecorder) Earliest() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.earliest
+}
+
+// TTLUntilExpiry returns ttl capped at the time left until expiresAt, the earliest expiry of the
+// tuples that a cached value depends on, or ttl itself if expiresAt is the zero time. The value is
+// not to be cached when the returned TTL is not positive.
+func TTLUntilExpiry(ttl time.Duration, expiresAt time.Time) time.Duration {
+	if expiresAt.IsZero() {
+		return ttl
+	}
+	return min(ttl, time.Until(expiresAt))
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveTupleExpiry(t *testing.T) {
+	now := time.Now()
+
+	t.Run("no_observer", func(t *testing.T) {
+		ObserveTupleExpiry(context.Background(), now)
+	})
+
+	t.Run("nested_observers", func(t *testing.T) {
+		var outer, inner TupleExpiryRecorder
+		outerCtx := ContextWithTupleExpiryObserver(context.Background(), outer.Observe)
+		innerCtx := ContextWithTupleExpiryObserver(outerCtx, inner.Observe)
+
+		ObserveTupleExpiry(innerCtx, now.Add(time.Hour))
+		ObserveTupleExpiry(innerCtx, now.Add(time.Minute))
+		ObserveTupleExpiry(innerCtx, time.Time{})
+		ObserveTupleExpiry(outerCtx, now.Add(time.Second))
+
+		require.Equal(t, now.Add(time.Minute), inner.Earliest())
+		require.Equal(t, now.Add(time.Second), outer.Earliest())
+	})
+
+	t.Run("no_expiry", func(t *testing.T) {
+		var recorder TupleExpiryRecorder
+		ctx := ContextWithTupleExpiryObserver(context.Background(), recorder.Observe)
+		ObserveTupleExpiry(ctx, time.Time{})
+		require.True(t, recorder.Earliest().IsZero())
+	})
+}
+
+func TestTTLUntilExpiry(t *testing.T) {
+	require.Equal(t, time.Hour, TTLUntilExpiry(time.Hour, time.Time{}))
+	require.Equal(t, time.Second, TTLUntilExpiry(time.Second, time.Now().Add(time.Hour)))
+
+	ttl := TTLUntilExpiry(time.Hour, time.Now().Add(time.Minute))
+	require.LessOrEqual(t, ttl, time.Minute)
+	require.Greater(t, ttl, 30*time.Second)
+
+	require.LessOrEqual(t, TTLUntilExpiry(time.Hour, time.Now().Add(-time.Minute)), time.Duration(0))
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/sync/errgroup"
@@ -17,10 +18,15 @@ import (
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 
-	"github.com/openfga/openfga/pkg/testutils"
 	"github.com/openfga/openfga/pkg/tuple"
 )
 
+// sortTupleKeys sorts the tuple keys compared, like testutils.TupleKeyCmpTransformer, which the
+// storage tests cannot import since the server configuration it depends on imports the storage.
+var sortTupleKeys = cmpopts.SortSlices(func(a, b *openfgav1.TupleKey) bool {
+	return tuple.TupleKeyToString(a) < tuple.TupleKeyToString(b)
+})
+
 func TestStaticTupleKeyIterator(t *testing.T) {
 	t.Run("next", func(t *testing.T) {
 		expected := []*openfgav1.TupleKey{
@@ -214,7 +220,7 @@ func TestCombinedIterator(t *testing.T) {
 		}
 
 		cmpOpts := []cmp.Option{
-			testutils.TupleKeyCmpTransformer,
+			sortTupleKeys,
 			protocmp.Transform(),
 		}
 
@@ -626,7 +632,7 @@ func TestFilteredTupleKeyIterator(t *testing.T) {
 		}
 
 		cmpOpts := []cmp.Option{
-			testutils.TupleKeyCmpTransformer,
+			sortTupleKeys,
 			protocmp.Transform(),
 		}
 
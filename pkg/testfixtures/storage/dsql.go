@@ -1,9 +1,11 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,10 +18,26 @@ import (
 	"github.com/openfga/openfga/internal/dsql"
 )
 
+// dsqlEmulatorConflictRate is the probability that a commit fails with a synthetic OCC
+// conflict when the DSQL datastore runs in emulation mode.
+const dsqlEmulatorConflictRate = 0.1
+
+// dsqlEmulatorScheme is the URI scheme of the DSQL emulation mode.
+const dsqlEmulatorScheme = "dsql-emulator://"
+
 type dsqlTestContainer struct {
 	clusterEndpoint string
 	region          string
 	version         int64
+
+	// secondaryEndpoint and secondaryRegion address another region of a multi-region cluster.
+	hasSecondary      bool
+	secondaryEndpoint string
+	secondaryRegion   string
+
+	// emulator is the postgres container backing the offline DSQL emulation mode.
+	// It is nil when the tests run against a real DSQL cluster.
+	emulator DatastoreTestContainer
 }
 
 // NewDSQLTestContainer returns an implementation of the DatastoreTestContainer interface
@@ -35,10 +53,14 @@ func (d *dsqlTestContainer) GetDatabaseSchemaVersion() int64 {
 // RunDSQLTestContainer connects to a DSQL cluster, runs migrations, and returns a
 // bootstrapped implementation of the DatastoreTestContainer interface wired up for the
 // DSQL datastore engine.
+//
+// If OPENFGA_DSQL_CLUSTER_ENDPOINT is not set, a Postgres container is started instead and
+// the datastore runs in DSQL emulation mode: it uses the DSQL query shapes and injects
+// synthetic OCC conflicts at commit time.
 func (d *dsqlTestContainer) RunDSQLTestContainer(t testing.TB) DatastoreTestContainer {
 	clusterEndpoint := os.Getenv("OPENFGA_DSQL_CLUSTER_ENDPOINT")
 	if clusterEndpoint == "" {
-		t.Skip("OPENFGA_DSQL_CLUSTER_ENDPOINT not set, skipping DSQL tests")
+		return d.runDSQLEmulator(t)
 	}
 
 	region := os.Getenv("AWS_REGION")
@@ -52,13 +74,10 @@ func (d *dsqlTestContainer) RunDSQLTestContainer(t testing.TB) DatastoreTestCont
 	d.clusterEndpoint = clusterEndpoint
 	d.region = region
 
-	pgURI, err := dsql.PreparePostgresURI(d.GetConnectionURI(false), "")
-	require.NoError(t, err, "failed to prepare DSQL URI")
-
 	goose.SetLogger(goose.NopLogger())
 
-	db, err := goose.OpenDBWithDriver("pgx", pgURI)
-	require.NoError(t, err)
+	db, err := dsql.OpenDB(context.Background(), d.GetConnectionURI(false), "", dsql.TokenConfig{})
+	require.NoError(t, err, "failed to open DSQL connection")
 
 	// DSQL doesn't support SERIAL/IDENTITY, so create custom goose table.
 	// Use retry logic for test resilience.
@@ -72,19 +91,14 @@ func (d *dsqlTestContainer) RunDSQLTestContainer(t testing.TB) DatastoreTestCont
 
 	version, err := goose.GetDBVersion(db)
 	require.NoError(t, err)
-	d.version = version
+	d.version, err = dsql.SchemaRevision(version)
+	require.NoError(t, err)
 
 	err = db.Close()
 	require.NoError(t, err)
 
 	t.Cleanup(func() {
-		pgURI, err := dsql.PreparePostgresURI(d.GetConnectionURI(false), "")
-		if err != nil {
-			t.Logf("failed to prepare DSQL URI for cleanup: %v", err)
-			return
-		}
-
-		db, err := goose.OpenDBWithDriver("pgx", pgURI)
+		db, err := dsql.OpenDB(context.Background(), d.GetConnectionURI(false), "", dsql.TokenConfig{})
 		if err != nil {
 			t.Logf("failed to connect for cleanup: %v", err)
 			return
@@ -104,25 +118,68 @@ func (d *dsqlTestContainer) RunDSQLTestContainer(t testing.TB) DatastoreTestCont
 	return d
 }
 
-// GetConnectionURI returns the DSQL connection URI.
+// runDSQLEmulator starts a Postgres container to back the DSQL emulation mode.
+func (d *dsqlTestContainer) runDSQLEmulator(t testing.TB) DatastoreTestContainer {
+	t.Log("OPENFGA_DSQL_CLUSTER_ENDPOINT not set, running DSQL tests in emulation mode")
+
+	d.emulator = NewPostgresTestContainer().RunPostgresTestContainer(t)
+	d.version = d.emulator.GetDatabaseSchemaVersion()
+
+	return d
+}
+
+// GetConnectionURI returns the DSQL connection URI. In emulation mode this is a
+// dsql-emulator:// URI pointing at the backing Postgres container.
 func (d *dsqlTestContainer) GetConnectionURI(includeCredentials bool) string {
+	if d.emulator != nil {
+		return fmt.Sprintf(
+			dsqlEmulatorScheme+"%s&occ_conflict_rate=%g",
+			strings.TrimPrefix(d.emulator.GetConnectionURI(includeCredentials), "postgres://"),
+			dsqlEmulatorConflictRate,
+		)
+	}
 	return fmt.Sprintf("dsql://admin@%s/postgres?region=%s", d.clusterEndpoint, d.region)
 }
 
 func (d *dsqlTestContainer) GetUsername() string {
+	if d.emulator != nil {
+		return d.emulator.GetUsername()
+	}
 	return "admin"
 }
 
 func (d *dsqlTestContainer) GetPassword() string {
+	if d.emulator != nil {
+		return d.emulator.GetPassword()
+	}
 	return ""
 }
 
+// CreateSecondary sets up the endpoint of another region of a multi-region cluster, read from
+// OPENFGA_DSQL_SECONDARY_CLUSTER_ENDPOINT and OPENFGA_DSQL_SECONDARY_REGION. The regions of a
+// multi-region cluster serve the same data, so in emulation mode the secondary is the emulator
+// itself.
 func (d *dsqlTestContainer) CreateSecondary(t testing.TB) error {
-	return fmt.Errorf("secondary datastores not supported for DSQL")
+	if d.emulator == nil {
+		d.secondaryEndpoint = os.Getenv("OPENFGA_DSQL_SECONDARY_CLUSTER_ENDPOINT")
+		d.secondaryRegion = os.Getenv("OPENFGA_DSQL_SECONDARY_REGION")
+		if d.secondaryEndpoint == "" || d.secondaryRegion == "" {
+			return fmt.Errorf("OPENFGA_DSQL_SECONDARY_CLUSTER_ENDPOINT and OPENFGA_DSQL_SECONDARY_REGION must be set to use a secondary DSQL endpoint")
+		}
+	}
+	d.hasSecondary = true
+	return nil
 }
 
 func (d *dsqlTestContainer) GetSecondaryConnectionURI(includeCredentials bool) string {
-	return ""
+	switch {
+	case !d.hasSecondary:
+		return ""
+	case d.emulator != nil:
+		return d.GetConnectionURI(includeCredentials)
+	default:
+		return fmt.Sprintf("dsql://admin@%s/postgres?region=%s", d.secondaryEndpoint, d.secondaryRegion)
+	}
 }
 
 // ensureGooseTableWithRetry wraps dsql.EnsureGooseTable with retry logic for test resilience.
@@ -0,0 +1,218 @@
+package typesystem
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	openfgav1 "github.com/openfga/api/proto/openfga/v1"
+)
+
+// ModelDiff describes the differences between two authorization models. Types and conditions are
+// identified by their name, and every list is sorted.
+type ModelDiff struct {
+	AddedTypes   []string
+	RemovedTypes []string
+	// ChangedTypes are the types of both models with added, removed or changed relations.
+	ChangedTypes []string
+
+	AddedRelations   []RelationDiff
+	RemovedRelations []RelationDiff
+	ChangedRelations []RelationDiff
+
+	AddedConditions   []string
+	RemovedConditions []string
+	// ChangedConditions are the conditions of both models with a different expression or parameters.
+	ChangedConditions []string
+}
+
+// RelationDiff describes a relation of a [ModelDiff]. The directly related user types are written
+// as in the DSL, e.g. `user`, `user:*`, `group#member` or `user with condition`.
+type RelationDiff struct {
+	ObjectType string
+	Relation   string
+
+	// RewriteChanged reports whether the definition of a changed relation changed, which changes
+	// the users it resolves to even when its type restrictions did not change.
+	RewriteChanged bool
+
+	// AddedDirectlyRelatedUserTypes are the type restrictions of an added relation, or the ones
+	// that a changed relation gained.
+	AddedDirectlyRelatedUserTypes []string
+	// RemovedDirectlyRelatedUserTypes are the type restrictions of a removed relation, or the ones
+	// that a changed relation lost.
+	RemovedDirectlyRelatedUserTypes []string
+}
+
+// IsEmpty returns true if the two models of the diff define the same types, relations and conditions.
+func (d *ModelDiff) IsEmpty() bool {
+	return len(d.AddedTypes) == 0 && len(d.RemovedTypes) == 0 && len(d.ChangedTypes) == 0 &&
+		len(d.AddedConditions) == 0 && len(d.RemovedConditions) == 0 && len(d.ChangedConditions) == 0
+}
+
+// MayOrphanTuples returns true if tuples valid in the first model of the diff may be invalid in the
+// second one. Only adding types and conditions is guaranteed to keep every tuple valid.
+func (d *ModelDiff) MayOrphanTuples() bool {
+	return len(d.RemovedTypes) > 0 || len(d.ChangedTypes) > 0 ||
+		len(d.RemovedConditions) > 0 || len(d.ChangedConditions) > 0
+}
+
+// OrphanableTypes returns the sorted object types of the tuples that may be invalid in the second
+// model of the diff, from being its first model: the removed and changed types, and the types with
+// relations of from that allow a removed or changed condition. The tuples of the other types stay
+// valid.
+func (d *ModelDiff) OrphanableTypes(from *TypeSystem) []string {
+	types := slices.Concat(d.RemovedTypes, d.ChangedTypes)
+	conditions := slices.Concat(d.RemovedConditions, d.ChangedConditions)
+	for objectType, relations := range from.relations {
+		for _, relation := range relations {
+			if slices.ContainsFunc(relation.GetTypeInfo().GetDirectlyRelatedUserTypes(), func(rr *openfgav1.RelationReference) bool {
+				return slices.Contains(conditions, rr.GetCondition())
+			}) {
+				types = append(types, objectType)
+				break
+			}
+		}
+	}
+	slices.Sort(types)
+	return slices.Compact(types)
+}
+
+// Diff returns the differences between the from and to models.
+func Diff(from, to *TypeSystem) *ModelDiff {
+	diff := &ModelDiff{}
+
+	for _, objectType := range slices.Sorted(maps.Keys(to.relations)) {
+		if _, ok := from.relations[objectType]; !ok {
+			diff.AddedTypes = append(diff.AddedTypes, objectType)
+			for _, relation := range slices.Sorted(maps.Keys(to.relations[objectType])) {
+				diff.AddedRelations = append(diff.AddedRelations, newRelationDiff(objectType, nil, to.relations[objectType][relation]))
+			}
+		}
+	}
+
+	for _, objectType := range slices.Sorted(maps.Keys(from.relations)) {
+		fromRelations := from.relations[objectType]
+		toRelations, ok := to.relations[objectType]
+		if !ok {
+			diff.RemovedTypes = append(diff.RemovedTypes, objectType)
+			for _, relation := range slices.Sorted(maps.Keys(fromRelations)) {
+				diff.RemovedRelations = append(diff.RemovedRelations, newRelationDiff(objectType, fromRelations[relation], nil))
+			}
+			continue
+		}
+
+		changed := false
+		for _, relation := range slices.Sorted(maps.Keys(toRelations)) {
+			if _, ok := fromRelations[relation]; !ok {
+				diff.AddedRelations = append(diff.AddedRelations, newRelationDiff(objectType, nil, toRelations[relation]))
+				changed = true
+			}
+		}
+		for _, relation := range slices.Sorted(maps.Keys(fromRelations)) {
+			toRelation, ok := toRelations[relation]
+			if !ok {
+				diff.RemovedRelations = append(diff.RemovedRelations, newRelationDiff(objectType, fromRelations[relation], nil))
+				changed = true
+				continue
+			}
+
+			relationDiff := newRelationDiff(objectType, fromRelations[relation], toRelation)
+			if relationDiff.RewriteChanged || len(relationDiff.AddedDirectlyRelatedUserTypes) > 0 || len(relationDiff.RemovedDirectlyRelatedUserTypes) > 0 {
+				diff.ChangedRelations = append(diff.ChangedRelations, relationDiff)
+				changed = true
+			}
+		}
+		if changed {
+			diff.ChangedTypes = append(diff.ChangedTypes, objectType)
+		}
+	}
+	sortRelationDiffs(diff.AddedRelations)
+	sortRelationDiffs(diff.RemovedRelations)
+
+	for _, name := range slices.Sorted(maps.Keys(to.conditions)) {
+		if _, ok := from.conditions[name]; !ok {
+			diff.AddedConditions = append(diff.AddedConditions, name)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(from.conditions)) {
+		toCondition, ok := to.conditions[name]
+		if !ok {
+			diff.RemovedConditions = append(diff.RemovedConditions, name)
+			continue
+		}
+
+		fromCondition := from.conditions[name]
+		if fromCondition.GetExpression() != toCondition.GetExpression() ||
+			!parametersEqual(fromCondition.GetParameters(), toCondition.GetParameters()) {
+			diff.ChangedConditions = append(diff.ChangedConditions, name)
+		}
+	}
+
+	return diff
+}
+
+// newRelationDiff returns the diff of a relation of objectType, where from is nil for an added
+// relation and to is nil for a removed one.
+func newRelationDiff(objectType string, from, to *openfgav1.Relation) RelationDiff {
+	relationDiff := RelationDiff{
+		ObjectType: objectType,
+		Relation:   from.GetName(),
+	}
+	if from == nil {
+		relationDiff.Relation = to.GetName()
+	}
+
+	fromUserTypes := directlyRelatedUserTypeStrings(from)
+	toUserTypes := directlyRelatedUserTypeStrings(to)
+	for _, userType := range toUserTypes {
+		if !slices.Contains(fromUserTypes, userType) {
+			relationDiff.AddedDirectlyRelatedUserTypes = append(relationDiff.AddedDirectlyRelatedUserTypes, userType)
+		}
+	}
+	for _, userType := range fromUserTypes {
+		if !slices.Contains(toUserTypes, userType) {
+			relationDiff.RemovedDirectlyRelatedUserTypes = append(relationDiff.RemovedDirectlyRelatedUserTypes, userType)
+		}
+	}
+
+	relationDiff.RewriteChanged = from != nil && to != nil && !proto.Equal(from.GetRewrite(), to.GetRewrite())
+	return relationDiff
+}
+
+// directlyRelatedUserTypeStrings returns the sorted type restrictions of relation, written as in the DSL.
+func directlyRelatedUserTypeStrings(relation *openfgav1.Relation) []string {
+	userTypes := make([]string, 0, len(relation.GetTypeInfo().GetDirectlyRelatedUserTypes()))
+	for _, rr := range relation.GetTypeInfo().GetDirectlyRelatedUserTypes() {
+		userType := rr.GetType()
+		if rr.GetRelationOrWildcard() != nil {
+			userType = GetRelationReferenceAsString(rr)
+		}
+		if rr.GetCondition() != "" {
+			userType += " with " + rr.GetCondition()
+		}
+		userTypes = append(userTypes, userType)
+	}
+	slices.Sort(userTypes)
+	return userTypes
+}
+
+func sortRelationDiffs(relationDiffs []RelationDiff) {
+	slices.SortFunc(relationDiffs, func(a, b RelationDiff) int {
+		return cmp.Or(cmp.Compare(a.ObjectType, b.ObjectType), cmp.Compare(a.Relation, b.Relation))
+	})
+}
+
+func parametersEqual(a, b map[string]*openfgav1.ConditionParamTypeRef) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, param := range a {
+		if !proto.Equal(param, b[name]) {
+			return false
+		}
+	}
+	return true
+}
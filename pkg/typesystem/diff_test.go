@@ -0,0 +1,118 @@
+package typesystem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/openfga/openfga/pkg/testutils"
+)
+
+func TestDiff(t *testing.T) {
+	from, err := New(testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type team
+			relations
+				define member: [user]
+		type folder
+			relations
+				define viewer: [user]
+		type document
+			relations
+				define owner: [user, team#member]
+				define editor: [user] or owner
+				define viewer: [user, user:*]
+				define parent: [folder]
+
+		condition in_region(region: string) {
+			region == "eu"
+		}
+		condition before(now: timestamp, deadline: timestamp) {
+			now < deadline
+		}
+	`))
+	require.NoError(t, err)
+
+	to, err := New(testutils.MustTransformDSLToProtoWithID(`
+		model
+			schema 1.1
+		type user
+		type group
+			relations
+				define member: [user]
+		type folder
+			relations
+				define viewer: [user]
+		type document
+			relations
+				define owner: [user, group#member]
+				define editor: [user]
+				define viewer: [user, user with in_region]
+				define can_share: owner
+
+		condition in_region(region: string) {
+			region == "us"
+		}
+		condition after(now: timestamp, start: timestamp) {
+			now > start
+		}
+	`))
+	require.NoError(t, err)
+
+	t.Run("same_model", func(t *testing.T) {
+		diff := Diff(from, from)
+		require.True(t, diff.IsEmpty())
+		require.False(t, diff.MayOrphanTuples())
+	})
+
+	t.Run("different_models", func(t *testing.T) {
+		diff := Diff(from, to)
+		require.False(t, diff.IsEmpty())
+		require.True(t, diff.MayOrphanTuples())
+		require.Equal(t, []string{"document", "team"}, diff.OrphanableTypes(from))
+		require.Equal(t, &ModelDiff{
+			AddedTypes:   []string{"group"},
+			RemovedTypes: []string{"team"},
+			ChangedTypes: []string{"document"},
+			AddedRelations: []RelationDiff{
+				{ObjectType: "document", Relation: "can_share"},
+				{ObjectType: "group", Relation: "member", AddedDirectlyRelatedUserTypes: []string{"user"}},
+			},
+			RemovedRelations: []RelationDiff{
+				{ObjectType: "document", Relation: "parent", RemovedDirectlyRelatedUserTypes: []string{"folder"}},
+				{ObjectType: "team", Relation: "member", RemovedDirectlyRelatedUserTypes: []string{"user"}},
+			},
+			ChangedRelations: []RelationDiff{
+				{ObjectType: "document", Relation: "editor", RewriteChanged: true},
+				{
+					ObjectType:                      "document",
+					Relation:                        "owner",
+					AddedDirectlyRelatedUserTypes:   []string{"group#member"},
+					RemovedDirectlyRelatedUserTypes: []string{"team#member"},
+				},
+				{
+					ObjectType:                      "document",
+					Relation:                        "viewer",
+					AddedDirectlyRelatedUserTypes:   []string{"user with in_region"},
+					RemovedDirectlyRelatedUserTypes: []string{"user:*"},
+				},
+			},
+			AddedConditions:   []string{"after"},
+			RemovedConditions: []string{"before"},
+			ChangedConditions: []string{"in_region"},
+		}, diff)
+	})
+
+	t.Run("only_additions", func(t *testing.T) {
+		diff := Diff(to, to)
+		require.True(t, diff.IsEmpty())
+
+		diff = Diff(from, from)
+		diff.AddedTypes = []string{"group"}
+		diff.AddedConditions = []string{"after"}
+		require.False(t, diff.IsEmpty())
+		require.False(t, diff.MayOrphanTuples())
+	})
+}
@@ -177,6 +177,8 @@ type TypeSystem struct {
 	ttuRelations map[string]map[string][]*openfgav1.TupleToUserset
 
 	computedRelations sync.Map
+	// [objectType#relation] => []string of objectType#relation.
+	relationDependencies sync.Map
 
 	modelID                 string
 	schemaVersion           string
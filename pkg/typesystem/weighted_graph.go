@@ -2,7 +2,9 @@ package typesystem
 
 import (
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 
 	openfgav1 "github.com/openfga/api/proto/openfga/v1"
 	"github.com/openfga/language/pkg/go/graph"
@@ -252,3 +254,52 @@ func (t *TypeSystem) ConstructUnionUserset(node *graph.WeightedAuthorizationMode
 				Child: usersets,
 			}}}, nil
 }
+
+// GetRelationDependencies returns the sorted object type and relation pairs, formatted as
+// "objectType#relation", of the tuples that the resolution of a Check of objectType#relation
+// could read: the relations reachable from it in the weighted graph, and the tupleset relations
+// of the tuple to userset rewrites on the way. It returns false if the weighted graph is not
+// available or doesn't contain objectType#relation.
+// Subsequent calls to this method are resolved from a cache.
+func (t *TypeSystem) GetRelationDependencies(objectType, relation string) ([]string, bool) {
+	objRel := tuple.ToObjectRelationString(objectType, relation)
+	if val, ok := t.relationDependencies.Load(objRel); ok {
+		return val.([]string), true
+	}
+
+	if t.authzWeightedGraph == nil {
+		return nil, false
+	}
+	node, ok := t.authzWeightedGraph.GetNodeByID(objRel)
+	if !ok {
+		return nil, false
+	}
+
+	dependencies := map[string]struct{}{}
+	visited := map[string]struct{}{node.GetUniqueLabel(): {}}
+	stack := []*graph.WeightedAuthorizationModelNode{node}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current.GetNodeType() == graph.SpecificTypeAndRelation {
+			dependencies[current.GetUniqueLabel()] = struct{}{}
+		}
+
+		edges, _ := t.authzWeightedGraph.GetEdgesFromNode(current)
+		for _, edge := range edges {
+			if tuplesetRelation := edge.GetTuplesetRelation(); tuplesetRelation != "" {
+				dependencies[tuplesetRelation] = struct{}{}
+			}
+			to := edge.GetTo()
+			if _, ok := visited[to.GetUniqueLabel()]; !ok {
+				visited[to.GetUniqueLabel()] = struct{}{}
+				stack = append(stack, to)
+			}
+		}
+	}
+
+	res := slices.Sorted(maps.Keys(dependencies))
+	t.relationDependencies.Store(objRel, res)
+	return res, true
+}
@@ -1079,3 +1079,58 @@ func TestConstructUserset(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGetRelationDependencies(t *testing.T) {
+	model := `
+		model
+			schema 1.1
+		type user
+		type group
+			relations
+				define member: [user, group#member]
+		type folder
+			relations
+				define owner: [user]
+				define viewer: [user, group#member] or owner
+		type document
+			relations
+				define parent: [folder]
+				define blocked: [user]
+				define editor: [user]
+				define viewer: ([user] or editor or viewer from parent) but not blocked
+				define unrelated: [user]
+		`
+	typeSystem, err := New(testutils.MustTransformDSLToProtoWithID(model))
+	require.NoError(t, err)
+
+	t.Run("follows_every_rewrite", func(t *testing.T) {
+		dependencies, ok := typeSystem.GetRelationDependencies("document", "viewer")
+		require.True(t, ok)
+		require.Equal(t, []string{
+			"document#blocked",
+			"document#editor",
+			"document#parent",
+			"document#viewer",
+			"folder#owner",
+			"folder#viewer",
+			"group#member",
+		}, dependencies)
+	})
+
+	t.Run("direct_relation", func(t *testing.T) {
+		dependencies, ok := typeSystem.GetRelationDependencies("document", "unrelated")
+		require.True(t, ok)
+		require.Equal(t, []string{"document#unrelated"}, dependencies)
+	})
+
+	t.Run("recursive_relation", func(t *testing.T) {
+		dependencies, ok := typeSystem.GetRelationDependencies("group", "member")
+		require.True(t, ok)
+		require.Equal(t, []string{"group#member"}, dependencies)
+	})
+
+	t.Run("unknown_relation", func(t *testing.T) {
+		_, ok := typeSystem.GetRelationDependencies("document", "undefined")
+		require.False(t, ok)
+	})
+}